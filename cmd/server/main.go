@@ -1,41 +1,116 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"flag"
+	"fmt"
 	"log"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
-	"github.com/Prodro21/video-mcp/internal/client"
+	"github.com/Prodro21/video-mcp/internal/config"
 	"github.com/Prodro21/video-mcp/internal/handlers"
+	"github.com/Prodro21/video-mcp/internal/transport"
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
 	"github.com/mark3labs/mcp-go/server"
 )
 
 func main() {
-	// Configuration flags
-	apiURL := flag.String("api-url", "http://localhost:8080", "Video platform API base URL")
-	flag.Parse()
-
-	// Check for environment variable override
-	if envURL := os.Getenv("VIDEO_PLATFORM_URL"); envURL != "" {
-		*apiURL = envURL
+	// Configuration from flags and environment
+	cfg, err := config.Load(os.Args[1:])
+	if err != nil {
+		// The flag set already printed -h and parse errors
+		if !errors.Is(err, flag.ErrHelp) && !errors.Is(err, config.ErrFlags) {
+			fmt.Fprintln(os.Stderr, err)
+		}
+		os.Exit(2)
 	}
 
 	// Create API client
-	apiClient := client.New(*apiURL)
+	var clientOpts []videoplatform.Option
+	if cfg.APIToken != "" {
+		clientOpts = append(clientOpts, videoplatform.WithToken(cfg.APIToken))
+	}
+	if cfg.Debug {
+		clientOpts = append(clientOpts, videoplatform.WithLogger(log.Default()))
+	}
+	apiClient := videoplatform.New(cfg.APIURL, clientOpts...)
 
 	// Create MCP server
 	s := server.NewMCPServer(
 		"video-platform",
-		"1.0.0",
+		config.Version,
 		server.WithResourceCapabilities(true, false),
 		server.WithPromptCapabilities(true),
 	)
 
 	// Register handlers
-	handlers.RegisterTools(s, apiClient)
-	handlers.RegisterResources(s, apiClient)
+	registry := handlers.RegisterTools(s, apiClient, cfg)
+	handlers.RegisterResources(s, apiClient, cfg, registry)
 	handlers.RegisterPrompts(s)
 
+	// Self-test mode checks the platform instead of serving
+	if cfg.SelfTest {
+		report := handlers.RunSelfTest(context.Background(), s, apiClient)
+		fmt.Print(report.Table())
+		if !report.Passed {
+			os.Exit(1)
+		}
+		return
+	}
+
+	// Support bundle mode prints a diagnostics snapshot instead of serving
+	if cfg.SupportBundle {
+		bundle := handlers.BuildSupportBundle(context.Background(), registry, apiClient, cfg)
+		data, _ := json.MarshalIndent(bundle, "", "  ")
+		fmt.Println(string(data))
+		return
+	}
+
+	// Digest mode prints the last 24 hours for cron-style runs
+	if cfg.DigestOnStart {
+		fmt.Print(handlers.DailyDigest(context.Background(), apiClient, time.Now(), cfg.NoEmoji))
+		return
+	}
+
+	// Warm the tool cache in the background so serving isn't delayed
+	if cfg.Prefetch {
+		go func() {
+			elapsed, err := registry.Prefetch(context.Background())
+			if err != nil {
+				log.Printf("Prefetch skipped: %v", err)
+				return
+			}
+			log.Printf("Prefetched channels and recent sessions in %s", elapsed.Round(time.Millisecond))
+		}()
+	}
+
+	// Serve over HTTP with Server-Sent Events, tracking connections for
+	// connection_info
+	if cfg.Transport == config.TransportSSE {
+		sse := transport.NewSSE(s, cfg.Keepalive, log.Default())
+		registry.AttachTransport(sse)
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+		go func() {
+			<-ctx.Done()
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			sse.Shutdown(shutdownCtx)
+		}()
+
+		log.Printf("Starting video-platform MCP server on %s (sse)...", cfg.HTTPAddr)
+		if err := sse.ListenAndServe(cfg.HTTPAddr); err != nil {
+			log.Fatalf("Server error: %v", err)
+		}
+		return
+	}
+
 	// Start stdio server
 	log.Println("Starting video-platform MCP server...")
 	if err := server.ServeStdio(s); err != nil {
@@ -0,0 +1,37 @@
+// Command active-sessions prints the sessions that are currently recording.
+// It shows how to script against the platform with the public client.
+//
+//	go run ./examples/active-sessions -api-url http://localhost:8080
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+)
+
+func main() {
+	apiURL := flag.String("api-url", "http://localhost:8080", "Video platform API base URL")
+	flag.Parse()
+
+	c := videoplatform.New(*apiURL, videoplatform.WithTimeout(10*time.Second))
+
+	ctx := context.Background()
+	resp, err := c.ListSessions(ctx, videoplatform.ListSessionsParams{Status: videoplatform.SessionActive})
+	if err != nil {
+		var apiErr *videoplatform.APIError
+		if errors.As(err, &apiErr) {
+			log.Fatalf("platform returned %d: %s", apiErr.StatusCode, apiErr.Body)
+		}
+		log.Fatal(err)
+	}
+
+	for _, s := range resp.Data {
+		fmt.Printf("%s\t%s\t%d clips\n", s.ID, s.Name, s.ClipCount)
+	}
+}
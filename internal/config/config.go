@@ -0,0 +1,241 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Prodro21/video-mcp/internal/stats"
+)
+
+// Version is the server version, overridable at build time with
+// -ldflags "-X github.com/Prodro21/video-mcp/internal/config.Version=..."
+var Version = "1.0.0"
+
+// ErrFlags marks errors from parsing the command line. The flag set has
+// already printed them with the usage, so callers shouldn't print them
+// again.
+var ErrFlags = errors.New("invalid flags")
+
+// DefaultResourceLimit is the page size of paged resources when the URI
+// doesn't set a limit
+const DefaultResourceLimit = 100
+
+// Transports the server can serve MCP over
+const (
+	TransportStdio = "stdio"
+	TransportSSE   = "sse"
+)
+
+// DefaultHTTPAddr is the listen address of the SSE transport. It is
+// loopback-only because anyone who can reach the port can call every tool.
+const DefaultHTTPAddr = "127.0.0.1:8090"
+
+// DefaultKeepalive is the interval between keepalive pings on SSE streams
+const DefaultKeepalive = 15 * time.Second
+
+// DefaultOvertimeLength bounds the game clock of an overtime period
+const DefaultOvertimeLength = 10 * time.Minute
+
+// DefaultSnapshotInlineLimit is the largest snapshot channel_snapshot
+// returns inline
+const DefaultSnapshotInlineLimit = 1 << 20
+
+// DefaultToolLimits are the built-in limit defaults of the list tools
+var DefaultToolLimits = map[string]int{
+	"list_sessions": 20,
+	"list_clips":    20,
+	"list_tags":     50,
+	"search_tags":   20,
+}
+
+// ToolDefaults are argument defaults for one tool
+type ToolDefaults struct {
+	Limit int `json:"limit,omitempty"`
+}
+
+// ToolsConfig is the tools section of the config file
+type ToolsConfig struct {
+	Defaults map[string]ToolDefaults `json:"defaults,omitempty"`
+}
+
+// fileConfig is the part of the config that is read from the -config file
+type fileConfig struct {
+	ResourceLimit int         `json:"resource_limit,omitempty"`
+	Tools         ToolsConfig `json:"tools"`
+}
+
+// Config holds the server's runtime settings
+type Config struct {
+	APIURL              string           `json:"api_url"`
+	APIToken            string           `json:"api_token,omitempty"`
+	SelfTest            bool             `json:"self_test"`
+	SupportBundle       bool             `json:"support_bundle"`
+	DigestOnStart       bool             `json:"digest_on_start"`
+	NoEmoji             bool             `json:"no_emoji"`
+	Debug               bool             `json:"debug"`
+	DataDir             string           `json:"data_dir,omitempty"`
+	IndexMaxAge         time.Duration    `json:"index_max_age"`
+	Success             stats.Thresholds `json:"success_thresholds"`
+	ToolCacheTTL        time.Duration    `json:"tool_cache_ttl"`
+	EnableRawRequests   bool             `json:"enable_raw_requests"`
+	ConfigFile          string           `json:"config_file,omitempty"`
+	ResourceLimit       int              `json:"resource_limit"`
+	Tools               ToolsConfig      `json:"tools"`
+	OvertimeLength      time.Duration    `json:"overtime_length"`
+	MaxConcurrentTools  int              `json:"max_concurrent_tools"`
+	Prefetch            bool             `json:"prefetch"`
+	Transport           string           `json:"transport"`
+	HTTPAddr            string           `json:"http_addr,omitempty"`
+	Keepalive           time.Duration    `json:"keepalive"`
+	AllowedSessionTypes []string         `json:"allowed_session_types,omitempty"`
+	ExportDir           string           `json:"export_dir,omitempty"`
+	SnapshotInlineLimit int              `json:"snapshot_inline_limit"`
+}
+
+// Load parses command-line arguments and applies environment overrides
+func Load(args []string) (*Config, error) {
+	cfg := &Config{ResourceLimit: DefaultResourceLimit}
+
+	fs := flag.NewFlagSet("video-mcp", flag.ContinueOnError)
+	fs.StringVar(&cfg.APIURL, "api-url", "http://localhost:8080", "Video platform API base URL")
+	fs.BoolVar(&cfg.SelfTest, "self-test", false, "Run read-only checks against the platform and exit")
+	fs.BoolVar(&cfg.SupportBundle, "support-bundle", false, "Print a JSON support bundle to stdout and exit")
+	fs.BoolVar(&cfg.DigestOnStart, "digest-on-start", false, "Print a markdown digest of the last 24 hours to stdout and exit")
+	fs.BoolVar(&cfg.NoEmoji, "no-emoji", false, "Use [OK]/[WARN]/[FAIL] instead of emoji in status summaries")
+	fs.BoolVar(&cfg.Debug, "debug", false, "Log every platform request to stderr")
+	fs.StringVar(&cfg.DataDir, "data-dir", "", "Directory for the local session/channel index and tag templates (disabled when empty)")
+	fs.DurationVar(&cfg.IndexMaxAge, "index-max-age", 15*time.Minute, "Age after which the local index is refreshed in the background")
+	fs.Float64Var(&cfg.Success.FirstDown, "success-first-down", stats.DefaultThresholds.FirstDown, "Fraction of the distance a 1st-down play must gain to count as successful")
+	fs.Float64Var(&cfg.Success.SecondDown, "success-second-down", stats.DefaultThresholds.SecondDown, "Fraction of the distance a 2nd-down play must gain to count as successful")
+	fs.Float64Var(&cfg.Success.LateDown, "success-late-down", stats.DefaultThresholds.LateDown, "Fraction of the distance a 3rd/4th-down play must gain to count as successful")
+	fs.DurationVar(&cfg.ToolCacheTTL, "tool-cache-ttl", 0, "Reuse results of identical read-only tool calls for this long (0 disables)")
+	fs.BoolVar(&cfg.EnableRawRequests, "enable-raw-requests", false, "Register the platform_request tool for calling unmapped /api/ endpoints")
+	fs.DurationVar(&cfg.OvertimeLength, "overtime-length", DefaultOvertimeLength, "Longest game clock accepted for an overtime period in game_clock")
+	fs.IntVar(&cfg.MaxConcurrentTools, "max-concurrent-tools", 8, "Tool calls allowed to run at once; extra calls wait briefly, then fail as busy (0 disables)")
+	fs.BoolVar(&cfg.Prefetch, "prefetch", false, "Warm the tool cache with channels and recent sessions in the background at startup (needs -tool-cache-ttl)")
+	fs.StringVar(&cfg.Transport, "transport", TransportStdio, "Transport to serve MCP over: stdio or sse")
+	fs.StringVar(&cfg.HTTPAddr, "http-addr", DefaultHTTPAddr, "Listen address of the sse transport; a non-loopback address exposes every tool to the network")
+	fs.DurationVar(&cfg.Keepalive, "keepalive", DefaultKeepalive, "Interval between keepalive pings on sse streams; a stream whose ping can't be written is dropped (0 disables)")
+	fs.Func("allowed-session-types", "Comma-separated session types to accept, e.g. game,practice,7v7 (any type is accepted when unset)", func(v string) error {
+		cfg.AllowedSessionTypes = splitList(v)
+		return nil
+	})
+	fs.StringVar(&cfg.ExportDir, "export-dir", "", "Directory export_clips may write clip media under (export_clips is disabled when empty)")
+	fs.IntVar(&cfg.SnapshotInlineLimit, "snapshot-inline-limit", DefaultSnapshotInlineLimit, "Largest channel snapshot, in bytes, returned as an image; larger ones are written under -export-dir")
+	fs.StringVar(&cfg.ConfigFile, "config", "", "JSON file with resource_limit and tools.defaults overrides")
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: %w", ErrFlags, err)
+	}
+	if err := cfg.Success.Validate(); err != nil {
+		return nil, err
+	}
+	if cfg.Transport != TransportStdio && cfg.Transport != TransportSSE {
+		return nil, fmt.Errorf("-transport must be %s or %s, got %q", TransportStdio, TransportSSE, cfg.Transport)
+	}
+	if cfg.Keepalive < 0 {
+		return nil, fmt.Errorf("-keepalive must not be negative")
+	}
+	if cfg.SnapshotInlineLimit < 0 {
+		return nil, fmt.Errorf("-snapshot-inline-limit must not be negative")
+	}
+	if cfg.Prefetch && cfg.ToolCacheTTL <= 0 {
+		return nil, fmt.Errorf("-prefetch needs -tool-cache-ttl to keep the prefetched results")
+	}
+	if cfg.ConfigFile != "" {
+		if err := cfg.loadFile(cfg.ConfigFile); err != nil {
+			return nil, err
+		}
+	}
+
+	// Check for environment variable override
+	if envURL := os.Getenv("VIDEO_PLATFORM_URL"); envURL != "" {
+		cfg.APIURL = envURL
+	}
+	// The token is only read from the environment so it never shows up in
+	// process listings
+	cfg.APIToken = os.Getenv("VIDEO_PLATFORM_TOKEN")
+
+	return cfg, nil
+}
+
+// splitList splits a comma-separated flag value, dropping blank items
+func splitList(v string) []string {
+	var items []string
+	for _, item := range strings.Split(v, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// loadFile applies the settings in a config file such as
+//
+//	{"resource_limit": 200, "tools": {"defaults": {"list_tags": {"limit": 500}}}}
+func (c *Config) loadFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("config file: %w", err)
+	}
+	defer f.Close()
+
+	var fc fileConfig
+	dec := json.NewDecoder(f)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&fc); err != nil {
+		return fmt.Errorf("config file %s: %w", path, err)
+	}
+
+	if fc.ResourceLimit < 0 {
+		return fmt.Errorf("config file %s: resource_limit must be positive", path)
+	}
+	for tool, defaults := range fc.Tools.Defaults {
+		if defaults.Limit < 0 {
+			return fmt.Errorf("config file %s: tools.defaults.%s.limit must be positive", path, tool)
+		}
+	}
+
+	if fc.ResourceLimit > 0 {
+		c.ResourceLimit = fc.ResourceLimit
+	}
+	c.Tools = fc.Tools
+	return nil
+}
+
+// ToolLimit returns the default limit of a list tool: the tools.defaults
+// override if set, otherwise the built-in default
+func (c Config) ToolLimit(tool string) int {
+	if limit := c.Tools.Defaults[tool].Limit; limit > 0 {
+		return limit
+	}
+	return DefaultToolLimits[tool]
+}
+
+// ResourcePageLimit returns the default page size of paged resources
+func (c Config) ResourcePageLimit() int {
+	if c.ResourceLimit > 0 {
+		return c.ResourceLimit
+	}
+	return DefaultResourceLimit
+}
+
+// Redacted returns a copy of the config that is safe to share, with the API
+// token and any credentials embedded in the API URL masked
+func (c Config) Redacted() Config {
+	if u, err := url.Parse(c.APIURL); err == nil {
+		c.APIURL = u.Redacted()
+	}
+	if c.APIToken != "" {
+		c.APIToken = "xxxxx"
+	}
+	return c
+}
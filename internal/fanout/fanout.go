@@ -0,0 +1,37 @@
+// Package fanout runs a batch of independent calls concurrently with a cap
+// on how many are in flight, so bulk tools don't flood the platform API.
+package fanout
+
+import (
+	"context"
+	"sync"
+)
+
+// Each calls fn for every index in [0, n), at most limit at a time, and
+// waits for all of them. The error of call i lands in errs[i]; calls still
+// waiting for a slot when ctx is cancelled are not made and get ctx.Err().
+// fn may write to index i of caller-owned slices without locking.
+func Each(ctx context.Context, n, limit int, fn func(i int) error) []error {
+	if limit < 1 {
+		limit = 1
+	}
+	errs := make([]error, n)
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, limit)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				errs[i] = ctx.Err()
+				return
+			}
+			errs[i] = fn(i)
+		}(i)
+	}
+	wg.Wait()
+	return errs
+}
@@ -0,0 +1,70 @@
+package fanout
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestEach_BoundsConcurrency(t *testing.T) {
+	var inFlight, peak int32
+	results := make([]int, 20)
+	errs := Each(context.Background(), len(results), 3, func(i int) error {
+		n := atomic.AddInt32(&inFlight, 1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		atomic.AddInt32(&inFlight, -1)
+		results[i] = i * 2
+		if i == 7 {
+			return errors.New("boom")
+		}
+		return nil
+	})
+
+	if peak > 3 {
+		t.Errorf("peak concurrency = %d, want at most 3", peak)
+	}
+	for i, err := range errs {
+		if (err != nil) != (i == 7) {
+			t.Errorf("errs[%d] = %v", i, err)
+		}
+		if results[i] != i*2 {
+			t.Errorf("results[%d] = %d, want %d", i, results[i], i*2)
+		}
+	}
+}
+
+func TestEach_CancelledSkipsWaitingCalls(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var calls int32
+	errs := Each(ctx, 5, 1, func(i int) error {
+		atomic.AddInt32(&calls, 1)
+		return nil
+	})
+
+	// A slot may still win the race with the cancelled context, but every
+	// call that didn't run must report the cancellation
+	for i, err := range errs {
+		if err != nil && !errors.Is(err, context.Canceled) {
+			t.Errorf("errs[%d] = %v, want context.Canceled", i, err)
+		}
+	}
+	var skipped int32
+	for _, err := range errs {
+		if err != nil {
+			skipped++
+		}
+	}
+	if calls+skipped != 5 {
+		t.Errorf("calls %d + skipped %d, want 5", calls, skipped)
+	}
+}
@@ -0,0 +1,25 @@
+package handlers
+
+import (
+	"fmt"
+	"strings"
+)
+
+// describeEach renders each item of a bulk report with describe,
+// comma-separated, e.g. "cam-3 (encoder offline), cam-9 (channel not found)"
+func describeEach[T any](items []T, describe func(T) string) string {
+	out := make([]string, len(items))
+	for i, item := range items {
+		out[i] = describe(item)
+	}
+	return strings.Join(out, ", ")
+}
+
+// bulkSummary ends the summary sentence of a bulk change, adding how many
+// items failed and why when any did
+func bulkSummary[T any](text string, failed []T, describe func(T) string) string {
+	if len(failed) == 0 {
+		return text + "."
+	}
+	return fmt.Sprintf("%s; %d failed: %s.", text, len(failed), describeEach(failed, describe))
+}
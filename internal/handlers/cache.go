@@ -0,0 +1,120 @@
+package handlers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// toolCacheSize bounds how many tool results the cache retains
+const toolCacheSize = 256
+
+// toolCache memoizes read-only tool results for a short TTL so repeated
+// identical calls within a conversation don't hit the platform again.
+// gen counts invalidations so a read that overlapped a mutation can't store
+// the stale result it fetched before the mutation landed.
+type toolCache struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]toolCacheEntry
+	gen     uint64
+}
+
+type toolCacheEntry struct {
+	result  *mcp.CallToolResult
+	expires time.Time
+}
+
+func newToolCache(ttl time.Duration) *toolCache {
+	return &toolCache{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[string]toolCacheEntry),
+	}
+}
+
+// toolCacheKey identifies a call by tool name and a hash of its arguments.
+// encoding/json sorts map keys, so equal arguments hash equally; missing
+// arguments hash the same as empty ones.
+func toolCacheKey(name string, args map[string]interface{}) (string, bool) {
+	if args == nil {
+		args = map[string]interface{}{}
+	}
+	data, err := json.Marshal(args)
+	if err != nil {
+		return "", false
+	}
+	sum := sha256.Sum256(data)
+	return name + ":" + hex.EncodeToString(sum[:]), true
+}
+
+func (c *toolCache) get(key string) (*mcp.CallToolResult, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if !c.now().Before(entry.expires) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return entry.result, true
+}
+
+// generation returns the current invalidation count. Readers take it before
+// running the handler and hand it back to put.
+func (c *toolCache) generation() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.gen
+}
+
+// put stores a result unless the cache was invalidated since gen was taken
+func (c *toolCache) put(key string, gen uint64, result *mcp.CallToolResult) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if gen != c.gen {
+		return
+	}
+	now := c.now()
+	if len(c.entries) >= toolCacheSize {
+		c.evictLocked(now)
+	}
+	c.entries[key] = toolCacheEntry{result: result, expires: now.Add(c.ttl)}
+}
+
+// evictLocked drops expired entries, or the one closest to expiry if none
+// have expired
+func (c *toolCache) evictLocked(now time.Time) {
+	var oldestKey string
+	var oldest time.Time
+	for key, entry := range c.entries {
+		if !now.Before(entry.expires) {
+			delete(c.entries, key)
+			continue
+		}
+		if oldestKey == "" || entry.expires.Before(oldest) {
+			oldestKey, oldest = key, entry.expires
+		}
+	}
+	if len(c.entries) >= toolCacheSize {
+		delete(c.entries, oldestKey)
+	}
+}
+
+// invalidate drops every cached result
+func (c *toolCache) invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	clear(c.entries)
+	c.gen++
+}
@@ -0,0 +1,243 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// newCachingRegistry returns a registry with a tool cache on a fake clock
+func newCachingRegistry(ttl time.Duration) (*Registry, *time.Time) {
+	r := newRegistry(server.NewMCPServer("video-platform", "test"))
+	r.cache = newToolCache(ttl)
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	r.cache.now = func() time.Time { return now }
+	return r, &now
+}
+
+// countingHandler counts calls and returns a fresh result each time
+func countingHandler(calls *int) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		*calls++
+		return mcp.NewToolResultText("result"), nil
+	}
+}
+
+func callWith(h server.ToolHandlerFunc, args map[string]interface{}) *mcp.CallToolResult {
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = args
+	result, _ := h(context.Background(), req)
+	return result
+}
+
+func TestToolCache_HitAndMiss(t *testing.T) {
+	r, _ := newCachingRegistry(time.Minute)
+	r.readOnly["list_channels"] = true
+
+	calls := 0
+	h := r.wrap("list_channels", countingHandler(&calls))
+
+	first := callWith(h, map[string]interface{}{"status": "active", "limit": float64(5)})
+	second := callWith(h, map[string]interface{}{"limit": float64(5), "status": "active"})
+	if calls != 1 {
+		t.Errorf("Expected identical arguments to hit the cache, handler ran %d times", calls)
+	}
+	if first != second {
+		t.Error("Expected the cached result to be returned")
+	}
+
+	callWith(h, map[string]interface{}{"status": "inactive", "limit": float64(5)})
+	if calls != 2 {
+		t.Errorf("Expected different arguments to miss, handler ran %d times", calls)
+	}
+
+	entries := r.AuditEntries()
+	if !entries[1].Cached || entries[0].Cached || entries[2].Cached {
+		t.Errorf("Expected only the second call to be audited as cached, got %+v", entries)
+	}
+}
+
+func TestToolCache_ErrorsNotCached(t *testing.T) {
+	r, _ := newCachingRegistry(time.Minute)
+	r.readOnly["get_clip"] = true
+
+	calls := 0
+	h := r.wrap("get_clip", func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		calls++
+		return mcp.NewToolResultError("platform down"), nil
+	})
+
+	callWith(h, nil)
+	callWith(h, nil)
+	if calls != 2 {
+		t.Errorf("Expected error results not to be cached, handler ran %d times", calls)
+	}
+}
+
+func TestToolCache_MutationInvalidates(t *testing.T) {
+	r, _ := newCachingRegistry(time.Minute)
+	r.readOnly["list_sessions"] = true
+
+	reads, writes := 0, 0
+	list := r.wrap("list_sessions", countingHandler(&reads))
+	start := r.wrap("start_session", countingHandler(&writes))
+
+	callWith(list, nil)
+	callWith(list, nil)
+	if reads != 1 {
+		t.Fatalf("Expected second read to hit the cache, handler ran %d times", reads)
+	}
+
+	// Mutating tools always run and clear the cache
+	callWith(start, map[string]interface{}{"session_id": "session-1"})
+	callWith(start, map[string]interface{}{"session_id": "session-1"})
+	if writes != 2 {
+		t.Errorf("Expected mutating tool never to be cached, handler ran %d times", writes)
+	}
+
+	callWith(list, nil)
+	if reads != 2 {
+		t.Errorf("Expected read after mutation to miss, handler ran %d times", reads)
+	}
+}
+
+func TestToolCache_UncachedTool(t *testing.T) {
+	r, _ := newCachingRegistry(time.Minute)
+	r.readOnly["list_sessions"] = true
+	r.uncached["session_progress"] = true
+
+	reads, progress := 0, 0
+	list := r.wrap("list_sessions", countingHandler(&reads))
+	live := r.wrap("session_progress", countingHandler(&progress))
+
+	callWith(list, nil)
+	callWith(live, map[string]interface{}{"session_id": "session-1"})
+	callWith(live, map[string]interface{}{"session_id": "session-1"})
+	if progress != 2 {
+		t.Errorf("Expected uncached tool never to be cached, handler ran %d times", progress)
+	}
+
+	callWith(list, nil)
+	if reads != 1 {
+		t.Errorf("Expected uncached tool to leave the cache alone, handler ran %d times", reads)
+	}
+}
+
+func TestToolCache_UncachedPollingTool(t *testing.T) {
+	r, _ := newCachingRegistry(time.Minute)
+	r.addReadOnlyTool(mcp.Tool{Name: "list_sessions"}, countingHandler(new(int)))
+	r.addUncachedPollingTool(mcp.Tool{Name: "wait_for_session_status"}, countingHandler(new(int)))
+	r.addPollingTool(mcp.Tool{Name: "restart_channel"}, countingHandler(new(int)))
+
+	reads := 0
+	list := r.wrap("list_sessions", countingHandler(&reads))
+	callWith(list, nil)
+	callWith(r.wrap("wait_for_session_status", r.handlers["wait_for_session_status"]), map[string]interface{}{"session_id": "session-1"})
+	callWith(list, nil)
+	if reads != 1 {
+		t.Errorf("Expected waiting to leave the cache alone, handler ran %d times", reads)
+	}
+
+	callWith(r.wrap("restart_channel", r.handlers["restart_channel"]), map[string]interface{}{"channel_id": "camera-1"})
+	callWith(list, nil)
+	if reads != 2 {
+		t.Errorf("Expected a polling mutation to clear the cache, handler ran %d times", reads)
+	}
+}
+
+func TestToolCache_ReadOverlappingMutation(t *testing.T) {
+	r, _ := newCachingRegistry(time.Minute)
+	r.readOnly["list_sessions"] = true
+
+	// The slow read fetches its result, then stalls while a mutation runs
+	fetched := make(chan struct{})
+	resume := make(chan struct{})
+	reads := 0
+	list := r.wrap("list_sessions", func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		reads++
+		result := mcp.NewToolResultText("before")
+		if reads == 1 {
+			close(fetched)
+			<-resume
+		}
+		return result, nil
+	})
+	start := r.wrap("start_session", countingHandler(new(int)))
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		callWith(list, nil)
+	}()
+	<-fetched
+	callWith(start, map[string]interface{}{"session_id": "session-1"})
+	close(resume)
+	<-done
+
+	// The stale result from before the mutation must not be served
+	callWith(list, nil)
+	if reads != 2 {
+		t.Errorf("Expected read after overlapping mutation to miss, handler ran %d times", reads)
+	}
+}
+
+func TestToolCache_TTLExpiry(t *testing.T) {
+	r, now := newCachingRegistry(5 * time.Second)
+	r.readOnly["list_channels"] = true
+
+	calls := 0
+	h := r.wrap("list_channels", countingHandler(&calls))
+
+	callWith(h, nil)
+	*now = now.Add(4 * time.Second)
+	callWith(h, nil)
+	if calls != 1 {
+		t.Errorf("Expected hit within TTL, handler ran %d times", calls)
+	}
+
+	*now = now.Add(2 * time.Second)
+	callWith(h, nil)
+	if calls != 2 {
+		t.Errorf("Expected miss after TTL, handler ran %d times", calls)
+	}
+}
+
+func TestToolCache_Disabled(t *testing.T) {
+	r := newRegistry(server.NewMCPServer("video-platform", "test"))
+	r.readOnly["list_channels"] = true
+
+	calls := 0
+	h := r.wrap("list_channels", countingHandler(&calls))
+	callWith(h, nil)
+	callWith(h, nil)
+	if calls != 2 {
+		t.Errorf("Expected no caching by default, handler ran %d times", calls)
+	}
+}
+
+func TestToolCache_MutatingFlag(t *testing.T) {
+	r, _ := newCachingRegistry(time.Minute)
+	r.readOnly["get_clip"] = true
+	r.mutatingFlags["get_clip"] = "record_view"
+
+	calls := 0
+	h := r.wrap("get_clip", countingHandler(&calls))
+
+	callWith(h, map[string]interface{}{"clip_id": "clip-1"})
+	callWith(h, map[string]interface{}{"clip_id": "clip-1"})
+	if calls != 1 {
+		t.Fatalf("Expected plain reads to hit the cache, handler ran %d times", calls)
+	}
+
+	// Recording a view must reach the platform every time, and clears the
+	// cache like any other mutation
+	callWith(h, map[string]interface{}{"clip_id": "clip-1", "record_view": true})
+	callWith(h, map[string]interface{}{"clip_id": "clip-1", "record_view": true})
+	callWith(h, map[string]interface{}{"clip_id": "clip-1"})
+	if calls != 4 {
+		t.Errorf("Expected record_view calls to bypass the cache, handler ran %d times", calls)
+	}
+}
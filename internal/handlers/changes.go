@@ -0,0 +1,252 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// changesSinceLastCall is the since value that uses the watermark of the
+// previous changes_since call
+const changesSinceLastCall = "last_call"
+
+// changesPageSize and changesScanPages bound the client-side fallback: at
+// most this many of the most recent items are scanned per kind
+const (
+	changesPageSize  = 100
+	changesScanPages = 5
+)
+
+// How a change list was computed
+const (
+	mechanismServer = "server_filter"
+	mechanismClient = "client_filter"
+)
+
+// errFilterIgnored means the platform returned items older than
+// updated_since
+var errFilterIgnored = errors.New("updated_since filter ignored")
+
+// changeWatermark remembers when the last successful changes_since call
+// started, for since: "last_call"
+type changeWatermark struct {
+	mu sync.Mutex
+	t  time.Time
+}
+
+func (w *changeWatermark) get() (time.Time, bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.t, !w.t.IsZero()
+}
+
+func (w *changeWatermark) advance(t time.Time) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if t.After(w.t) {
+		w.t = t
+	}
+}
+
+// changeList is the changes of one kind. Complete is false when the
+// client-side fallback stopped scanning before reaching the oldest item.
+type changeList[T any] struct {
+	Mechanism string `json:"mechanism"`
+	Scanned   int    `json:"scanned,omitempty"`
+	Complete  bool   `json:"complete"`
+	Count     int    `json:"count"`
+	Items     []T    `json:"items"`
+}
+
+// pageFunc fetches one page of a listing, filtered server-side by
+// updatedSince when it is set
+type pageFunc[T any] func(ctx context.Context, offset int, updatedSince string) (*videoplatform.PaginatedResponse[T], error)
+
+// changedSince reports whether an item with the given timestamps changed
+// at or after since. Timestamps have second precision, so an item in the
+// same second as since counts as changed; unparseable timestamps count as
+// changed too, so nothing is silently dropped.
+func changedSince(since time.Time, timestamps ...string) bool {
+	parsed := false
+	for _, ts := range timestamps {
+		if ts == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, ts)
+		if err != nil {
+			continue
+		}
+		parsed = true
+		if !t.Before(since) {
+			return true
+		}
+	}
+	return !parsed
+}
+
+// filterRejected reports whether the platform refused the updated_since
+// filter itself rather than failing the listing
+func filterRejected(err error) bool {
+	status := apiStatus(err)
+	return status == http.StatusBadRequest || status == http.StatusUnprocessableEntity || status == http.StatusNotImplemented
+}
+
+// collectChanges lists the items changed since since. It asks the platform
+// to filter with updated_since first; if the platform rejects the filter or
+// returns items that don't match it (i.e. ignored it), it falls back to
+// scanning the most recent pages and filtering client-side.
+func collectChanges[T any](ctx context.Context, since time.Time, list pageFunc[T], changed func(T) bool) (changeList[T], error) {
+	result, err := serverChanges(ctx, since, list, changed)
+	if err == nil {
+		return result, nil
+	}
+	if !errors.Is(err, errFilterIgnored) && !filterRejected(err) {
+		return result, err
+	}
+	return clientChanges(ctx, list, changed)
+}
+
+func serverChanges[T any](ctx context.Context, since time.Time, list pageFunc[T], changed func(T) bool) (changeList[T], error) {
+	result := changeList[T]{Mechanism: mechanismServer, Complete: true, Items: []T{}}
+	for page := 0; page < changesScanPages; page++ {
+		resp, err := list(ctx, len(result.Items), since.UTC().Format(time.RFC3339))
+		if err != nil {
+			return result, err
+		}
+		for _, item := range resp.Data {
+			if !changed(item) {
+				return result, errFilterIgnored
+			}
+		}
+		result.Items = append(result.Items, resp.Data...)
+		if len(resp.Data) == 0 || len(result.Items) >= resp.Total {
+			result.Count = len(result.Items)
+			return result, nil
+		}
+	}
+	result.Complete = false
+	result.Count = len(result.Items)
+	return result, nil
+}
+
+func clientChanges[T any](ctx context.Context, list pageFunc[T], changed func(T) bool) (changeList[T], error) {
+	result := changeList[T]{Mechanism: mechanismClient, Items: []T{}}
+	for page := 0; page < changesScanPages; page++ {
+		resp, err := list(ctx, result.Scanned, "")
+		if err != nil {
+			return result, err
+		}
+		for _, item := range resp.Data {
+			if changed(item) {
+				result.Items = append(result.Items, item)
+			}
+		}
+		result.Scanned += len(resp.Data)
+		if len(resp.Data) == 0 || result.Scanned >= resp.Total {
+			result.Complete = true
+			break
+		}
+	}
+	result.Count = len(result.Items)
+	return result, nil
+}
+
+// changesReport is the result of changes_since
+type changesReport struct {
+	Since    string                            `json:"since"`
+	Until    string                            `json:"until"`
+	Total    int                               `json:"total_changes"`
+	Sessions changeList[videoplatform.Session] `json:"sessions"`
+	Clips    changeList[videoplatform.Clip]    `json:"clips"`
+	Tags     changeList[videoplatform.Tag]     `json:"tags"`
+}
+
+// fetchChanges collects changed sessions, clips and tags concurrently
+func fetchChanges(ctx context.Context, c *videoplatform.Client, since time.Time) (*changesReport, error) {
+	var report changesReport
+	var sessionsErr, clipsErr, tagsErr error
+	var wg sync.WaitGroup
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		report.Sessions, sessionsErr = collectChanges(ctx, since,
+			func(ctx context.Context, offset int, updatedSince string) (*videoplatform.PaginatedResponse[videoplatform.Session], error) {
+				return c.ListSessions(ctx, videoplatform.ListSessionsParams{UpdatedSince: updatedSince, Sort: "-created_at", Limit: changesPageSize, Offset: offset})
+			},
+			func(s videoplatform.Session) bool { return changedSince(since, s.CreatedAt, s.UpdatedAt) })
+	}()
+	go func() {
+		defer wg.Done()
+		report.Clips, clipsErr = collectChanges(ctx, since,
+			func(ctx context.Context, offset int, updatedSince string) (*videoplatform.PaginatedResponse[videoplatform.Clip], error) {
+				return c.ListClips(ctx, videoplatform.ListClipsParams{UpdatedSince: updatedSince, Sort: "-created_at", Limit: changesPageSize, Offset: offset})
+			},
+			func(clip videoplatform.Clip) bool { return changedSince(since, clip.CreatedAt) })
+	}()
+	go func() {
+		defer wg.Done()
+		report.Tags, tagsErr = collectChanges(ctx, since,
+			func(ctx context.Context, offset int, updatedSince string) (*videoplatform.PaginatedResponse[videoplatform.Tag], error) {
+				return c.ListTags(ctx, videoplatform.ListTagsParams{UpdatedSince: updatedSince, Limit: changesPageSize, Offset: offset})
+			},
+			func(tag videoplatform.Tag) bool { return changedSince(since, tag.CreatedAt) })
+	}()
+	wg.Wait()
+
+	switch {
+	case sessionsErr != nil:
+		return nil, fmt.Errorf("sessions: %w", sessionsErr)
+	case clipsErr != nil:
+		return nil, fmt.Errorf("clips: %w", clipsErr)
+	case tagsErr != nil:
+		return nil, fmt.Errorf("tags: %w", tagsErr)
+	}
+	report.Total = report.Sessions.Count + report.Clips.Count + report.Tags.Count
+	return &report, nil
+}
+
+func makeChangesSince(c *videoplatform.Client, watermark *changeWatermark) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		sinceArg, _ := req.Params.Arguments["since"].(string)
+		if sinceArg == "" {
+			return mcp.NewToolResultError("since is required"), nil
+		}
+
+		var since time.Time
+		if sinceArg == changesSinceLastCall {
+			t, ok := watermark.get()
+			if !ok {
+				return mcp.NewToolResultError("No previous changes_since call in this server process; pass an RFC 3339 timestamp instead of last_call"), nil
+			}
+			since = t
+		} else {
+			t, err := time.Parse(time.RFC3339, sinceArg)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid since %q: use an RFC 3339 timestamp like 2026-10-17T19:00:00Z or %s", sinceArg, changesSinceLastCall)), nil
+			}
+			since = t
+		}
+
+		// The watermark is when this call started, so changes made while it
+		// runs are picked up by the next one
+		started := time.Now().Truncate(time.Second)
+		report, err := fetchChanges(ctx, c, since)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list changes: %v", err)), nil
+		}
+		report.Since = since.UTC().Format(time.RFC3339)
+		report.Until = started.UTC().Format(time.RFC3339)
+		watermark.advance(started)
+
+		data, _ := json.MarshalIndent(report, "", "  ")
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
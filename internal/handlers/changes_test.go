@@ -0,0 +1,208 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// filterMode is how a changesPlatform treats updated_since
+type filterMode int
+
+const (
+	filterHonored filterMode = iota
+	filterIgnored
+	filterRejectedBadRequest
+)
+
+// changesPlatform serves one old and one new item of each kind
+func changesPlatform(t *testing.T, mode filterMode) *videoplatform.Client {
+	const oldTS, newTS = "2026-10-01T12:00:00Z", "2026-10-17T19:30:00Z"
+	sessions := []videoplatform.Session{
+		{ID: "s-new", CreatedAt: oldTS, UpdatedAt: newTS},
+		{ID: "s-old", CreatedAt: oldTS, UpdatedAt: oldTS},
+	}
+	clips := []videoplatform.Clip{{ID: "c-new", CreatedAt: newTS}, {ID: "c-old", CreatedAt: oldTS}}
+	tags := []videoplatform.Tag{{ID: "t-new", CreatedAt: newTS}, {ID: "t-old", CreatedAt: oldTS}}
+
+	server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		since := r.URL.Query().Get("updated_since")
+		if since != "" && mode == filterRejectedBadRequest {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]string{"error": "unknown parameter updated_since"})
+			return
+		}
+		// An honoring platform returns only the newest item of each kind
+		n := 2
+		if since != "" && mode == filterHonored {
+			n = 1
+		}
+		switch r.URL.Path {
+		case "/api/v1/sessions":
+			json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Session]{Data: sessions[:n], Total: n})
+		case "/api/v1/clips":
+			json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Clip]{Data: clips[:n], Total: n})
+		case "/api/v1/tags":
+			json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Tag]{Data: tags[:n], Total: n})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	t.Cleanup(server.Close)
+	return videoplatform.New(server.URL)
+}
+
+func callChangesSince(t *testing.T, c *videoplatform.Client, watermark *changeWatermark, since string) *mcp.CallToolResult {
+	t.Helper()
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{"since": since}
+	result, err := makeChangesSince(c, watermark)(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	return result
+}
+
+func changesResult(t *testing.T, result *mcp.CallToolResult) changesReport {
+	t.Helper()
+	if result.IsError {
+		t.Fatalf("Unexpected tool error: %v", result.Content)
+	}
+	var report changesReport
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &report); err != nil {
+		t.Fatalf("result is not JSON: %v", err)
+	}
+	return report
+}
+
+func TestChangesSince(t *testing.T) {
+	tests := []struct {
+		name      string
+		mode      filterMode
+		mechanism string
+		scanned   int
+	}{
+		{"server filter", filterHonored, mechanismServer, 0},
+		{"filter ignored", filterIgnored, mechanismClient, 2},
+		{"filter rejected", filterRejectedBadRequest, mechanismClient, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := changesResult(t, callChangesSince(t, changesPlatform(t, tt.mode), &changeWatermark{}, "2026-10-17T19:00:00Z"))
+
+			if report.Total != 3 {
+				t.Errorf("Total = %d, want 3", report.Total)
+			}
+			if report.Since != "2026-10-17T19:00:00Z" || report.Until == "" {
+				t.Errorf("Since = %q, Until = %q", report.Since, report.Until)
+			}
+			if report.Sessions.Count != 1 || report.Sessions.Items[0].ID != "s-new" {
+				t.Errorf("Sessions = %+v, want only s-new", report.Sessions)
+			}
+			if report.Clips.Count != 1 || report.Clips.Items[0].ID != "c-new" {
+				t.Errorf("Clips = %+v, want only c-new", report.Clips)
+			}
+			if report.Tags.Count != 1 || report.Tags.Items[0].ID != "t-new" {
+				t.Errorf("Tags = %+v, want only t-new", report.Tags)
+			}
+			for kind, got := range map[string]struct {
+				mechanism string
+				scanned   int
+				complete  bool
+			}{
+				"sessions": {report.Sessions.Mechanism, report.Sessions.Scanned, report.Sessions.Complete},
+				"clips":    {report.Clips.Mechanism, report.Clips.Scanned, report.Clips.Complete},
+				"tags":     {report.Tags.Mechanism, report.Tags.Scanned, report.Tags.Complete},
+			} {
+				if got.mechanism != tt.mechanism || got.scanned != tt.scanned || !got.complete {
+					t.Errorf("%s: mechanism %q, scanned %d, complete %v; want %q, %d, true", kind, got.mechanism, got.scanned, got.complete, tt.mechanism, tt.scanned)
+				}
+			}
+		})
+	}
+
+	t.Run("no changes", func(t *testing.T) {
+		report := changesResult(t, callChangesSince(t, changesPlatform(t, filterIgnored), &changeWatermark{}, "2026-10-18T00:00:00Z"))
+		if report.Total != 0 || report.Sessions.Items == nil || len(report.Sessions.Items) != 0 {
+			t.Errorf("report = %+v, want no changes", report)
+		}
+	})
+
+	t.Run("invalid since", func(t *testing.T) {
+		verifyError(t, callChangesSince(t, changesPlatform(t, filterHonored), &changeWatermark{}, "yesterday"), "invalid since")
+	})
+
+	t.Run("missing since", func(t *testing.T) {
+		verifyError(t, callChangesSince(t, changesPlatform(t, filterHonored), &changeWatermark{}, ""), "since is required")
+	})
+
+	t.Run("listing fails", func(t *testing.T) {
+		server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		})
+		defer server.Close()
+		verifyError(t, callChangesSince(t, videoplatform.New(server.URL), &changeWatermark{}, "2026-10-17T19:00:00Z"), "Failed to list changes")
+	})
+}
+
+func TestChangesSince_Watermark(t *testing.T) {
+	c := changesPlatform(t, filterHonored)
+	watermark := &changeWatermark{}
+
+	verifyError(t, callChangesSince(t, c, watermark, changesSinceLastCall), "No previous changes_since call")
+
+	before := time.Now().Truncate(time.Second)
+	first := changesResult(t, callChangesSince(t, c, watermark, "2026-10-17T19:00:00Z"))
+	mark, ok := watermark.get()
+	if !ok || mark.Before(before) {
+		t.Fatalf("watermark = %v, %v; want at least %v", mark, ok, before)
+	}
+	if first.Until != mark.UTC().Format(time.RFC3339) {
+		t.Errorf("Until = %q, want the watermark %v", first.Until, mark)
+	}
+
+	second := changesResult(t, callChangesSince(t, c, watermark, changesSinceLastCall))
+	if second.Since != first.Until {
+		t.Errorf("last_call Since = %q, want previous Until %q", second.Since, first.Until)
+	}
+
+	// A failed call leaves the watermark where it was
+	watermark.advance(mark.Add(time.Hour))
+	failing := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	defer failing.Close()
+	callChangesSince(t, videoplatform.New(failing.URL), watermark, changesSinceLastCall)
+	if got, _ := watermark.get(); !got.Equal(mark.Add(time.Hour)) {
+		t.Errorf("watermark moved to %v after a failed call", got)
+	}
+}
+
+func TestChangedSince(t *testing.T) {
+	since := time.Date(2026, 10, 17, 19, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name       string
+		timestamps []string
+		want       bool
+	}{
+		{"created after", []string{"2026-10-17T19:00:01Z"}, true},
+		{"same second", []string{"2026-10-17T19:00:00Z"}, true},
+		{"older", []string{"2026-10-17T18:59:59Z"}, false},
+		{"updated after", []string{"2026-10-01T00:00:00Z", "2026-10-17T20:00:00Z"}, true},
+		{"offset zone", []string{"2026-10-17T15:30:00-04:00"}, true},
+		{"unparseable", []string{"last tuesday"}, true},
+		{"missing", []string{""}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := changedSince(since, tt.timestamps...); got != tt.want {
+				t.Errorf("changedSince(%v) = %v, want %v", tt.timestamps, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,261 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/Prodro21/video-mcp/internal/fanout"
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// maxBulkChannelChanges bounds how many channels bulk_set_channels
+// changes at once
+const maxBulkChannelChanges = 4
+
+// bulkChannelStates are the states bulk_set_channels can set
+var bulkChannelStates = []string{string(videoplatform.ChannelActive), string(videoplatform.ChannelInactive)}
+
+// bulkChannel is one channel in a bulk_set_channels result. Status is the
+// channel's status after the call; Error says why it couldn't be changed.
+type bulkChannel struct {
+	ID     string                      `json:"id"`
+	Name   string                      `json:"name,omitempty"`
+	Status videoplatform.ChannelStatus `json:"status,omitempty"`
+	Error  string                      `json:"error,omitempty"`
+}
+
+// bulkChannelReport is the result of bulk_set_channels
+type bulkChannelReport struct {
+	State   videoplatform.ChannelStatus `json:"state"`
+	Changed []bulkChannel               `json:"changed"`
+	Skipped []bulkChannel               `json:"skipped"`
+	Failed  []bulkChannel               `json:"failed"`
+}
+
+// Summary renders the report as a single sentence for tool output
+func (r bulkChannelReport) Summary() string {
+	text := fmt.Sprintf("Set channels %s: %d changed, %d already %s", r.State, len(r.Changed), len(r.Skipped), r.State)
+	return bulkSummary(text, r.Failed, describeBulkChannel)
+}
+
+// failures lists the failed channels with their errors
+func (r bulkChannelReport) failures() string {
+	return describeEach(r.Failed, describeBulkChannel)
+}
+
+// describeBulkChannel names a failed channel and its error
+func describeBulkChannel(ch bulkChannel) string {
+	return fmt.Sprintf("%s (%s)", ch.ID, ch.Error)
+}
+
+// channelTargets picks the channels named by ids from a channel list, once
+// each and in the order given. IDs missing from the list get a bare
+// channel with no status.
+func channelTargets(channels []videoplatform.Channel, ids []string) []videoplatform.Channel {
+	known := make(map[string]videoplatform.Channel, len(channels))
+	for _, ch := range channels {
+		known[ch.ID] = ch
+	}
+	targets := make([]videoplatform.Channel, 0, len(ids))
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		ch, ok := known[id]
+		if !ok {
+			ch = videoplatform.Channel{ID: id}
+		}
+		targets = append(targets, ch)
+	}
+	return targets
+}
+
+// setChannelStates activates or deactivates each target concurrently, at
+// most maxBulkChannelChanges at a time, skipping those already in state.
+// A target with an empty status wasn't in the channel list, so it is tried
+// anyway. Deactivations pass the same recording check as
+// deactivate_channel, run once over every channel being switched off; if
+// it refuses, none of them is changed. Failures are collected so one bad
+// channel doesn't stop the rest; results keep the targets' order.
+func setChannelStates(ctx context.Context, c *videoplatform.Client, req mcp.CallToolRequest, targets []videoplatform.Channel, state videoplatform.ChannelStatus) bulkChannelReport {
+	report := bulkChannelReport{State: state, Changed: []bulkChannel{}, Skipped: []bulkChannel{}, Failed: []bulkChannel{}}
+
+	results := make([]bulkChannel, len(targets))
+	changed := make([]bool, len(targets))
+	var pending []string
+	for i, ch := range targets {
+		results[i] = bulkChannel{ID: ch.ID, Name: ch.Name, Status: ch.Status}
+		if ch.Status != state {
+			pending = append(pending, ch.ID)
+		}
+	}
+
+	// The channels switched off together are one change, so the recording
+	// check sees them all at once
+	var refusal string
+	if state == videoplatform.ChannelInactive {
+		if r := recordingGuardAll(ctx, c, req, pending, "deactivating"); r != nil {
+			refusal = r.Content[0].(mcp.TextContent).Text
+		}
+	}
+
+	errs := fanout.Each(ctx, len(targets), maxBulkChannelChanges, func(i int) error {
+		if targets[i].Status == state || refusal != "" {
+			return nil
+		}
+		var updated *videoplatform.Channel
+		var err error
+		if state == videoplatform.ChannelActive {
+			updated, err = c.ActivateChannel(ctx, targets[i].ID)
+		} else {
+			updated, err = c.DeactivateChannel(ctx, targets[i].ID)
+		}
+		if err != nil {
+			return err
+		}
+		results[i].Name, results[i].Status = updated.Name, updated.Status
+		changed[i] = true
+		return nil
+	})
+	for i, ch := range targets {
+		switch err := errs[i]; {
+		case ch.Status == state:
+		case refusal != "":
+			results[i].Error = refusal
+		case apiStatus(err) == http.StatusNotFound:
+			results[i].Error = "channel not found"
+		case err != nil:
+			results[i].Error = err.Error()
+		}
+	}
+
+	for i, result := range results {
+		switch {
+		case changed[i]:
+			report.Changed = append(report.Changed, result)
+		case result.Error != "":
+			report.Failed = append(report.Failed, result)
+		default:
+			report.Skipped = append(report.Skipped, result)
+		}
+	}
+	return report
+}
+
+func makeBulkSetChannels(c *videoplatform.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		s, _ := req.Params.Arguments["state"].(string)
+		if s == "" {
+			return mcp.NewToolResultError("state is required"), nil
+		}
+		if !slices.Contains(bulkChannelStates, s) {
+			return mcp.NewToolResultError(fmt.Sprintf("invalid state %q: must be %s", s, joinOr(bulkChannelStates))), nil
+		}
+		state := videoplatform.ChannelStatus(s)
+
+		ids, err := idsArg(req.Params.Arguments, "channel_ids", "channel")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		all, _ := req.Params.Arguments["all"].(bool)
+		group, _ := req.Params.Arguments["group"].(string)
+		group = strings.TrimSpace(group)
+		selectors := 0
+		for _, set := range []bool{len(ids) > 0, all, group != ""} {
+			if set {
+				selectors++
+			}
+		}
+		switch {
+		case selectors > 1:
+			return mcp.NewToolResultError("Pass only one of channel_ids, group or all: true"), nil
+		case selectors == 0:
+			return mcp.NewToolResultError("channel_ids, group or all: true is required"), nil
+		}
+
+		channels, err := c.ListAllChannels(ctx, videoplatform.ListChannelsParams{Group: group})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list channels: %v", err)), nil
+		}
+
+		targets := channels
+		switch {
+		case len(ids) > 0:
+			targets = channelTargets(channels, ids)
+		case group != "":
+			targets = channelsInGroup(channels, group)
+			if len(targets) == 0 {
+				return mcp.NewToolResultError(fmt.Sprintf("No channels in group %q; check the labels with list_channels", group)), nil
+			}
+		}
+
+		report := setChannelStates(ctx, c, req, targets, state)
+		data, _ := json.MarshalIndent(report, "", "  ")
+		return mcp.NewToolResultText(fmt.Sprintf("%s\n%s", report.Summary(), data)), nil
+	}
+}
+
+// channelsInGroup keeps the channels labelled group, ignoring case, in
+// case the platform doesn't filter by group itself
+func channelsInGroup(channels []videoplatform.Channel, group string) []videoplatform.Channel {
+	var kept []videoplatform.Channel
+	for _, ch := range channels {
+		if ch.Group != nil && strings.EqualFold(strings.TrimSpace(*ch.Group), group) {
+			kept = append(kept, ch)
+		}
+	}
+	return kept
+}
+
+// assignSessionChannels activates the channels a session should record
+// from and, when exclusive, deactivates every other channel. It returns
+// the channels used and those switched off, or a refusal naming the
+// channels that could not be set, in which case the session must not be
+// started.
+func assignSessionChannels(ctx context.Context, c *videoplatform.Client, req mcp.CallToolRequest, ids []string, exclusive bool) (used, deactivated []bulkChannel, refusal *mcp.CallToolResult) {
+	channels, err := c.ListAllChannels(ctx, videoplatform.ListChannelsParams{})
+	if err != nil {
+		return nil, nil, mcp.NewToolResultError(fmt.Sprintf("Session not started: failed to list channels: %v", err))
+	}
+
+	activated := setChannelStates(ctx, c, req, channelTargets(channels, ids), videoplatform.ChannelActive)
+	if len(activated.Failed) > 0 {
+		return nil, nil, mcp.NewToolResultError(fmt.Sprintf("Session not started: could not activate %s", activated.failures()))
+	}
+	used = append(activated.Changed, activated.Skipped...)
+	if !exclusive {
+		return used, nil, nil
+	}
+
+	var others []videoplatform.Channel
+	for _, ch := range channels {
+		if !slices.Contains(ids, ch.ID) {
+			others = append(others, ch)
+		}
+	}
+	switched := setChannelStates(ctx, c, req, others, videoplatform.ChannelInactive)
+	if len(switched.Failed) > 0 {
+		return nil, nil, mcp.NewToolResultError(fmt.Sprintf("Session not started: activated the requested channels but could not deactivate %s", switched.failures()))
+	}
+	return used, switched.Changed, nil
+}
+
+// channelNames renders channels as "Name (id)" for tool output
+func channelNames(channels []bulkChannel) string {
+	names := make([]string, len(channels))
+	for i, ch := range channels {
+		names[i] = ch.ID
+		if ch.Name != "" {
+			names[i] = fmt.Sprintf("%s (%s)", ch.Name, ch.ID)
+		}
+	}
+	return strings.Join(names, ", ")
+}
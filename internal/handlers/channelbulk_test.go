@@ -0,0 +1,220 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestBulkSetChannels(t *testing.T) {
+	var mu sync.Mutex
+	var calls []string
+	north, south := "North Field", "South Field"
+	srv := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/v1/channels":
+			json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Channel]{
+				Data: []videoplatform.Channel{
+					{ID: "cam-1", Name: "Sideline", Status: videoplatform.ChannelInactive, Group: &north},
+					{ID: "cam-2", Name: "End Zone", Status: videoplatform.ChannelActive, Group: &north},
+					{ID: "cam-3", Name: "Press Box", Status: videoplatform.ChannelError},
+					{ID: "cam-4", Name: "Skycam", Status: videoplatform.ChannelInactive, Group: &south},
+				},
+				Total: 4,
+			})
+		case r.URL.Path == "/api/v1/sessions":
+			json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Session]{})
+		case r.Method == http.MethodPost:
+			id, action, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/api/v1/channels/"), "/")
+			mu.Lock()
+			calls = append(calls, id+" "+action)
+			mu.Unlock()
+			switch id {
+			case "cam-3":
+				w.WriteHeader(http.StatusInternalServerError)
+				w.Write([]byte(`{"error":"encoder offline"}`))
+				return
+			case "cam-9":
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			status := videoplatform.ChannelActive
+			if action == "deactivate" {
+				status = videoplatform.ChannelInactive
+			}
+			json.NewEncoder(w).Encode(videoplatform.Channel{ID: id, Name: "Camera " + id, Status: status})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer srv.Close()
+
+	handler := makeBulkSetChannels(videoplatform.New(srv.URL))
+	call := func(args map[string]interface{}) (*mcp.CallToolResult, bulkChannelReport) {
+		t.Helper()
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = args
+		result, err := handler(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		var report bulkChannelReport
+		if !result.IsError {
+			text := result.Content[0].(mcp.TextContent).Text
+			if err := json.Unmarshal([]byte(text[strings.Index(text, "\n")+1:]), &report); err != nil {
+				t.Fatalf("Failed to parse result: %v", err)
+			}
+		}
+		return result, report
+	}
+	ids := func(channels []bulkChannel) []string {
+		var out []string
+		for _, ch := range channels {
+			out = append(out, ch.ID)
+		}
+		return out
+	}
+
+	t.Run("all", func(t *testing.T) {
+		result, report := call(map[string]interface{}{"all": true, "state": "active"})
+		if result.IsError {
+			t.Fatalf("Expected success, got %v", result.Content)
+		}
+		if got := ids(report.Changed); !reflect.DeepEqual(got, []string{"cam-1", "cam-4"}) {
+			t.Errorf("changed = %v", got)
+		}
+		if got := ids(report.Skipped); !reflect.DeepEqual(got, []string{"cam-2"}) {
+			t.Errorf("skipped = %v", got)
+		}
+		if len(report.Failed) != 1 || report.Failed[0].ID != "cam-3" || !strings.Contains(report.Failed[0].Error, "encoder offline") {
+			t.Errorf("failed = %+v", report.Failed)
+		}
+		if text := result.Content[0].(mcp.TextContent).Text; !strings.HasPrefix(text, "Set channels active: 2 changed, 1 already active; 1 failed: cam-3") {
+			t.Errorf("summary = %s", text)
+		}
+	})
+
+	t.Run("channel_ids", func(t *testing.T) {
+		mu.Lock()
+		calls = nil
+		mu.Unlock()
+		_, report := call(map[string]interface{}{"channel_ids": []interface{}{"cam-2", "cam-1", "cam-9", "cam-2"}, "state": "inactive"})
+		if got := ids(report.Changed); !reflect.DeepEqual(got, []string{"cam-2"}) {
+			t.Errorf("changed = %v", got)
+		}
+		if got := ids(report.Skipped); !reflect.DeepEqual(got, []string{"cam-1"}) {
+			t.Errorf("skipped = %v", got)
+		}
+		if len(report.Failed) != 1 || report.Failed[0].Error != "channel not found" {
+			t.Errorf("failed = %+v", report.Failed)
+		}
+		if len(calls) != 2 {
+			t.Errorf("Expected one deactivation each for cam-2 and cam-9, got %v", calls)
+		}
+	})
+
+	t.Run("group", func(t *testing.T) {
+		mu.Lock()
+		calls = nil
+		mu.Unlock()
+		// The mock ignores the group filter, so the handler must apply it
+		_, report := call(map[string]interface{}{"group": "north field", "state": "active"})
+		if got := ids(report.Changed); !reflect.DeepEqual(got, []string{"cam-1"}) {
+			t.Errorf("changed = %v", got)
+		}
+		if got := ids(report.Skipped); !reflect.DeepEqual(got, []string{"cam-2"}) {
+			t.Errorf("skipped = %v", got)
+		}
+		if !reflect.DeepEqual(calls, []string{"cam-1 activate"}) {
+			t.Errorf("Expected only cam-1 to be activated, got %v", calls)
+		}
+	})
+
+	t.Run("invalid arguments", func(t *testing.T) {
+		result, _ := call(map[string]interface{}{"all": true})
+		verifyError(t, result, "state is required")
+		result, _ = call(map[string]interface{}{"all": true, "state": "error"})
+		verifyError(t, result, `invalid state "error": must be active or inactive`)
+		result, _ = call(map[string]interface{}{"state": "active"})
+		verifyError(t, result, "channel_ids, group or all: true is required")
+		result, _ = call(map[string]interface{}{"all": true, "channel_ids": []interface{}{"cam-1"}, "state": "active"})
+		verifyError(t, result, "Pass only one of channel_ids, group or all: true")
+		result, _ = call(map[string]interface{}{"group": "Practice Field", "state": "active"})
+		verifyError(t, result, `No channels in group "Practice Field"`)
+	})
+}
+
+func TestBulkSetChannels_RecordingGuard(t *testing.T) {
+	var mu sync.Mutex
+	var deactivated []string
+	var sessionLists, clipLists int
+	srv := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/v1/channels":
+			json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Channel]{
+				Data: []videoplatform.Channel{
+					{ID: "cam-1", Status: videoplatform.ChannelActive},
+					{ID: "cam-2", Status: videoplatform.ChannelActive},
+				},
+				Total: 2,
+			})
+		case r.URL.Path == "/api/v1/sessions":
+			mu.Lock()
+			sessionLists++
+			mu.Unlock()
+			json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Session]{
+				Data:  []videoplatform.Session{{ID: "s-1", Name: "Week 3", Status: videoplatform.SessionActive}},
+				Total: 1,
+			})
+		case r.URL.Path == "/api/v1/clips":
+			mu.Lock()
+			clipLists++
+			mu.Unlock()
+			// The session has no clips yet
+			json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Clip]{})
+		case r.Method == http.MethodPost:
+			mu.Lock()
+			deactivated = append(deactivated, r.URL.Path)
+			mu.Unlock()
+			json.NewEncoder(w).Encode(videoplatform.Channel{Status: videoplatform.ChannelInactive})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer srv.Close()
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{"all": true, "state": "inactive"}
+	result, err := makeBulkSetChannels(videoplatform.New(srv.URL))(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	text := result.Content[0].(mcp.TextContent).Text
+	if !strings.Contains(text, "2 failed") || !strings.Contains(text, "Channels cam-1, cam-2 are the only active channels while recording active session 'Week 3' (s-1)") {
+		t.Errorf("Expected both channels refused, got %s", text)
+	}
+	if len(deactivated) != 0 {
+		t.Errorf("Expected no deactivations, got %v", deactivated)
+	}
+	if sessionLists != 1 {
+		t.Errorf("Expected the guard to list sessions once, got %d", sessionLists)
+	}
+	if clipLists != 1 {
+		t.Errorf("Expected one clip listing for the one active session, got %d", clipLists)
+	}
+
+	req.Params.Arguments["force"] = true
+	if _, err := makeBulkSetChannels(videoplatform.New(srv.URL))(context.Background(), req); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(deactivated) != 2 {
+		t.Errorf("Expected force to deactivate both channels, got %v", deactivated)
+	}
+}
@@ -0,0 +1,136 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/Prodro21/video-mcp/internal/fanout"
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// maxRecordingChecks bounds how many sessions the recording check lists
+// clips for at once
+const maxRecordingChecks = 4
+
+// recordingGuard returns a refusal result when taking channelID offline
+// would interrupt a live recording and the caller did not pass force, or nil
+// when the change may proceed. A channel is interrupting a recording when an
+// active session has clips from it, or when it is the only active channel
+// while any session is active. action completes "<action> it would stop the
+// recording", e.g. "deactivating". If the check itself fails the change is
+// refused as well.
+func recordingGuard(ctx context.Context, c *videoplatform.Client, req mcp.CallToolRequest, channelID, action string) *mcp.CallToolResult {
+	return recordingGuardAll(ctx, c, req, []string{channelID}, action)
+}
+
+// recordingGuardAll is recordingGuard for channels taken offline together:
+// the change is refused when any of them is recording for an active
+// session, or when they are all the active channels left while a session
+// is active.
+func recordingGuardAll(ctx context.Context, c *videoplatform.Client, req mcp.CallToolRequest, channelIDs []string, action string) *mcp.CallToolResult {
+	if force, _ := req.Params.Arguments["force"].(bool); force || len(channelIDs) == 0 {
+		return nil
+	}
+	subject := strings.Join(channelIDs, ", ")
+
+	var sessions []videoplatform.Session
+	var channels []videoplatform.Channel
+	var sessionsErr, channelsErr error
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		sessions, sessionsErr = c.ListAllSessions(ctx, videoplatform.ListSessionsParams{Status: videoplatform.SessionActive})
+	}()
+	go func() {
+		defer wg.Done()
+		channels, channelsErr = c.ListAllChannels(ctx, videoplatform.ListChannelsParams{})
+	}()
+	wg.Wait()
+
+	if err := sessionsErr; err != nil || channelsErr != nil {
+		if err == nil {
+			err = channelsErr
+		}
+		return mcp.NewToolResultError(fmt.Sprintf("Could not check whether channel %s is recording: %v. Pass force: true to proceed anyway.", subject, err))
+	}
+	if len(sessions) == 0 {
+		return nil
+	}
+
+	// Sessions with clips from a channel are recording from it; one clip
+	// listing per session names every channel it records from
+	recording := make([][]bool, len(sessions))
+	errs := fanout.Each(ctx, len(sessions), maxRecordingChecks, func(i int) error {
+		clips, err := c.ListAllClips(ctx, videoplatform.ListClipsParams{SessionID: sessions[i].ID})
+		if err != nil {
+			return fmt.Errorf("session %s: %w", sessions[i].ID, err)
+		}
+		recording[i] = make([]bool, len(channelIDs))
+		for _, clip := range clips {
+			if j := slices.Index(channelIDs, clip.ChannelID); j >= 0 {
+				recording[i][j] = true
+			}
+		}
+		return nil
+	})
+	if err := errors.Join(errs...); err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Could not check whether channel %s is recording: %v. Pass force: true to proceed anyway.", subject, err))
+	}
+
+	var affected []videoplatform.Session
+	var recorders []string
+	for i, s := range sessions {
+		if slices.Contains(recording[i], true) {
+			affected = append(affected, s)
+		}
+		for j, id := range channelIDs {
+			if recording[i][j] && !slices.Contains(recorders, id) {
+				recorders = append(recorders, id)
+			}
+		}
+	}
+
+	reason := "recording for"
+	if len(affected) == 0 {
+		var remaining, targeted int
+		for _, ch := range channels {
+			if ch.Status != videoplatform.ChannelActive {
+				continue
+			}
+			if slices.Contains(channelIDs, ch.ID) {
+				targeted++
+			} else {
+				remaining++
+			}
+		}
+		if targeted == 0 || remaining > 0 {
+			return nil
+		}
+		reason, affected, recorders = "the only active channel while recording", sessions, channelIDs
+		if len(channelIDs) > 1 {
+			reason = "the only active channels while recording"
+		}
+	}
+
+	names := make([]string, len(affected))
+	for i, s := range affected {
+		names[i] = fmt.Sprintf("'%s' (%s)", s.Name, s.ID)
+	}
+	noun := "session"
+	if len(affected) > 1 {
+		noun = "sessions"
+	}
+	who, verb, pronoun := "Channel", "is", "it"
+	if len(recorders) > 1 {
+		who, verb, pronoun = "Channels", "are", "them"
+	}
+	return mcp.NewToolResultError(fmt.Sprintf(
+		"%s %s %s %s active %s %s; %s %s would stop the recording. Pause or complete the session first, or pass force: true to proceed anyway.",
+		who, strings.Join(recorders, ", "), verb, reason, noun, strings.Join(names, ", "), action, pronoun))
+}
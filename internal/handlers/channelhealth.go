@@ -0,0 +1,165 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// defaultStaleMinutes is how long an active channel may go unseen before
+// channel_health calls it stale
+const defaultStaleMinutes = 10
+
+// neverSeen stands in for a missing or unreadable last_seen_at
+const neverSeen = "never seen"
+
+// failingChannel is a channel in error state
+type failingChannel struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	ErrorMessage string `json:"error_message,omitempty"`
+
+	channel videoplatform.Channel
+}
+
+// staleChannel is an active channel the platform hasn't heard from
+// recently. LastSeen is how long ago it was seen, or "never seen".
+type staleChannel struct {
+	ID         string  `json:"id"`
+	Name       string  `json:"name"`
+	LastSeenAt *string `json:"last_seen_at,omitempty"`
+	LastSeen   string  `json:"last_seen"`
+
+	channel videoplatform.Channel
+}
+
+// channelHealthReport is the result of channel_health
+type channelHealthReport struct {
+	StaleMinutes int              `json:"stale_minutes"`
+	Channels     int              `json:"channels"`
+	ByStatus     map[string]int   `json:"by_status"`
+	Errors       []failingChannel `json:"errors"`
+	Stale        []staleChannel   `json:"stale"`
+}
+
+// assessChannelHealth counts channels by status and picks out those in
+// error and the active ones last seen more than staleAfter before now.
+// Stale channels are listed longest-unseen first, never-seen ones leading.
+func assessChannelHealth(channels []videoplatform.Channel, staleAfter time.Duration, now time.Time) channelHealthReport {
+	report := channelHealthReport{
+		StaleMinutes: int(staleAfter / time.Minute),
+		Channels:     len(channels),
+		ByStatus:     make(map[string]int),
+		Errors:       []failingChannel{},
+		Stale:        []staleChannel{},
+	}
+	seen := make(map[string]time.Time)
+	for _, ch := range channels {
+		report.ByStatus[string(ch.Status)]++
+		switch ch.Status {
+		case videoplatform.ChannelError:
+			failing := failingChannel{ID: ch.ID, Name: ch.Name, channel: ch}
+			if ch.ErrorMessage != nil {
+				failing.ErrorMessage = *ch.ErrorMessage
+			}
+			report.Errors = append(report.Errors, failing)
+		case videoplatform.ChannelActive:
+			stale := staleChannel{ID: ch.ID, Name: ch.Name, LastSeenAt: ch.LastSeenAt, LastSeen: neverSeen, channel: ch}
+			if ch.LastSeenAt != nil {
+				if t, err := time.Parse(time.RFC3339, *ch.LastSeenAt); err == nil {
+					if now.Sub(t) <= staleAfter {
+						continue
+					}
+					seen[ch.ID] = t
+					stale.LastSeen = formatElapsed(now.Sub(t)) + " ago"
+				}
+			}
+			report.Stale = append(report.Stale, stale)
+		}
+	}
+	sort.SliceStable(report.Stale, func(i, j int) bool {
+		ti, iSeen := seen[report.Stale[i].ID]
+		tj, jSeen := seen[report.Stale[j].ID]
+		if iSeen != jSeen {
+			return !iSeen
+		}
+		return ti.Before(tj)
+	})
+	return report
+}
+
+// summary is the report's one-line verdict, e.g.
+// "❌ 6 channels: 4 active, 1 inactive, 1 error; 1 in error, 2 stale"
+func (r channelHealthReport) summary(p presenter) string {
+	level := levelOK
+	if len(r.Stale) > 0 {
+		level = levelWarn
+	}
+	if len(r.Errors) > 0 {
+		level = levelFail
+	}
+
+	statuses := make([]string, 0, len(r.ByStatus))
+	for status := range r.ByStatus {
+		statuses = append(statuses, status)
+	}
+	sort.Strings(statuses)
+	counts := make([]string, len(statuses))
+	for i, status := range statuses {
+		counts[i] = fmt.Sprintf("%d %s", r.ByStatus[status], status)
+	}
+	line := fmt.Sprintf("%s %d channels", p.glyphs[level], r.Channels)
+	if len(counts) > 0 {
+		line += ": " + strings.Join(counts, ", ")
+	}
+	return fmt.Sprintf("%s; %d in error, %d stale (unseen for over %d minutes)", line, len(r.Errors), len(r.Stale), r.StaleMinutes)
+}
+
+// lines renders the summary and then each channel in error or stale on a
+// status line of its own, e.g.
+// "- ⚠️ End Zone (cam-2): active, last seen 30m ago"
+func (r channelHealthReport) lines(p presenter) string {
+	var b strings.Builder
+	b.WriteString(r.summary(p))
+	for _, ch := range r.Errors {
+		fmt.Fprintf(&b, "\n- %s", p.channelLine(ch.channel))
+	}
+	for _, ch := range r.Stale {
+		level := max(classifyStatus(ch.channel.Status, ch.channel.ErrorMessage), levelWarn)
+		seen := ch.LastSeen
+		if seen != neverSeen {
+			seen = "last seen " + seen
+		}
+		fmt.Fprintf(&b, "\n- %s, %s", p.channelLineAt(level, ch.channel), seen)
+	}
+	return b.String()
+}
+
+func makeChannelHealth(c *videoplatform.Client, p presenter) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		staleMinutes := defaultStaleMinutes
+		if n, ok := req.Params.Arguments["stale_minutes"].(float64); ok {
+			if n < 1 {
+				return mcp.NewToolResultError("stale_minutes must be at least 1"), nil
+			}
+			staleMinutes = int(n)
+		}
+
+		channels, err := c.ListAllChannels(ctx, videoplatform.ListChannelsParams{})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list channels: %v", err)), nil
+		}
+
+		report := assessChannelHealth(channels, time.Duration(staleMinutes)*time.Minute, time.Now())
+		text := report.lines(p)
+		data, _ := json.MarshalIndent(report, "", "  ")
+		return mcp.NewToolResultText(fmt.Sprintf("%s\n%s", text, data)), nil
+	}
+}
@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestAssessChannelHealth(t *testing.T) {
+	now := time.Date(2026, 10, 17, 19, 0, 0, 0, time.UTC)
+	recent, old, older, garbled := "2026-10-17T18:58:00Z", "2026-10-17T18:30:00Z", "2026-10-17T16:15:00Z", "yesterday"
+	msg := "RTMP handshake failed"
+	channels := []videoplatform.Channel{
+		{ID: "cam-1", Name: "Sideline", Status: videoplatform.ChannelActive, LastSeenAt: &recent},
+		{ID: "cam-2", Name: "End Zone", Status: videoplatform.ChannelActive, LastSeenAt: &old},
+		{ID: "cam-3", Name: "Press Box", Status: videoplatform.ChannelActive},
+		{ID: "cam-4", Name: "Skycam", Status: videoplatform.ChannelActive, LastSeenAt: &garbled},
+		{ID: "cam-5", Name: "Tunnel", Status: videoplatform.ChannelActive, LastSeenAt: &older},
+		{ID: "cam-6", Name: "Booth", Status: videoplatform.ChannelError, ErrorMessage: &msg},
+		{ID: "cam-7", Name: "Spare", Status: videoplatform.ChannelInactive, LastSeenAt: &older},
+	}
+
+	report := assessChannelHealth(channels, 10*time.Minute, now)
+	if want := map[string]int{"active": 5, "error": 1, "inactive": 1}; !reflect.DeepEqual(report.ByStatus, want) {
+		t.Errorf("ByStatus = %v, want %v", report.ByStatus, want)
+	}
+	if len(report.Errors) != 1 || report.Errors[0].ID != "cam-6" || report.Errors[0].Name != "Booth" || report.Errors[0].ErrorMessage != msg {
+		t.Errorf("Errors = %+v, want cam-6 Booth with its message", report.Errors)
+	}
+
+	var got []string
+	for _, ch := range report.Stale {
+		got = append(got, ch.ID+" "+ch.LastSeen)
+	}
+	want := []string{"cam-3 never seen", "cam-4 never seen", "cam-5 2h 45m ago", "cam-2 30m ago"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Stale = %v, want %v", got, want)
+	}
+
+	lines := report.lines(newPresenter(true))
+	wantLines := strings.Join([]string{
+		"[FAIL] 7 channels: 5 active, 1 error, 1 inactive; 1 in error, 4 stale (unseen for over 10 minutes)",
+		"- [FAIL] Booth (cam-6): error - RTMP handshake failed",
+		"- [WARN] Press Box (cam-3): active, never seen",
+		"- [WARN] Skycam (cam-4): active, never seen",
+		"- [WARN] Tunnel (cam-5): active, last seen 2h 45m ago",
+		"- [WARN] End Zone (cam-2): active, last seen 30m ago",
+	}, "\n")
+	if lines != wantLines {
+		t.Errorf("lines =\n%s\nwant\n%s", lines, wantLines)
+	}
+}
+
+func TestChannelHealth(t *testing.T) {
+	recent := time.Now().Add(-20 * time.Minute).UTC().Format(time.RFC3339)
+	srv := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Channel]{
+			Data:  []videoplatform.Channel{{ID: "cam-1", Name: "Sideline", Status: videoplatform.ChannelActive, LastSeenAt: &recent}},
+			Total: 1,
+		})
+	})
+	defer srv.Close()
+
+	handler := makeChannelHealth(videoplatform.New(srv.URL), newPresenter(true))
+	call := func(args map[string]interface{}) *mcp.CallToolResult {
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = args
+		result, err := handler(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return result
+	}
+
+	result := call(map[string]interface{}{})
+	if text := result.Content[0].(mcp.TextContent).Text; result.IsError || !strings.HasPrefix(text, "[WARN] 1 channels: 1 active; 0 in error, 1 stale") {
+		t.Errorf("Expected the channel to be stale at the default threshold, got %s", text)
+	}
+
+	result = call(map[string]interface{}{"stale_minutes": float64(30)})
+	if text := result.Content[0].(mcp.TextContent).Text; result.IsError || !strings.HasPrefix(text, "[OK] 1 channels: 1 active; 0 in error, 0 stale") {
+		t.Errorf("Expected no stale channels within 30 minutes, got %s", text)
+	}
+
+	verifyError(t, call(map[string]interface{}{"stale_minutes": float64(0)}), "stale_minutes must be at least 1")
+}
+
+func TestChannelHealth_EveryPage(t *testing.T) {
+	srv := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		// One channel per page, whatever limit is asked for
+		page := []videoplatform.Channel{{ID: "cam-1", Status: videoplatform.ChannelInactive}}
+		if r.URL.Query().Get("offset") == "1" {
+			msg := "no signal"
+			page = []videoplatform.Channel{{ID: "cam-2", Status: videoplatform.ChannelError, ErrorMessage: &msg}}
+		}
+		json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Channel]{Data: page, Total: 2})
+	})
+	defer srv.Close()
+
+	result, err := makeChannelHealth(videoplatform.New(srv.URL), newPresenter(true))(context.Background(), mcp.CallToolRequest{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if text := result.Content[0].(mcp.TextContent).Text; !strings.HasPrefix(text, "[FAIL] 2 channels") || !strings.Contains(text, "cam-2") {
+		t.Errorf("Expected both pages checked, got %s", text)
+	}
+}
@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// restartWaitTimeout caps how long restart_channel waits for the channel
+// to come back
+const restartWaitTimeout = 30 * time.Second
+
+// errRestartTimeout reports a restarted channel that didn't settle in time
+var errRestartTimeout = errors.New("channel did not settle in time")
+
+// channelSettled reports whether a restarted channel has come back active
+// or failed with an error
+func channelSettled(ch *videoplatform.Channel) bool {
+	return ch.Status == videoplatform.ChannelActive || (ch.ErrorMessage != nil && *ch.ErrorMessage != "")
+}
+
+// waitForChannel polls the channel every interval until it settles. On
+// timeout it returns the last channel observed with errRestartTimeout; it
+// stops with ctx's error when ctx is done.
+func waitForChannel(ctx context.Context, c *videoplatform.Client, channelID string, timeout, interval time.Duration) (*videoplatform.Channel, error) {
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var last *videoplatform.Channel
+	for {
+		select {
+		case <-ctx.Done():
+			return last, ctx.Err()
+		case <-waitCtx.Done():
+			return last, errRestartTimeout
+		case <-ticker.C:
+		}
+
+		ch, err := c.GetChannel(waitCtx, channelID)
+		switch {
+		case ctx.Err() != nil:
+			return last, ctx.Err()
+		case waitCtx.Err() != nil:
+			return last, errRestartTimeout
+		case err != nil:
+			return last, err
+		}
+		last = ch
+		if channelSettled(ch) {
+			return ch, nil
+		}
+	}
+}
+
+func makeRestartChannel(c *videoplatform.Client, interval time.Duration) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		channelID, _ := req.Params.Arguments["channel_id"].(string)
+		if channelID == "" {
+			return mcp.NewToolResultError("channel_id is required"), nil
+		}
+		wait := true
+		if w, ok := req.Params.Arguments["wait"].(bool); ok {
+			wait = w
+		}
+
+		started := time.Now()
+		ch, err := c.RestartChannel(ctx, channelID)
+		if err != nil {
+			if apiStatus(err) == http.StatusNotFound {
+				return mcp.NewToolResultError(fmt.Sprintf("Channel %s not found", channelID)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to restart channel: %v", err)), nil
+		}
+		if !wait {
+			return mcp.NewToolResultText(fmt.Sprintf("Channel '%s' is restarting. Status: %s. Check it with get_channel or test_channel.", ch.Name, ch.Status)), nil
+		}
+
+		// An error in the restart answer may predate the restart, so only an
+		// active status ends the wait before the first poll
+		if ch.Status != videoplatform.ChannelActive {
+			last, err := waitForChannel(ctx, c, channelID, restartWaitTimeout, interval)
+			if last != nil {
+				ch = last
+			}
+			switch {
+			case errors.Is(err, errRestartTimeout):
+				return mcp.NewToolResultError(fmt.Sprintf("Channel '%s' restarted but is still %s after %s. Check its signal with test_channel.", ch.Name, ch.Status, restartWaitTimeout)), nil
+			case errors.Is(err, context.Canceled):
+				return mcp.NewToolResultError(fmt.Sprintf("Stopped waiting for channel %s: %v", channelID, err)), nil
+			case err != nil:
+				return mcp.NewToolResultError(fmt.Sprintf("Channel '%s' restarted but checking it failed: %v", ch.Name, err)), nil
+			}
+		}
+
+		data, _ := json.MarshalIndent(ch, "", "  ")
+		if ch.Status != videoplatform.ChannelActive {
+			return mcp.NewToolResultError(fmt.Sprintf("Channel '%s' restarted but reports an error: %s\n%s", ch.Name, *ch.ErrorMessage, data)), nil
+		}
+		return mcp.NewToolResultText(fmt.Sprintf("Channel '%s' is active after %s:\n%s", ch.Name, time.Since(started).Round(time.Second), data)), nil
+	}
+}
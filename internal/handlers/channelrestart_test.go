@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// restartPlatform restarts camera-1 into the error state and then serves
+// it with each of states in turn, repeating the last one
+func restartPlatform(t *testing.T, polls *atomic.Int32, states ...videoplatform.Channel) *videoplatform.Client {
+	stale := "no signal"
+	srv := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/channels/camera-1/restart":
+			json.NewEncoder(w).Encode(videoplatform.Channel{ID: "camera-1", Name: "End Zone", Status: videoplatform.ChannelError, ErrorMessage: &stale})
+		case r.URL.Path == "/api/v1/channels/camera-1":
+			n := int(polls.Add(1)) - 1
+			json.NewEncoder(w).Encode(states[min(n, len(states)-1)])
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	t.Cleanup(srv.Close)
+	return videoplatform.New(srv.URL)
+}
+
+func TestRestartChannel(t *testing.T) {
+	inactive := videoplatform.Channel{ID: "camera-1", Name: "End Zone", Status: videoplatform.ChannelInactive}
+	active := videoplatform.Channel{ID: "camera-1", Name: "End Zone", Status: videoplatform.ChannelActive}
+	refused := "RTSP connection refused"
+	failed := videoplatform.Channel{ID: "camera-1", Name: "End Zone", Status: videoplatform.ChannelError, ErrorMessage: &refused}
+
+	restart := func(ctx context.Context, c *videoplatform.Client, args map[string]interface{}) *mcp.CallToolResult {
+		t.Helper()
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = args
+		result, err := makeRestartChannel(c, time.Millisecond)(ctx, req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return result
+	}
+
+	t.Run("comes back active", func(t *testing.T) {
+		var polls atomic.Int32
+		c := restartPlatform(t, &polls, inactive, inactive, active)
+		result := restart(context.Background(), c, map[string]interface{}{"channel_id": "camera-1"})
+		if result.IsError {
+			t.Fatalf("Unexpected tool error: %v", result.Content)
+		}
+		if text := result.Content[0].(mcp.TextContent).Text; !strings.HasPrefix(text, "Channel 'End Zone' is active after") {
+			t.Errorf("result = %q", text)
+		}
+		if polls.Load() != 3 {
+			t.Errorf("polls = %d, want 3", polls.Load())
+		}
+	})
+
+	t.Run("reports an error", func(t *testing.T) {
+		var polls atomic.Int32
+		c := restartPlatform(t, &polls, inactive, failed)
+		verifyError(t, restart(context.Background(), c, map[string]interface{}{"channel_id": "camera-1"}), "reports an error: RTSP connection refused")
+	})
+
+	t.Run("without waiting", func(t *testing.T) {
+		var polls atomic.Int32
+		c := restartPlatform(t, &polls, active)
+		result := restart(context.Background(), c, map[string]interface{}{"channel_id": "camera-1", "wait": false})
+		if result.IsError || polls.Load() != 0 {
+			t.Errorf("Expected success without polling, got %d polls, %v", polls.Load(), result.Content)
+		}
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		var polls atomic.Int32
+		c := restartPlatform(t, &polls, inactive)
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		done := make(chan *mcp.CallToolResult)
+		go func() {
+			req := mcp.CallToolRequest{}
+			req.Params.Arguments = map[string]interface{}{"channel_id": "camera-1"}
+			result, _ := makeRestartChannel(c, time.Millisecond)(ctx, req)
+			done <- result
+		}()
+		select {
+		case result := <-done:
+			if !result.IsError {
+				t.Errorf("Expected an error after cancellation, got %v", result.Content)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("wait loop did not stop when the context ended")
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		var polls atomic.Int32
+		c := restartPlatform(t, &polls, active)
+		verifyError(t, restart(context.Background(), c, map[string]interface{}{"channel_id": "camera-9"}), "Channel camera-9 not found")
+	})
+}
@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"text/tabwriter"
+	"time"
+
+	"github.com/Prodro21/video-mcp/internal/fanout"
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// maxStatsFetches bounds how many channels' stats channel_stats fetches
+// at once
+const maxStatsFetches = 4
+
+// statNA stands in for a stat the platform has no reading for
+const statNA = "n/a"
+
+// channelStatsRow is one channel's stats, or the error fetching them
+type channelStatsRow struct {
+	channel videoplatform.Channel
+	stats   *videoplatform.ChannelStats
+	err     error
+}
+
+// statFields renders the bitrate, framerate, dropped frames and uptime. A
+// zero bitrate, framerate or uptime means the platform has no reading
+// rather than a stalled channel, so those show as n/a like missing ones;
+// a dropped-frame count of zero is only trusted once the channel has
+// uptime.
+func statFields(s videoplatform.ChannelStats) (bitrate, fps, dropped, uptime string) {
+	bitrate, fps, dropped, uptime = statNA, statNA, statNA, statNA
+	if s.BitrateKbps != nil && *s.BitrateKbps > 0 {
+		bitrate = fmt.Sprintf("%g kbps", *s.BitrateKbps)
+	}
+	if s.FPSActual != nil && *s.FPSActual > 0 {
+		fps = fmt.Sprintf("%g", *s.FPSActual)
+	}
+	up := s.UptimeSeconds != nil && *s.UptimeSeconds > 0
+	if up {
+		uptime = formatElapsed(time.Duration(*s.UptimeSeconds * float64(time.Second)))
+	}
+	if s.DroppedFrames != nil && (*s.DroppedFrames > 0 || up) {
+		dropped = fmt.Sprintf("%d", *s.DroppedFrames)
+	}
+	return bitrate, fps, dropped, uptime
+}
+
+// channelStatsSummary is the one-line reading of a channel's stats, e.g.
+// "camera-2: bitrate 4500 kbps, fps 59.94, dropped frames 12, uptime 2h 5m"
+func channelStatsSummary(channelID string, s videoplatform.ChannelStats) string {
+	bitrate, fps, dropped, uptime := statFields(s)
+	summary := fmt.Sprintf("%s: bitrate %s, fps %s, dropped frames %s, uptime %s", channelID, bitrate, fps, dropped, uptime)
+	if s.LastError != nil && *s.LastError != "" {
+		summary += "; last error: " + *s.LastError
+	}
+	return summary
+}
+
+// channelStatsTable renders one row per channel
+func channelStatsTable(rows []channelStatsRow) string {
+	var buf bytes.Buffer
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "CHANNEL\tBITRATE\tFPS\tDROPPED\tUPTIME\tLAST ERROR")
+	for _, row := range rows {
+		name := fmt.Sprintf("%s (%s)", row.channel.Name, row.channel.ID)
+		if row.err != nil {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\tstats unavailable: %v\n", name, statNA, statNA, statNA, statNA, row.err)
+			continue
+		}
+		bitrate, fps, dropped, uptime := statFields(*row.stats)
+		lastError := "-"
+		if row.stats.LastError != nil && *row.stats.LastError != "" {
+			lastError = *row.stats.LastError
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", name, bitrate, fps, dropped, uptime, lastError)
+	}
+	w.Flush()
+	return buf.String()
+}
+
+// activeChannelStats fetches the stats of every active channel
+// concurrently, at most maxStatsFetches at a time, in list order
+func activeChannelStats(ctx context.Context, c *videoplatform.Client, channels []videoplatform.Channel) []channelStatsRow {
+	var rows []channelStatsRow
+	for _, ch := range channels {
+		if ch.Status == videoplatform.ChannelActive {
+			rows = append(rows, channelStatsRow{channel: ch})
+		}
+	}
+
+	errs := fanout.Each(ctx, len(rows), maxStatsFetches, func(i int) (err error) {
+		rows[i].stats, err = c.GetChannelStats(ctx, rows[i].channel.ID)
+		return err
+	})
+	for i, err := range errs {
+		rows[i].err = err
+	}
+	return rows
+}
+
+func makeChannelStats(c *videoplatform.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		channelID, _ := req.Params.Arguments["channel_id"].(string)
+		all, _ := req.Params.Arguments["all"].(bool)
+		switch {
+		case all && channelID != "":
+			return mcp.NewToolResultError("Pass channel_id or all: true, not both"), nil
+		case !all && channelID == "":
+			return mcp.NewToolResultError("channel_id or all: true is required"), nil
+		}
+
+		if !all {
+			stats, err := c.GetChannelStats(ctx, channelID)
+			if err != nil {
+				if apiStatus(err) == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("Channel %s not found", channelID)), nil
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to get channel stats: %v", err)), nil
+			}
+			data, _ := json.MarshalIndent(stats, "", "  ")
+			return mcp.NewToolResultText(fmt.Sprintf("%s\n%s", channelStatsSummary(channelID, *stats), data)), nil
+		}
+
+		channels, err := c.ListAllChannels(ctx, videoplatform.ListChannelsParams{Status: videoplatform.ChannelActive})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list channels: %v", err)), nil
+		}
+		rows := activeChannelStats(ctx, c, channels)
+		if len(rows) == 0 {
+			return mcp.NewToolResultText("No active channels"), nil
+		}
+		return mcp.NewToolResultText(fmt.Sprintf("Stats for %d active channels:\n%s", len(rows), channelStatsTable(rows))), nil
+	}
+}
@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestChannelStatsSummary(t *testing.T) {
+	f := func(v float64) *float64 { return &v }
+	n := func(v int) *int { return &v }
+	lastErr := "packet loss"
+	tests := []struct {
+		name  string
+		stats videoplatform.ChannelStats
+		want  string
+	}{
+		{
+			"full reading",
+			videoplatform.ChannelStats{BitrateKbps: f(4500), FPSActual: f(59.94), DroppedFrames: n(12), UptimeSeconds: f(7500), LastError: &lastErr},
+			"camera-2: bitrate 4500 kbps, fps 59.94, dropped frames 12, uptime 2h 5m; last error: packet loss",
+		},
+		{
+			"zero drops while up",
+			videoplatform.ChannelStats{BitrateKbps: f(4500), FPSActual: f(30), DroppedFrames: n(0), UptimeSeconds: f(600)},
+			"camera-2: bitrate 4500 kbps, fps 30, dropped frames 0, uptime 10m",
+		},
+		{
+			"zeros without a reading",
+			videoplatform.ChannelStats{BitrateKbps: f(0), FPSActual: f(0), DroppedFrames: n(0), UptimeSeconds: f(0)},
+			"camera-2: bitrate n/a, fps n/a, dropped frames n/a, uptime n/a",
+		},
+		{
+			"missing",
+			videoplatform.ChannelStats{},
+			"camera-2: bitrate n/a, fps n/a, dropped frames n/a, uptime n/a",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := channelStatsSummary("camera-2", tt.stats); got != tt.want {
+				t.Errorf("channelStatsSummary() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChannelStats(t *testing.T) {
+	srv := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/channels":
+			if r.URL.Query().Get("status") != "active" {
+				t.Errorf("Expected status=active, got %s", r.URL.RawQuery)
+			}
+			w.Write([]byte(`{"data": [
+				{"id": "cam-1", "name": "Sideline", "status": "active"},
+				{"id": "cam-2", "name": "End Zone", "status": "active"},
+				{"id": "cam-3", "name": "Spare", "status": "inactive"}
+			], "total": 3}`))
+		case "/api/v1/channels/cam-1/stats":
+			w.Write([]byte(`{"bitrate_kbps": 4500, "fps_actual": 59.94, "dropped_frames": 3, "uptime_seconds": 120}`))
+		case "/api/v1/channels/cam-2/stats":
+			w.WriteHeader(http.StatusBadGateway)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer srv.Close()
+
+	handler := makeChannelStats(videoplatform.New(srv.URL))
+	call := func(args map[string]interface{}) *mcp.CallToolResult {
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = args
+		result, err := handler(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return result
+	}
+
+	result := call(map[string]interface{}{"channel_id": "cam-1"})
+	if text := result.Content[0].(mcp.TextContent).Text; result.IsError || !strings.HasPrefix(text, "cam-1: bitrate 4500 kbps, fps 59.94, dropped frames 3, uptime 2m") {
+		t.Errorf("Unexpected single-channel result: %s", text)
+	}
+
+	result = call(map[string]interface{}{"all": true})
+	text := result.Content[0].(mcp.TextContent).Text
+	if result.IsError || !strings.HasPrefix(text, "Stats for 2 active channels:\nCHANNEL") {
+		t.Fatalf("Unexpected table: %s", text)
+	}
+	if !strings.Contains(text, "Sideline (cam-1)") || !strings.Contains(text, "stats unavailable") || strings.Contains(text, "cam-3") {
+		t.Errorf("Expected rows for the active channels only, got %s", text)
+	}
+
+	verifyError(t, call(map[string]interface{}{"channel_id": "cam-9"}), "Channel cam-9 not found")
+	verifyError(t, call(map[string]interface{}{}), "channel_id or all: true is required")
+}
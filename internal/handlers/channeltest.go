@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// channelProbeTimeout bounds how long test_channel waits for a signal
+const channelProbeTimeout = 10 * time.Second
+
+// probeTimedOut reports whether a probe failed for lack of an answer
+// rather than an error, whether here or at the platform
+func probeTimedOut(err error) bool {
+	var netErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout()) {
+		return true
+	}
+	status := apiStatus(err)
+	return status == http.StatusRequestTimeout || status == http.StatusGatewayTimeout
+}
+
+// videoFormat renders a detected resolution and framerate the way staff
+// say them, e.g. "1080p60"; a resolution of another form is kept as is
+func videoFormat(resolution *string, framerate *float64) string {
+	var format string
+	if resolution != nil {
+		format = *resolution
+		if _, height, ok := strings.Cut(format, "x"); ok {
+			format = height + "p"
+		}
+	}
+	if framerate != nil {
+		if format == "" {
+			return fmt.Sprintf("%gfps", *framerate)
+		}
+		format += fmt.Sprintf("%g", *framerate)
+	}
+	return format
+}
+
+// channelTestSummary is the one-line verdict of a probe, e.g.
+// "camera-2: reachable, 1080p60, 42ms"
+func channelTestSummary(channelID string, result *videoplatform.ChannelTestResult) string {
+	if !result.Reachable {
+		summary := channelID + ": unreachable"
+		if result.Error != nil && *result.Error != "" {
+			summary += " (" + *result.Error + ")"
+		}
+		return summary
+	}
+	parts := []string{channelID + ": reachable"}
+	if format := videoFormat(result.DetectedResolution, result.DetectedFramerate); format != "" {
+		parts = append(parts, format)
+	}
+	if result.LatencyMS != nil {
+		parts = append(parts, fmt.Sprintf("%dms", *result.LatencyMS))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func makeTestChannel(c *videoplatform.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		channelID, _ := req.Params.Arguments["channel_id"].(string)
+		if channelID == "" {
+			return mcp.NewToolResultError("channel_id is required"), nil
+		}
+
+		probeCtx, cancel := context.WithTimeout(ctx, channelProbeTimeout)
+		defer cancel()
+		result, err := c.TestChannel(probeCtx, channelID)
+		if err != nil {
+			switch {
+			case apiStatus(err) == http.StatusNotFound:
+				return mcp.NewToolResultError(fmt.Sprintf("Channel %s not found", channelID)), nil
+			case ctx.Err() == nil && probeTimedOut(err):
+				return mcp.NewToolResultError(fmt.Sprintf("%s: no signal within %s", channelID, channelProbeTimeout)), nil
+			default:
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to test channel: %v", err)), nil
+			}
+		}
+
+		data, _ := json.MarshalIndent(result, "", "  ")
+		return mcp.NewToolResultText(fmt.Sprintf("%s\n%s", channelTestSummary(channelID, result), data)), nil
+	}
+}
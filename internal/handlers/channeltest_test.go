@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestChannelTestSummary(t *testing.T) {
+	latency, hd, sd, fps, ntsc := 42, "1920x1080", "NTSC", 60.0, 29.97
+	noSignal := "connection refused"
+	tests := []struct {
+		result videoplatform.ChannelTestResult
+		want   string
+	}{
+		{videoplatform.ChannelTestResult{Reachable: true, LatencyMS: &latency, DetectedResolution: &hd, DetectedFramerate: &fps}, "camera-2: reachable, 1080p60, 42ms"},
+		{videoplatform.ChannelTestResult{Reachable: true, DetectedResolution: &sd, DetectedFramerate: &ntsc}, "camera-2: reachable, NTSC29.97"},
+		{videoplatform.ChannelTestResult{Reachable: true}, "camera-2: reachable"},
+		{videoplatform.ChannelTestResult{Error: &noSignal}, "camera-2: unreachable (connection refused)"},
+	}
+	for _, tt := range tests {
+		if got := channelTestSummary("camera-2", &tt.result); got != tt.want {
+			t.Errorf("channelTestSummary() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestTestChannel(t *testing.T) {
+	latency, resolution, fps := 42, "1920x1080", 60.0
+	srv := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/channels/camera-2/test":
+			json.NewEncoder(w).Encode(videoplatform.ChannelTestResult{Reachable: true, LatencyMS: &latency, DetectedResolution: &resolution, DetectedFramerate: &fps})
+		case "/api/v1/channels/camera-3/test":
+			time.Sleep(100 * time.Millisecond)
+		case "/api/v1/channels/camera-4/test":
+			w.WriteHeader(http.StatusGatewayTimeout)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer srv.Close()
+	probe := func(c *videoplatform.Client, channelID string) *mcp.CallToolResult {
+		t.Helper()
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"channel_id": channelID}
+		result, err := makeTestChannel(c)(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return result
+	}
+	c := videoplatform.New(srv.URL)
+
+	result := probe(c, "camera-2")
+	if result.IsError {
+		t.Fatalf("Unexpected tool error: %v", result.Content)
+	}
+	if text := result.Content[0].(mcp.TextContent).Text; !strings.HasPrefix(text, "camera-2: reachable, 1080p60, 42ms\n{") {
+		t.Errorf("result = %q", text)
+	}
+
+	verifyError(t, probe(videoplatform.New(srv.URL, videoplatform.WithTimeout(10*time.Millisecond)), "camera-3"), "camera-3: no signal within 10s")
+	verifyError(t, probe(c, "camera-4"), "camera-4: no signal within 10s")
+	verifyError(t, probe(c, "camera-9"), "Channel camera-9 not found")
+}
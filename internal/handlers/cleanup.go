@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// defaultCleanupAgeDays is how old an empty session must be before
+// cleanup_empty_sessions considers it
+const defaultCleanupAgeDays = 7
+
+// cleanupAction returns what cleanup does to an empty session in the given
+// status. Sessions in any other status are never touched.
+func cleanupAction(status videoplatform.SessionStatus) (string, bool) {
+	switch status {
+	case videoplatform.SessionScheduled:
+		return "cancel", true
+	case videoplatform.SessionCompleted:
+		return "trash", true
+	default:
+		return "", false
+	}
+}
+
+// emptySessionCandidates returns sessions with no clips or tags, older than
+// minAge, in a status cleanup may act on
+func emptySessionCandidates(sessions []videoplatform.Session, minAge time.Duration, now time.Time) []videoplatform.Session {
+	var candidates []videoplatform.Session
+	for _, s := range sessions {
+		if _, ok := cleanupAction(s.Status); !ok {
+			continue
+		}
+		if s.ClipCount != 0 || s.TagCount != 0 {
+			continue
+		}
+		age, ok := sessionAge(s, now)
+		if !ok || age < minAge {
+			continue
+		}
+		candidates = append(candidates, s)
+	}
+	return candidates
+}
+
+// cleanupOutcome reports what happened to one session
+type cleanupOutcome struct {
+	SessionID string                      `json:"session_id"`
+	Name      string                      `json:"name"`
+	Status    videoplatform.SessionStatus `json:"status"`
+	Action    string                      `json:"action"`
+	Result    string                      `json:"result"`
+}
+
+func makeCleanupEmptySessions(c *videoplatform.Client, locks *sessionLocks) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		days := float64(defaultCleanupAgeDays)
+		if d, ok := req.Params.Arguments["older_than_days"].(float64); ok {
+			days = d
+		}
+		if days < 1 {
+			return mcp.NewToolResultError("older_than_days must be at least 1"), nil
+		}
+		confirm, _ := req.Params.Arguments["confirm"].(bool)
+
+		var sessions []videoplatform.Session
+		for _, status := range []videoplatform.SessionStatus{videoplatform.SessionScheduled, videoplatform.SessionCompleted} {
+			page, err := c.ListAllSessions(ctx, videoplatform.ListSessionsParams{Status: status})
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to list sessions: %v", err)), nil
+			}
+			sessions = append(sessions, page...)
+		}
+
+		minAge := time.Duration(days * 24 * float64(time.Hour))
+		outcomes := []cleanupOutcome{}
+		for _, s := range emptySessionCandidates(sessions, minAge, time.Now()) {
+			action, _ := cleanupAction(s.Status)
+			outcome := cleanupOutcome{SessionID: s.ID, Name: s.Name, Status: s.Status, Action: action}
+
+			switch {
+			case !confirm:
+				outcome.Result = "would " + action
+			default:
+				if refusal := locks.guard(ctx, req, s.ID); refusal != nil {
+					outcome.Result = "skipped: " + refusal.Content[0].(mcp.TextContent).Text
+					break
+				}
+				var err error
+				if action == "cancel" {
+					_, err = c.CancelSession(ctx, s.ID, videoplatform.CancelSessionRequest{})
+				} else {
+					err = c.DeleteSession(ctx, s.ID)
+				}
+				if err != nil {
+					outcome.Result = fmt.Sprintf("failed: %v", err)
+				} else if action == "cancel" {
+					outcome.Result = "cancelled"
+				} else {
+					outcome.Result = "trashed"
+				}
+			}
+			outcomes = append(outcomes, outcome)
+		}
+
+		report := struct {
+			DryRun   bool             `json:"dry_run"`
+			Sessions []cleanupOutcome `json:"sessions"`
+		}{DryRun: !confirm, Sessions: outcomes}
+
+		data, _ := json.MarshalIndent(report, "", "  ")
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestEmptySessionCandidates(t *testing.T) {
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	old := now.Add(-30 * 24 * time.Hour).Format(time.RFC3339)
+	recent := now.Add(-2 * 24 * time.Hour).Format(time.RFC3339)
+
+	sessions := []videoplatform.Session{
+		{ID: "scheduled-empty", Status: "scheduled", ScheduledStart: &old, CreatedAt: old},
+		{ID: "completed-empty", Status: "completed", ActualEnd: &old, CreatedAt: old},
+		{ID: "active-empty", Status: "active", CreatedAt: old},
+		{ID: "paused-empty", Status: "paused", CreatedAt: old},
+		{ID: "archived-empty", Status: "archived", CreatedAt: old},
+		{ID: "completed-clips", Status: "completed", ClipCount: 3, CreatedAt: old},
+		{ID: "completed-tags", Status: "completed", TagCount: 1, CreatedAt: old},
+		{ID: "scheduled-recent", Status: "scheduled", ScheduledStart: &recent, CreatedAt: old},
+	}
+
+	candidates := emptySessionCandidates(sessions, 7*24*time.Hour, now)
+
+	var got []string
+	for _, s := range candidates {
+		got = append(got, s.ID)
+	}
+	if len(got) != 2 || got[0] != "scheduled-empty" || got[1] != "completed-empty" {
+		t.Errorf("emptySessionCandidates() = %v, want [scheduled-empty completed-empty]", got)
+	}
+}
+
+func TestCleanupEmptySessions(t *testing.T) {
+	old := time.Now().Add(-30 * 24 * time.Hour).UTC().Format(time.RFC3339)
+
+	// cleanupPlatform lists one empty session per status and records
+	// mutating calls
+	cleanupPlatform := func(mu *sync.Mutex, calls *[]string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.URL.Path == "/api/v1/sessions":
+				status := r.URL.Query().Get("status")
+				data := []videoplatform.Session{{ID: status + "-1", Status: videoplatform.SessionStatus(status), CreatedAt: old}}
+				json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Session]{Data: data, Total: 1})
+			case r.URL.Path == "/api/v1/sessions/scheduled-1/lock", r.URL.Path == "/api/v1/sessions/completed-1/lock":
+				json.NewEncoder(w).Encode(videoplatform.SessionLock{})
+			default:
+				mu.Lock()
+				*calls = append(*calls, r.Method+" "+r.URL.Path)
+				mu.Unlock()
+				json.NewEncoder(w).Encode(videoplatform.Session{})
+			}
+		}
+	}
+
+	run := func(t *testing.T, args map[string]interface{}) ([]string, string) {
+		var mu sync.Mutex
+		var calls []string
+		server := mockServer(t, cleanupPlatform(&mu, &calls))
+		defer server.Close()
+
+		c := videoplatform.New(server.URL)
+		handler := makeCleanupEmptySessions(c, newSessionLocks(c))
+
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = args
+		result, err := handler(context.Background(), req)
+		if err != nil || result.IsError {
+			t.Fatalf("Expected success, got %v %+v", err, result)
+		}
+		return calls, result.Content[0].(mcp.TextContent).Text
+	}
+
+	t.Run("dry run by default", func(t *testing.T) {
+		calls, text := run(t, map[string]interface{}{})
+		if len(calls) != 0 {
+			t.Errorf("Dry run should not mutate, got %v", calls)
+		}
+
+		var report struct {
+			DryRun   bool             `json:"dry_run"`
+			Sessions []cleanupOutcome `json:"sessions"`
+		}
+		json.Unmarshal([]byte(text), &report)
+		if !report.DryRun || len(report.Sessions) != 2 || report.Sessions[0].Result != "would cancel" || report.Sessions[1].Result != "would trash" {
+			t.Errorf("Unexpected dry-run report: %s", text)
+		}
+	})
+
+	t.Run("confirm cancels scheduled and trashes completed", func(t *testing.T) {
+		calls, _ := run(t, map[string]interface{}{"confirm": true})
+		want := []string{"POST /api/v1/sessions/scheduled-1/cancel", "DELETE /api/v1/sessions/completed-1"}
+		if len(calls) != len(want) || calls[0] != want[0] || calls[1] != want[1] {
+			t.Errorf("Expected calls %v, got %v", want, calls)
+		}
+	})
+
+	t.Run("rejects cutoff below a day", func(t *testing.T) {
+		handler := makeCleanupEmptySessions(videoplatform.New("http://localhost:0"), nil)
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"older_than_days": float64(0)}
+
+		result, _ := handler(context.Background(), req)
+		verifyError(t, result, "older_than_days must be at least 1")
+	})
+}
@@ -0,0 +1,197 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// clipDuration is a clip's length in seconds: duration_seconds when the
+// platform reports it, otherwise the span between start_time and end_time
+func clipDuration(clip videoplatform.Clip) float64 {
+	if clip.DurationSeconds > 0 {
+		return clip.DurationSeconds
+	}
+	start, errStart := time.Parse(time.RFC3339, clip.StartTime)
+	end, errEnd := time.Parse(time.RFC3339, clip.EndTime)
+	if errStart != nil || errEnd != nil {
+		return 0
+	}
+	return end.Sub(start).Seconds()
+}
+
+// formatSeconds renders a clip length to a tenth of a second, e.g. "12.5s"
+func formatSeconds(seconds float64) string {
+	return fmt.Sprintf("%gs", math.Round(seconds*10)/10)
+}
+
+// trimmedBounds works out where a trim leaves a clip. Absolute times
+// replace a boundary; trim seconds move it inward from the current one.
+func trimmedBounds(clip videoplatform.Clip, trim videoplatform.TrimClipRequest) (start, end time.Time, err error) {
+	bound := func(name, current string, absolute *string, shift *float64, sign float64) (time.Time, error) {
+		if absolute != nil {
+			t, err := time.Parse(time.RFC3339, *absolute)
+			if err != nil {
+				return time.Time{}, fmt.Errorf("invalid %s %q: must be RFC 3339, e.g. 2026-10-17T19:04:10Z", name, *absolute)
+			}
+			return t, nil
+		}
+		t, err := time.Parse(time.RFC3339, current)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("clip %s has no readable %s; pass start_time and end_time instead", clip.ID, name)
+		}
+		if shift != nil {
+			t = t.Add(time.Duration(sign * *shift * float64(time.Second)))
+		}
+		return t, nil
+	}
+
+	if start, err = bound("start_time", clip.StartTime, trim.StartTime, trim.TrimStartSeconds, 1); err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if end, err = bound("end_time", clip.EndTime, trim.EndTime, trim.TrimEndSeconds, -1); err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return start, end, nil
+}
+
+func makeTrimClip(c *videoplatform.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		clipID, _ := req.Params.Arguments["clip_id"].(string)
+		if clipID == "" {
+			return mcp.NewToolResultError("clip_id is required"), nil
+		}
+
+		var trim videoplatform.TrimClipRequest
+		if s, ok := req.Params.Arguments["start_time"].(string); ok && s != "" {
+			trim.StartTime = &s
+		}
+		if s, ok := req.Params.Arguments["end_time"].(string); ok && s != "" {
+			trim.EndTime = &s
+		}
+		for _, key := range []string{"trim_start_seconds", "trim_end_seconds"} {
+			seconds, ok := req.Params.Arguments[key].(float64)
+			if !ok {
+				continue
+			}
+			if seconds < 0 {
+				return mcp.NewToolResultError(fmt.Sprintf("%s must not be negative", key)), nil
+			}
+			if key == "trim_start_seconds" {
+				trim.TrimStartSeconds = &seconds
+			} else {
+				trim.TrimEndSeconds = &seconds
+			}
+		}
+		absolute := trim.StartTime != nil || trim.EndTime != nil
+		relative := trim.TrimStartSeconds != nil || trim.TrimEndSeconds != nil
+		switch {
+		case absolute && relative:
+			return mcp.NewToolResultError("Pass either start_time/end_time or trim_start_seconds/trim_end_seconds, not both"), nil
+		case !absolute && !relative:
+			return mcp.NewToolResultError("Nothing to trim: pass start_time/end_time or trim_start_seconds/trim_end_seconds"), nil
+		}
+
+		before, err := c.GetClip(ctx, clipID)
+		if err != nil {
+			if apiStatus(err) == http.StatusNotFound {
+				return mcp.NewToolResultError(fmt.Sprintf("Clip %s not found", clipID)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get clip: %v", err)), nil
+		}
+		start, end, err := trimmedBounds(*before, trim)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if !end.After(start) {
+			return mcp.NewToolResultError(fmt.Sprintf("Trim would leave clip %s with a duration of %s; the new end must be after the new start", clipID, formatSeconds(end.Sub(start).Seconds()))), nil
+		}
+
+		after, err := c.TrimClip(ctx, clipID, trim)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to trim clip: %v", err)), nil
+		}
+
+		// Moving the start shifts where each tag's offset points, and either
+		// end can cut a tagged moment out of the clip
+		text := fmt.Sprintf("Trimmed clip %s from %s to %s.", clipID, formatSeconds(clipDuration(*before)), formatSeconds(clipDuration(*after)))
+		if skip, _ := req.Params.Arguments["skip_tag_migration"].(bool); skip {
+			text += " Tag offsets were left unchanged (skip_tag_migration)."
+		} else if src, err := trimTagSource(*before, *after); err != nil {
+			text += fmt.Sprintf(" Tag offsets were not adjusted: %v.", err)
+		} else if report := migrateTags(ctx, c, clipID, []tagSource{src}); src.Shift != 0 || len(report.OutOfRange) > 0 || len(report.Failed) > 0 {
+			text += " " + report.Summary()
+		}
+
+		data, _ := json.MarshalIndent(after, "", "  ")
+		return mcp.NewToolResultText(fmt.Sprintf("%s\n%s", text, data)), nil
+	}
+}
+
+func makeMergeClips(c *videoplatform.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var clipIDs []string
+		seen := make(map[string]bool)
+		items, _ := req.Params.Arguments["clip_ids"].([]interface{})
+		for _, item := range items {
+			id, ok := item.(string)
+			if !ok || id == "" {
+				return mcp.NewToolResultError("clip_ids must be an array of clip IDs"), nil
+			}
+			if seen[id] {
+				return mcp.NewToolResultError(fmt.Sprintf("clip_ids lists clip %s more than once", id)), nil
+			}
+			seen[id] = true
+			clipIDs = append(clipIDs, id)
+		}
+		if len(clipIDs) < 2 {
+			return mcp.NewToolResultError("clip_ids must list at least two clips to merge"), nil
+		}
+		mergeReq := videoplatform.MergeClipsRequest{ClipIDs: clipIDs}
+		if title, ok := req.Params.Arguments["title"].(string); ok && title != "" {
+			mergeReq.Title = &title
+		}
+
+		found, err := c.GetClips(ctx, clipIDs)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get clips: %v", err)), nil
+		}
+		sources := make([]videoplatform.Clip, 0, len(clipIDs))
+		for _, id := range clipIDs {
+			clip, ok := found[id]
+			if !ok {
+				return mcp.NewToolResultError(fmt.Sprintf("Clip %s not found", id)), nil
+			}
+			if len(sources) > 0 && clip.SessionID != sources[0].SessionID {
+				first := sources[0]
+				return mcp.NewToolResultError(fmt.Sprintf("Can't merge clips from different sessions: %s is in session %s but %s is in session %s", first.ID, first.SessionID, clip.ID, clip.SessionID)), nil
+			}
+			sources = append(sources, clip)
+		}
+
+		merged, err := c.MergeClips(ctx, mergeReq)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to merge clips: %v", err)), nil
+		}
+
+		// Tags move to the merged clip, offset by where their clip starts in it
+		text := fmt.Sprintf("Merged %d clips into clip %s (%s).", len(sources), merged.ID, formatSeconds(clipDuration(*merged)))
+		if skip, _ := req.Params.Arguments["skip_tag_migration"].(bool); skip {
+			text += " Tags were left on their original clips (skip_tag_migration)."
+		} else if tagSources, err := mergeTagSources(sources, *merged); err != nil {
+			text += fmt.Sprintf(" Tags were not moved: %v.", err)
+		} else {
+			text += " " + migrateTags(ctx, c, merged.ID, tagSources).Summary()
+		}
+
+		data, _ := json.MarshalIndent(merged, "", "  ")
+		return mcp.NewToolResultText(fmt.Sprintf("%s\n%s", text, data)), nil
+	}
+}
@@ -0,0 +1,256 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// clipPlatform serves clips by ID, applies trims and merges and records
+// tag updates. Trims and merges are recorded so tests can check none was
+// sent.
+type clipPlatform struct {
+	mu      sync.Mutex
+	clips   map[string]videoplatform.Clip
+	tags    map[string][]videoplatform.Tag
+	trims   int
+	merges  []videoplatform.MergeClipsRequest
+	updates map[string]videoplatform.UpdateTagRequest
+}
+
+func (p *clipPlatform) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	path := strings.TrimPrefix(r.URL.Path, "/api/v1/")
+	switch {
+	case r.Method == http.MethodPost && path == "clips/merge":
+		var req videoplatform.MergeClipsRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		p.merges = append(p.merges, req)
+		first, last := p.clips[req.ClipIDs[0]], p.clips[req.ClipIDs[len(req.ClipIDs)-1]]
+		json.NewEncoder(w).Encode(videoplatform.Clip{ID: "clip-m", SessionID: first.SessionID, StartTime: first.StartTime, EndTime: last.EndTime, Title: req.Title})
+	case r.Method == http.MethodGet && strings.HasPrefix(path, "clips/"):
+		clip, ok := p.clips[strings.TrimPrefix(path, "clips/")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode(clip)
+	case r.Method == http.MethodPost && strings.HasSuffix(path, "/trim"):
+		p.trims++
+		id := strings.TrimSuffix(strings.TrimPrefix(path, "clips/"), "/trim")
+		var req videoplatform.TrimClipRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		start, end, _ := trimmedBounds(p.clips[id], req)
+		clip := p.clips[id]
+		clip.StartTime, clip.EndTime = start.UTC().Format(time.RFC3339), end.UTC().Format(time.RFC3339)
+		clip.DurationSeconds = end.Sub(start).Seconds()
+		p.clips[id] = clip
+		json.NewEncoder(w).Encode(clip)
+	case r.Method == http.MethodGet && path == "tags":
+		tags := p.tags[r.URL.Query().Get("clip_id")]
+		json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Tag]{Data: tags, Total: len(tags)})
+	case r.Method == http.MethodPatch && strings.HasPrefix(path, "tags/"):
+		var req videoplatform.UpdateTagRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		p.updates[strings.TrimPrefix(path, "tags/")] = req
+		json.NewEncoder(w).Encode(videoplatform.Tag{ID: strings.TrimPrefix(path, "tags/"), ClipID: *req.ClipID})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func newClipPlatform(t *testing.T) (*clipPlatform, *videoplatform.Client) {
+	t.Helper()
+	p := &clipPlatform{
+		clips: map[string]videoplatform.Clip{
+			"clip-1": {ID: "clip-1", SessionID: "s-1", StartTime: "2026-10-17T19:00:00Z", EndTime: "2026-10-17T19:00:38Z", DurationSeconds: 38},
+			"clip-2": {ID: "clip-2", SessionID: "s-1", StartTime: "2026-10-17T19:01:00Z", EndTime: "2026-10-17T19:01:30Z", DurationSeconds: 30},
+			"clip-x": {ID: "clip-x", SessionID: "s-2", StartTime: "2026-10-17T19:02:00Z", EndTime: "2026-10-17T19:02:10Z", DurationSeconds: 10},
+		},
+		tags: map[string][]videoplatform.Tag{
+			"clip-1": {{ID: "tag-1", ClipID: "clip-1", OffsetSeconds: floatPtr(14)}, {ID: "tag-2", ClipID: "clip-1"}},
+			"clip-2": {{ID: "tag-3", ClipID: "clip-2", OffsetSeconds: floatPtr(5)}},
+		},
+		updates: map[string]videoplatform.UpdateTagRequest{},
+	}
+	server := mockServer(t, p.ServeHTTP)
+	t.Cleanup(server.Close)
+	return p, videoplatform.New(server.URL)
+}
+
+func TestTrimClip(t *testing.T) {
+	trim := func(t *testing.T, c *videoplatform.Client, args map[string]interface{}) *mcp.CallToolResult {
+		t.Helper()
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = args
+		result, err := makeTrimClip(c)(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return result
+	}
+
+	t.Run("relative", func(t *testing.T) {
+		p, c := newClipPlatform(t)
+		result := trim(t, c, map[string]interface{}{"clip_id": "clip-1", "trim_start_seconds": float64(10), "trim_end_seconds": float64(2)})
+		if result.IsError {
+			t.Fatalf("Unexpected tool error: %v", result.Content)
+		}
+		text := result.Content[0].(mcp.TextContent).Text
+		if !strings.HasPrefix(text, "Trimmed clip clip-1 from 38s to 26s. Migrated 1 tags to clip clip-1.") {
+			t.Errorf("result = %q", text)
+		}
+		if got := p.clips["clip-1"].StartTime; got != "2026-10-17T19:00:10Z" {
+			t.Errorf("new start = %s, want 19:00:10", got)
+		}
+		if update, ok := p.updates["tag-1"]; !ok || *update.OffsetSeconds != 4 {
+			t.Errorf("tag-1 update = %+v, want offset 4", update)
+		}
+		if _, ok := p.updates["tag-2"]; ok {
+			t.Error("tag-2 has no offset and should not be updated")
+		}
+	})
+
+	t.Run("absolute", func(t *testing.T) {
+		p, c := newClipPlatform(t)
+		result := trim(t, c, map[string]interface{}{"clip_id": "clip-1", "end_time": "2026-10-17T19:00:30Z"})
+		if result.IsError {
+			t.Fatalf("Unexpected tool error: %v", result.Content)
+		}
+		text := result.Content[0].(mcp.TextContent).Text
+		if !strings.HasPrefix(text, "Trimmed clip clip-1 from 38s to 30s.\n") {
+			t.Errorf("result = %q, want no tag changes when the start is unchanged", text)
+		}
+		if len(p.updates) != 0 {
+			t.Errorf("tag updates = %v, want none", p.updates)
+		}
+	})
+
+	t.Run("end cut past a tag", func(t *testing.T) {
+		p, c := newClipPlatform(t)
+		result := trim(t, c, map[string]interface{}{"clip_id": "clip-1", "trim_end_seconds": float64(28)})
+		text := result.Content[0].(mcp.TextContent).Text
+		if !strings.HasPrefix(text, "Trimmed clip clip-1 from 38s to 10s. Migrated 0 tags to clip clip-1; 1 left unchanged because their moment is no longer in the clip: tag-1 (would be at 14s).") {
+			t.Errorf("result = %q", text)
+		}
+		if len(p.updates) != 0 {
+			t.Errorf("tag updates = %v, want none", p.updates)
+		}
+	})
+
+	t.Run("skip tag migration", func(t *testing.T) {
+		p, c := newClipPlatform(t)
+		result := trim(t, c, map[string]interface{}{"clip_id": "clip-1", "trim_start_seconds": float64(10), "skip_tag_migration": true})
+		if text := result.Content[0].(mcp.TextContent).Text; !strings.Contains(text, "Tag offsets were left unchanged (skip_tag_migration).") {
+			t.Errorf("result = %q", text)
+		}
+		if len(p.updates) != 0 {
+			t.Errorf("tag updates = %v, want none", p.updates)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		p, c := newClipPlatform(t)
+		for _, tt := range []struct {
+			name string
+			args map[string]interface{}
+			want string
+		}{
+			{"trims past the end", map[string]interface{}{"trim_start_seconds": float64(30), "trim_end_seconds": float64(8)}, "Trim would leave clip clip-1 with a duration of 0s"},
+			{"end before start", map[string]interface{}{"start_time": "2026-10-17T19:00:20Z", "end_time": "2026-10-17T19:00:05Z"}, "duration of -15s"},
+			{"unparseable time", map[string]interface{}{"start_time": "19:00:05"}, `invalid start_time "19:00:05"`},
+			{"both forms", map[string]interface{}{"start_time": "2026-10-17T19:00:05Z", "trim_end_seconds": float64(2)}, "not both"},
+			{"negative", map[string]interface{}{"trim_start_seconds": float64(-5)}, "trim_start_seconds must not be negative"},
+			{"nothing", map[string]interface{}{}, "Nothing to trim"},
+		} {
+			t.Run(tt.name, func(t *testing.T) {
+				tt.args["clip_id"] = "clip-1"
+				verifyError(t, trim(t, c, tt.args), tt.want)
+			})
+		}
+		if p.trims != 0 {
+			t.Errorf("%d trims sent to the platform, want none", p.trims)
+		}
+	})
+
+	t.Run("clip not found", func(t *testing.T) {
+		_, c := newClipPlatform(t)
+		verifyError(t, trim(t, c, map[string]interface{}{"clip_id": "missing", "trim_start_seconds": float64(5)}), "Clip missing not found")
+	})
+}
+
+func TestMergeClips(t *testing.T) {
+	merge := func(t *testing.T, c *videoplatform.Client, args map[string]interface{}) *mcp.CallToolResult {
+		t.Helper()
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = args
+		result, err := makeMergeClips(c)(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return result
+	}
+
+	t.Run("merges and moves tags", func(t *testing.T) {
+		p, c := newClipPlatform(t)
+		result := merge(t, c, map[string]interface{}{"clip_ids": []interface{}{"clip-1", "clip-2"}, "title": "Opening drive"})
+		if result.IsError {
+			t.Fatalf("Unexpected tool error: %v", result.Content)
+		}
+		text := result.Content[0].(mcp.TextContent).Text
+		if !strings.HasPrefix(text, "Merged 2 clips into clip clip-m (90s). Migrated 3 tags to clip clip-m.") {
+			t.Errorf("result = %q", text)
+		}
+		if len(p.merges) != 1 || p.merges[0].Title == nil || *p.merges[0].Title != "Opening drive" {
+			t.Errorf("merge requests = %+v", p.merges)
+		}
+		if update := p.updates["tag-3"]; update.OffsetSeconds == nil || *update.OffsetSeconds != 65 {
+			t.Errorf("tag-3 update = %+v, want offset 65", update)
+		}
+	})
+
+	t.Run("skip tag migration", func(t *testing.T) {
+		p, c := newClipPlatform(t)
+		result := merge(t, c, map[string]interface{}{"clip_ids": []interface{}{"clip-1", "clip-2"}, "skip_tag_migration": true})
+		if text := result.Content[0].(mcp.TextContent).Text; !strings.Contains(text, "Tags were left on their original clips (skip_tag_migration).") {
+			t.Errorf("result = %q", text)
+		}
+		if len(p.merges) != 1 || len(p.updates) != 0 {
+			t.Errorf("merges = %d, tag updates = %v; want one merge and no updates", len(p.merges), p.updates)
+		}
+	})
+
+	t.Run("different sessions", func(t *testing.T) {
+		p, c := newClipPlatform(t)
+		result := merge(t, c, map[string]interface{}{"clip_ids": []interface{}{"clip-1", "clip-x"}})
+		verifyError(t, result, "Can't merge clips from different sessions: clip-1 is in session s-1 but clip-x is in session s-2")
+		if len(p.merges) != 0 {
+			t.Errorf("merge sent despite mixed sessions: %+v", p.merges)
+		}
+	})
+
+	t.Run("invalid clip_ids", func(t *testing.T) {
+		_, c := newClipPlatform(t)
+		for _, tt := range []struct {
+			ids  interface{}
+			want string
+		}{
+			{[]interface{}{"clip-1"}, "at least two clips"},
+			{nil, "at least two clips"},
+			{[]interface{}{"clip-1", "clip-1"}, "lists clip clip-1 more than once"},
+			{[]interface{}{"clip-1", float64(2)}, "must be an array of clip IDs"},
+			{[]interface{}{"clip-1", "missing"}, "Clip missing not found"},
+		} {
+			verifyError(t, merge(t, c, map[string]interface{}{"clip_ids": tt.ids}), tt.want)
+		}
+	})
+}
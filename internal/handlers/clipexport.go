@@ -0,0 +1,233 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// Outcomes of exporting one clip
+const (
+	clipExportWritten = "written"
+	clipExportSkipped = "skipped"
+	clipExportFailed  = "failed"
+)
+
+// clipExport is the export_clips report line of one clip
+type clipExport struct {
+	ClipID string `json:"clip_id"`
+	File   string `json:"file,omitempty"`
+	Status string `json:"status"`
+	Bytes  int64  `json:"bytes"`
+	Error  string `json:"error,omitempty"`
+}
+
+// clipFileName names a clip's media file
+// {session}_{start_time}_{title}_{clip_id}.mp4, leaving out the title when
+// it has none. The clip ID keeps angles of the same play, which share a
+// session, start and title, from landing in one file.
+func clipFileName(clip videoplatform.Clip) string {
+	start := clip.StartTime
+	if t, err := time.Parse(time.RFC3339, start); err == nil {
+		start = t.UTC().Format("20060102-150405")
+	}
+	parts := []string{clip.SessionID, start}
+	if clip.Title != nil && strings.TrimSpace(*clip.Title) != "" {
+		parts = append(parts, *clip.Title)
+	}
+	parts = append(parts, clip.ID)
+	for i, part := range parts {
+		parts[i] = strings.Trim(unsafeFileChars.ReplaceAllString(part, "_"), "_")
+	}
+	return strings.Join(parts, "_") + ".mp4"
+}
+
+// exportPath resolves dir, absolute or relative to root, and refuses paths
+// that leave root. Symlinks are resolved so a link inside root can't point
+// the export elsewhere; the deepest existing ancestor is what gets checked.
+func exportPath(root, dir string) (string, error) {
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return "", err
+	}
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(root, dir)
+	}
+	dir = filepath.Clean(dir)
+	if !within(root, dir) {
+		return "", fmt.Errorf("directory %s is outside the export directory %s", dir, root)
+	}
+
+	realRoot, err := filepath.EvalSymlinks(root)
+	if err != nil {
+		return "", fmt.Errorf("export directory: %w", err)
+	}
+	existing, rest := dir, ""
+	for {
+		resolved, err := filepath.EvalSymlinks(existing)
+		if err == nil {
+			if !within(realRoot, filepath.Join(resolved, rest)) {
+				return "", fmt.Errorf("directory %s resolves outside the export directory %s", dir, root)
+			}
+			return dir, nil
+		}
+		if !errors.Is(err, os.ErrNotExist) {
+			return "", err
+		}
+		rest = filepath.Join(filepath.Base(existing), rest)
+		existing = filepath.Dir(existing)
+	}
+}
+
+// within reports whether path is root or lies under it
+func within(root, path string) bool {
+	rel, err := filepath.Rel(root, path)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// downloadClipFile writes a clip's media to path. It downloads to a
+// temporary file first so an interrupted download doesn't leave a partial
+// file that a later export would skip as already written.
+func downloadClipFile(ctx context.Context, c *videoplatform.Client, clipID, path string) (int64, error) {
+	part := path + ".part"
+	f, err := os.Create(part)
+	if err != nil {
+		return 0, err
+	}
+	n, err := c.DownloadClip(ctx, clipID, f)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(part, path)
+	}
+	if err != nil {
+		os.Remove(part)
+		return n, err
+	}
+	return n, nil
+}
+
+func makeExportClips(c *videoplatform.Client, exportRoot string) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if exportRoot == "" {
+			return mcp.NewToolResultError("Clip export is disabled; start the server with -export-dir to allow writing clip media"), nil
+		}
+		dirArg, _ := req.Params.Arguments["directory"].(string)
+		if dirArg == "" {
+			return mcp.NewToolResultError("directory is required"), nil
+		}
+		if err := os.MkdirAll(exportRoot, 0o755); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create export directory: %v", err)), nil
+		}
+		dir, err := exportPath(exportRoot, dirArg)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Refusing to export: %v", err)), nil
+		}
+
+		sessionID, _ := req.Params.Arguments["session_id"].(string)
+		items, _ := req.Params.Arguments["clip_ids"].([]interface{})
+		switch {
+		case len(items) > 0 && sessionID != "":
+			return mcp.NewToolResultError("Pass either clip_ids or session_id, not both"), nil
+		case len(items) == 0 && sessionID == "":
+			return mcp.NewToolResultError("clip_ids or session_id is required"), nil
+		}
+
+		// Clips the platform doesn't know are reported as failed rather than
+		// failing the whole export
+		var clips []videoplatform.Clip
+		var report []clipExport
+		if sessionID != "" {
+			params := videoplatform.ListClipsParams{SessionID: sessionID}
+			if favoritesOnly, _ := req.Params.Arguments["favorites_only"].(bool); favoritesOnly {
+				params.Favorite = &favoritesOnly
+			}
+			if clips, err = c.ListAllClips(ctx, params); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to list clips: %v", err)), nil
+			}
+		} else {
+			var ids []string
+			for _, item := range items {
+				id, ok := item.(string)
+				if !ok || id == "" {
+					return mcp.NewToolResultError("clip_ids must be an array of clip IDs"), nil
+				}
+				ids = append(ids, id)
+			}
+			found, err := c.GetClips(ctx, ids)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to get clips: %v", err)), nil
+			}
+			for _, id := range ids {
+				if clip, ok := found[id]; ok {
+					clips = append(clips, clip)
+				} else {
+					report = append(report, clipExport{ClipID: id, Status: clipExportFailed, Error: "clip not found"})
+				}
+			}
+		}
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create directory: %v", err)), nil
+		}
+
+		result := struct {
+			Directory string       `json:"directory"`
+			Written   int          `json:"written"`
+			Skipped   int          `json:"skipped"`
+			Failed    int          `json:"failed"`
+			Bytes     int64        `json:"bytes"`
+			Clips     []clipExport `json:"clips"`
+		}{Directory: dir}
+
+		// Downloads run one at a time so a large export doesn't saturate the
+		// platform's media bandwidth
+		for _, clip := range clips {
+			name := clipFileName(clip)
+			entry := clipExport{ClipID: clip.ID, File: name}
+			path := filepath.Join(dir, name)
+			switch _, statErr := os.Lstat(path); {
+			case ctx.Err() != nil:
+				entry.Status, entry.Error = clipExportFailed, ctx.Err().Error()
+			case statErr == nil:
+				entry.Status = clipExportSkipped
+			default:
+				entry.Bytes, err = downloadClipFile(ctx, c, clip.ID, path)
+				if err != nil {
+					entry.Status, entry.Error, entry.Bytes = clipExportFailed, err.Error(), 0
+				} else {
+					entry.Status = clipExportWritten
+				}
+			}
+			report = append(report, entry)
+		}
+
+		for _, entry := range report {
+			switch entry.Status {
+			case clipExportWritten:
+				result.Written++
+				result.Bytes += entry.Bytes
+			case clipExportSkipped:
+				result.Skipped++
+			default:
+				result.Failed++
+			}
+		}
+		result.Clips = report
+		if result.Clips == nil {
+			result.Clips = []clipExport{}
+		}
+
+		data, _ := json.MarshalIndent(result, "", "  ")
+		return mcp.NewToolResultText(fmt.Sprintf("Exported %d clips to %s (%d skipped, %d failed):\n%s", result.Written, dir, result.Skipped, result.Failed, data)), nil
+	}
+}
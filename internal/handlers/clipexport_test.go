@@ -0,0 +1,170 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestClipFileName(t *testing.T) {
+	title := "Johnson 45yd TD!"
+	tests := []struct {
+		clip videoplatform.Clip
+		want string
+	}{
+		{videoplatform.Clip{ID: "clip-1", SessionID: "s-1", StartTime: "2026-10-17T19:04:10Z", Title: &title}, "s-1_20261017-190410_Johnson_45yd_TD_clip-1.mp4"},
+		{videoplatform.Clip{ID: "clip-2", SessionID: "s-1", StartTime: "2026-10-17T21:04:10+02:00"}, "s-1_20261017-190410_clip-2.mp4"},
+		{videoplatform.Clip{ID: "clip-3", SessionID: "s/../1", StartTime: "soon"}, "s_1_soon_clip-3.mp4"},
+	}
+	for _, tt := range tests {
+		if got := clipFileName(tt.clip); got != tt.want {
+			t.Errorf("clipFileName(%s) = %q, want %q", tt.clip.ID, got, tt.want)
+		}
+	}
+	// Two angles of one play share session, start and title
+	angle := videoplatform.Clip{ID: "clip-4", SessionID: "s-1", StartTime: "2026-10-17T19:04:10Z", Title: &title}
+	if clipFileName(angle) == clipFileName(tests[0].clip) {
+		t.Errorf("Expected different files for clip-1 and clip-4, both got %q", clipFileName(angle))
+	}
+}
+
+func TestExportPath(t *testing.T) {
+	root := t.TempDir()
+	outside := t.TempDir()
+	if err := os.Symlink(outside, filepath.Join(root, "escape")); err != nil {
+		t.Skipf("symlinks unavailable: %v", err)
+	}
+
+	for _, dir := range []string{"week3", "week3/favorites", filepath.Join(root, "week3"), "."} {
+		if _, err := exportPath(root, dir); err != nil {
+			t.Errorf("exportPath(%q) unexpected error: %v", dir, err)
+		}
+	}
+	for _, dir := range []string{"..", "../other", "week3/../../other", outside, "escape", "escape/new"} {
+		if got, err := exportPath(root, dir); err == nil {
+			t.Errorf("exportPath(%q) = %q, want it refused", dir, got)
+		}
+	}
+}
+
+func TestExportClips(t *testing.T) {
+	title := "Opening drive"
+	clips := map[string]videoplatform.Clip{
+		"clip-1": {ID: "clip-1", SessionID: "s-1", StartTime: "2026-10-17T19:00:00Z", Title: &title, IsFavorite: true},
+		"clip-2": {ID: "clip-2", SessionID: "s-1", StartTime: "2026-10-17T19:01:00Z"},
+		"broken": {ID: "broken", SessionID: "s-1", StartTime: "2026-10-17T19:02:00Z"},
+	}
+	server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimPrefix(r.URL.Path, "/api/v1/clips")
+		switch {
+		case path == "":
+			var data []videoplatform.Clip
+			for _, id := range []string{"clip-1", "clip-2"} {
+				if r.URL.Query().Get("favorite") != "true" || clips[id].IsFavorite {
+					data = append(data, clips[id])
+				}
+			}
+			json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Clip]{Data: data, Total: len(data)})
+		case path == "/broken/download":
+			w.WriteHeader(http.StatusGone)
+		case strings.HasSuffix(path, "/download"):
+			w.Write([]byte("media of " + strings.TrimSuffix(strings.TrimPrefix(path, "/"), "/download")))
+		default:
+			clip, ok := clips[strings.TrimPrefix(path, "/")]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			json.NewEncoder(w).Encode(clip)
+		}
+	})
+	defer server.Close()
+	c := videoplatform.New(server.URL)
+
+	type report struct {
+		Written, Skipped, Failed int
+		Bytes                    int64
+		Clips                    []clipExport
+	}
+	export := func(t *testing.T, root string, args map[string]interface{}) report {
+		t.Helper()
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = args
+		result, err := makeExportClips(c, root)(context.Background(), req)
+		if err != nil || result.IsError {
+			t.Fatalf("Expected success, got %v %v", result, err)
+		}
+		_, body, _ := strings.Cut(result.Content[0].(mcp.TextContent).Text, "\n")
+		var r report
+		if err := json.Unmarshal([]byte(body), &r); err != nil {
+			t.Fatalf("report is not JSON: %v", err)
+		}
+		return r
+	}
+
+	t.Run("clip_ids", func(t *testing.T) {
+		root := t.TempDir()
+		r := export(t, root, map[string]interface{}{"clip_ids": []interface{}{"clip-1", "missing", "broken"}, "directory": "week3"})
+		if r.Written != 1 || r.Failed != 2 || r.Bytes != int64(len("media of clip-1")) {
+			t.Errorf("report = %+v", r)
+		}
+		data, err := os.ReadFile(filepath.Join(root, "week3", "s-1_20261017-190000_Opening_drive_clip-1.mp4"))
+		if err != nil || string(data) != "media of clip-1" {
+			t.Errorf("exported file = %q, %v", data, err)
+		}
+		// A failed download leaves nothing behind that a rerun would skip
+		entries, _ := os.ReadDir(filepath.Join(root, "week3"))
+		if len(entries) != 1 {
+			t.Errorf("directory holds %d files, want only the written clip", len(entries))
+		}
+	})
+
+	t.Run("session skips existing files", func(t *testing.T) {
+		root := t.TempDir()
+		os.WriteFile(filepath.Join(root, "s-1_20261017-190100_clip-2.mp4"), []byte("earlier"), 0o644)
+		r := export(t, root, map[string]interface{}{"session_id": "s-1", "directory": "."})
+		if r.Written != 1 || r.Skipped != 1 || len(r.Clips) != 2 || r.Clips[1].Status != clipExportSkipped {
+			t.Errorf("report = %+v", r)
+		}
+		if data, _ := os.ReadFile(filepath.Join(root, "s-1_20261017-190100_clip-2.mp4")); string(data) != "earlier" {
+			t.Errorf("existing file overwritten with %q", data)
+		}
+	})
+
+	t.Run("favorites only", func(t *testing.T) {
+		r := export(t, t.TempDir(), map[string]interface{}{"session_id": "s-1", "favorites_only": true, "directory": "favorites"})
+		if len(r.Clips) != 1 || r.Clips[0].ClipID != "clip-1" {
+			t.Errorf("report = %+v, want only clip-1", r)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		root := t.TempDir()
+		for _, tt := range []struct {
+			root string
+			args map[string]interface{}
+			want string
+		}{
+			{"", map[string]interface{}{"session_id": "s-1", "directory": "week3"}, "start the server with -export-dir"},
+			{root, map[string]interface{}{"session_id": "s-1", "directory": "../elsewhere"}, "outside the export directory"},
+			{root, map[string]interface{}{"session_id": "s-1"}, "directory is required"},
+			{root, map[string]interface{}{"directory": "week3"}, "clip_ids or session_id is required"},
+			{root, map[string]interface{}{"session_id": "s-1", "clip_ids": []interface{}{"clip-1"}, "directory": "week3"}, "not both"},
+		} {
+			req := mcp.CallToolRequest{}
+			req.Params.Arguments = tt.args
+			result, _ := makeExportClips(c, tt.root)(context.Background(), req)
+			verifyError(t, result, tt.want)
+		}
+		if _, err := os.Stat(filepath.Join(filepath.Dir(root), "elsewhere")); err == nil {
+			t.Error("directory created outside the export directory")
+		}
+	})
+}
@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"sync/atomic"
+	"time"
+
+	"github.com/Prodro21/video-mcp/internal/config"
+	"github.com/Prodro21/video-mcp/internal/transport"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// connectionSource reports the connections of the HTTP transport; nil when
+// serving over stdio
+type connectionSource interface {
+	Stats() transport.Stats
+}
+
+// AttachTransport makes connection_info report the connections of t. The
+// transport is created after the tools are registered, so it is attached
+// separately.
+func (r *Registry) AttachTransport(t *transport.SSE) {
+	r.transport.Store(t)
+}
+
+// connectionInfo is the result of connection_info
+type connectionInfo struct {
+	Transport        string `json:"transport"`
+	HTTPAddr         string `json:"http_addr,omitempty"`
+	Keepalive        string `json:"keepalive,omitempty"`
+	Clients          *int   `json:"clients,omitempty"`
+	TotalConnections *int   `json:"total_connections,omitempty"`
+	Uptime           string `json:"uptime"`
+}
+
+func buildConnectionInfo(cfg *config.Config, source connectionSource, started time.Time) connectionInfo {
+	info := connectionInfo{Transport: cfg.Transport, Uptime: time.Since(started).Round(time.Second).String()}
+	if cfg.Transport != config.TransportSSE || source == nil {
+		return info
+	}
+
+	stats := source.Stats()
+	info.HTTPAddr = cfg.HTTPAddr
+	info.Keepalive = "off"
+	if cfg.Keepalive > 0 {
+		info.Keepalive = cfg.Keepalive.String()
+	}
+	info.Clients = &stats.Clients
+	info.TotalConnections = &stats.Connections
+	info.Uptime = stats.Uptime.Round(time.Second).String()
+	return info
+}
+
+func makeConnectionInfo(attached *atomic.Pointer[transport.SSE], cfg *config.Config, started time.Time) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var source connectionSource
+		if t := attached.Load(); t != nil {
+			source = t
+		}
+
+		data, _ := json.MarshalIndent(buildConnectionInfo(cfg, source, started), "", "  ")
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
@@ -0,0 +1,44 @@
+package handlers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Prodro21/video-mcp/internal/config"
+	"github.com/Prodro21/video-mcp/internal/transport"
+)
+
+type fakeConnections transport.Stats
+
+func (f fakeConnections) Stats() transport.Stats { return transport.Stats(f) }
+
+func TestBuildConnectionInfo(t *testing.T) {
+	started := time.Now().Add(-90 * time.Second)
+
+	t.Run("stdio", func(t *testing.T) {
+		info := buildConnectionInfo(&config.Config{Transport: config.TransportStdio}, nil, started)
+		if info.Transport != "stdio" || info.Clients != nil || info.HTTPAddr != "" {
+			t.Errorf("buildConnectionInfo() = %+v, want stdio without client counts", info)
+		}
+		if info.Uptime != "1m30s" {
+			t.Errorf("Uptime = %q, want 1m30s", info.Uptime)
+		}
+	})
+
+	t.Run("sse", func(t *testing.T) {
+		cfg := &config.Config{Transport: config.TransportSSE, HTTPAddr: ":8090", Keepalive: 15 * time.Second}
+		source := fakeConnections{Clients: 2, Connections: 7, Uptime: time.Hour}
+		info := buildConnectionInfo(cfg, source, started)
+		if info.Clients == nil || *info.Clients != 2 || *info.TotalConnections != 7 {
+			t.Fatalf("buildConnectionInfo() = %+v, want 2 clients of 7", info)
+		}
+		if info.HTTPAddr != ":8090" || info.Keepalive != "15s" || info.Uptime != "1h0m0s" {
+			t.Errorf("buildConnectionInfo() = %+v", info)
+		}
+
+		cfg.Keepalive = 0
+		if info := buildConnectionInfo(cfg, source, started); info.Keepalive != "off" {
+			t.Errorf("Keepalive = %q, want off", info.Keepalive)
+		}
+	})
+}
@@ -0,0 +1,120 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+)
+
+// maxDeepSearchTags caps how many tags a deep search reads when it isn't
+// scoped to a session, so a search across every session stays one request
+const maxDeepSearchTags = 1000
+
+// matchedViaTag marks clips that a deep search found through their tags
+// rather than their titles
+const matchedViaTag = "tag"
+
+// searchClip is a list_clips result of a deep search. MatchedVia is
+// "tag" for clips only a tag's notes or labels matched.
+type searchClip struct {
+	videoplatform.Clip
+	MatchedVia string `json:"matched_via,omitempty"`
+}
+
+// tagMatches reports whether a tag's notes or one of its labels contain
+// the search string, ignoring case
+func tagMatches(tag videoplatform.Tag, search string) bool {
+	search = strings.ToLower(search)
+	if tag.Notes != nil && strings.Contains(strings.ToLower(*tag.Notes), search) {
+		return true
+	}
+	for _, label := range tag.Labels {
+		if strings.Contains(strings.ToLower(label), search) {
+			return true
+		}
+	}
+	return false
+}
+
+// clipsMatchingTags finds the clips whose tags match the search and that
+// pass the list filters, skipping those in exclude. A search scoped to a
+// session reads sessionTags, the session's tags the caller already fetched;
+// an unscoped one reads only the first maxDeepSearchTags tags. searched and
+// total say how many tags were read out of how many exist.
+func clipsMatchingTags(ctx context.Context, c *videoplatform.Client, params videoplatform.ListClipsParams, sessionTags []videoplatform.Tag, exclude []videoplatform.Clip) (clips []videoplatform.Clip, searched, total int, err error) {
+	tags := sessionTags
+	if params.SessionID != "" {
+		total = len(tags)
+	} else {
+		resp, err := c.ListTags(ctx, videoplatform.ListTagsParams{Limit: maxDeepSearchTags})
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		tags, total = resp.Data, resp.Total
+	}
+
+	seen := make(map[string]bool, len(exclude))
+	for _, clip := range exclude {
+		seen[clip.ID] = true
+	}
+	var ids []string
+	for _, tag := range tags {
+		if tag.ClipID != "" && !seen[tag.ClipID] && tagMatches(tag, params.Search) {
+			seen[tag.ClipID] = true
+			ids = append(ids, tag.ClipID)
+		}
+	}
+
+	for _, r := range c.GetClipsInOrder(ctx, ids) {
+		if r.Err != nil {
+			// A tag can outlive its clip
+			if apiStatus(r.Err) == http.StatusNotFound {
+				continue
+			}
+			return nil, 0, 0, fmt.Errorf("get clip %s: %w", r.ID, r.Err)
+		}
+		clip := *r.Clip
+		if params.Status != "" && clip.Status != params.Status {
+			continue
+		}
+		if params.Favorite != nil && clip.IsFavorite != *params.Favorite {
+			continue
+		}
+		if !clipInDurationRange(clip, params.MinDuration, params.MaxDuration) || !clipRatedAtLeast(clip, params.MinRating) {
+			continue
+		}
+		clips = append(clips, clip)
+	}
+	return clips, len(tags), total, nil
+}
+
+// withTagMatches appends the clips found through tags to a page of title
+// matches, marking them so the model can tell the two apart
+func withTagMatches(e listEnvelope[videoplatform.Clip], tagged []videoplatform.Clip) listEnvelope[searchClip] {
+	merged := listEnvelope[searchClip]{
+		Total:   e.Total,
+		Limit:   e.Limit,
+		Offset:  e.Offset,
+		Notice:  e.Notice,
+		Data:    make([]searchClip, 0, len(e.Data)+len(tagged)),
+		scanned: e.pageLen(),
+	}
+	for _, clip := range e.Data {
+		merged.Data = append(merged.Data, searchClip{Clip: clip})
+	}
+	for _, clip := range tagged {
+		merged.Data = append(merged.Data, searchClip{Clip: clip, MatchedVia: matchedViaTag})
+	}
+	return merged
+}
+
+// joinNotices appends a sentence to an envelope's notice
+func joinNotices(notice, more string) string {
+	if notice == "" || more == "" {
+		return notice + more
+	}
+	return notice + " " + more
+}
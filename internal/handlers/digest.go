@@ -0,0 +1,285 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// digestDateLayout is the date format of digest names and URIs
+const digestDateLayout = "2006-01-02"
+
+// digestURIPrefix is where generated digests can be read back
+const digestURIPrefix = "video://digests/"
+
+// digestStoreSize bounds how many generated digests are kept for the
+// resource
+const digestStoreSize = 31
+
+// digestWindow is the period a digest covers, [From, To)
+type digestWindow struct {
+	Date string
+	From time.Time
+	To   time.Time
+}
+
+// lastDayWindow is the 24 hours ending at now, named after now's date
+func lastDayWindow(now time.Time) digestWindow {
+	return digestWindow{Date: now.Format(digestDateLayout), From: now.Add(-24 * time.Hour), To: now}
+}
+
+// calendarDayWindow is midnight to midnight of date in loc, which is 23 or
+// 25 hours long on daylight saving changes
+func calendarDayWindow(date string, loc *time.Location) (digestWindow, error) {
+	day, err := time.ParseInLocation(digestDateLayout, date, loc)
+	if err != nil {
+		return digestWindow{}, fmt.Errorf("invalid date %q: use YYYY-MM-DD", date)
+	}
+	y, m, d := day.Date()
+	return digestWindow{Date: date, From: day, To: time.Date(y, m, d+1, 0, 0, 0, 0, loc)}, nil
+}
+
+// contains reports whether an RFC 3339 timestamp falls in the window
+func (w digestWindow) contains(ts string) bool {
+	t, err := time.Parse(time.RFC3339, ts)
+	return err == nil && !t.Before(w.From) && t.Before(w.To)
+}
+
+// overlaps reports whether a session was recording at any point in the
+// window
+func (w digestWindow) overlaps(s videoplatform.Session) bool {
+	if s.ActualStart == nil {
+		return false
+	}
+	start, err := time.Parse(time.RFC3339, *s.ActualStart)
+	if err != nil || !start.Before(w.To) {
+		return false
+	}
+	if s.ActualEnd == nil {
+		return true
+	}
+	end, err := time.Parse(time.RFC3339, *s.ActualEnd)
+	return err != nil || !end.Before(w.From)
+}
+
+// dailyDigest is what happened on the platform during a window. A section
+// whose fetch failed carries its error instead of data.
+type dailyDigest struct {
+	Window        digestWindow
+	Sessions      []videoplatform.Session
+	SessionsErr   error
+	Clips         []videoplatform.Clip
+	ClipsErr      error
+	Tags          int
+	TagsErr       error
+	ChannelErrors []videoplatform.Channel
+	ChannelsErr   error
+}
+
+// buildDailyDigest lists sessions, clips, tags and channels concurrently
+// and keeps what falls in the window. The platform has no change feed, so
+// everything is listed and filtered by timestamp.
+func buildDailyDigest(ctx context.Context, c *videoplatform.Client, w digestWindow) *dailyDigest {
+	d := &dailyDigest{Window: w}
+	var wg sync.WaitGroup
+	wg.Add(4)
+	go func() {
+		defer wg.Done()
+		sessions, err := c.ListAllSessions(ctx, videoplatform.ListSessionsParams{})
+		for _, s := range sessions {
+			if w.overlaps(s) {
+				d.Sessions = append(d.Sessions, s)
+			}
+		}
+		d.SessionsErr = err
+	}()
+	go func() {
+		defer wg.Done()
+		clips, err := c.ListAllClips(ctx, videoplatform.ListClipsParams{})
+		for _, clip := range clips {
+			if w.contains(clip.CreatedAt) {
+				d.Clips = append(d.Clips, clip)
+			}
+		}
+		d.ClipsErr = err
+	}()
+	go func() {
+		defer wg.Done()
+		tags, err := c.ListAllTags(ctx, videoplatform.ListTagsParams{})
+		for _, tag := range tags {
+			if w.contains(tag.CreatedAt) {
+				d.Tags++
+			}
+		}
+		d.TagsErr = err
+	}()
+	go func() {
+		defer wg.Done()
+		channels, err := c.ListAllChannels(ctx, videoplatform.ListChannelsParams{})
+		if err != nil {
+			d.ChannelsErr = err
+			return
+		}
+		for _, ch := range channels {
+			if classifyStatus(ch.Status, ch.ErrorMessage) == levelFail {
+				d.ChannelErrors = append(d.ChannelErrors, ch)
+			}
+		}
+	}()
+	wg.Wait()
+	return d
+}
+
+// markdown renders the digest
+func (d *dailyDigest) markdown(p presenter) string {
+	var b strings.Builder
+	w := d.Window
+	fmt.Fprintf(&b, "# Daily digest %s\n\n", w.Date)
+	fmt.Fprintf(&b, "_%s to %s_\n", w.From.Format(time.RFC3339), w.To.Format(time.RFC3339))
+
+	fmt.Fprintf(&b, "\n## Sessions (%d)\n", len(d.Sessions))
+	switch {
+	case d.SessionsErr != nil:
+		fmt.Fprintf(&b, "_Unavailable: %v_\n", d.SessionsErr)
+	case len(d.Sessions) == 0:
+		b.WriteString("_None_\n")
+	default:
+		for _, s := range d.Sessions {
+			fmt.Fprintf(&b, "- %s\n", p.sessionLine(s))
+		}
+	}
+
+	fmt.Fprintf(&b, "\n## Clips (%d)\n", len(d.Clips))
+	switch {
+	case d.ClipsErr != nil:
+		fmt.Fprintf(&b, "_Unavailable: %v_\n", d.ClipsErr)
+	case len(d.Clips) == 0:
+		b.WriteString("_None_\n")
+	default:
+		byStatus := make(map[string]int)
+		for _, clip := range d.Clips {
+			byStatus[string(clip.Status)]++
+		}
+		statuses := make([]string, 0, len(byStatus))
+		for status := range byStatus {
+			statuses = append(statuses, status)
+		}
+		sort.Strings(statuses)
+		for _, status := range statuses {
+			fmt.Fprintf(&b, "- %s: %d\n", status, byStatus[status])
+		}
+	}
+
+	b.WriteString("\n## Tags\n")
+	if d.TagsErr != nil {
+		fmt.Fprintf(&b, "_Unavailable: %v_\n", d.TagsErr)
+	} else {
+		fmt.Fprintf(&b, "%d created\n", d.Tags)
+	}
+
+	fmt.Fprintf(&b, "\n## Channel errors (%d)\n", len(d.ChannelErrors))
+	switch {
+	case d.ChannelsErr != nil:
+		fmt.Fprintf(&b, "_Unavailable: %v_\n", d.ChannelsErr)
+	case len(d.ChannelErrors) == 0:
+		b.WriteString("_None_\n")
+	default:
+		for _, ch := range d.ChannelErrors {
+			fmt.Fprintf(&b, "- %s\n", p.channelLine(ch))
+		}
+	}
+
+	b.WriteString("\n## Storage\n")
+	if d.ClipsErr != nil {
+		fmt.Fprintf(&b, "_Unavailable: %v_\n", d.ClipsErr)
+	} else {
+		var seconds float64
+		for _, clip := range d.Clips {
+			seconds += clip.DurationSeconds
+		}
+		fmt.Fprintf(&b, "+%s of media in %d new clips\n", formatElapsed(time.Duration(seconds*float64(time.Second))), len(d.Clips))
+	}
+	return b.String()
+}
+
+// digestStore keeps recently generated digests so they can be read back as
+// video://digests/{date} resources. Digests only live as long as the
+// process.
+type digestStore struct {
+	mu      sync.Mutex
+	digests map[string]string
+	order   []string
+}
+
+func newDigestStore() *digestStore {
+	return &digestStore{digests: make(map[string]string)}
+}
+
+func (s *digestStore) put(date, markdown string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.digests[date]; !ok {
+		s.order = append(s.order, date)
+		if len(s.order) > digestStoreSize {
+			delete(s.digests, s.order[0])
+			s.order = s.order[1:]
+		}
+	}
+	s.digests[date] = markdown
+}
+
+func (s *digestStore) get(date string) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	markdown, ok := s.digests[date]
+	return markdown, ok
+}
+
+// DailyDigest renders the digest of the 24 hours ending at now, for
+// printing in -digest-on-start mode
+func DailyDigest(ctx context.Context, c *videoplatform.Client, now time.Time, noEmoji bool) string {
+	return buildDailyDigest(ctx, c, lastDayWindow(now)).markdown(newPresenter(noEmoji))
+}
+
+func makeGenerateDailyDigest(c *videoplatform.Client, p presenter, digests *digestStore) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		w := lastDayWindow(time.Now())
+		if date, _ := req.Params.Arguments["date"].(string); date != "" {
+			var err error
+			if w, err = calendarDayWindow(date, time.Local); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+		}
+
+		markdown := buildDailyDigest(ctx, c, w).markdown(p)
+		digests.put(w.Date, markdown)
+		return mcp.NewToolResultText(markdown + fmt.Sprintf("\n_Saved as %s%s_\n", digestURIPrefix, w.Date)), nil
+	}
+}
+
+func makeDigestResource(digests *digestStore) server.ResourceTemplateHandlerFunc {
+	return func(ctx context.Context, req mcp.ReadResourceRequest) ([]interface{}, error) {
+		date := strings.TrimPrefix(req.Params.URI, digestURIPrefix)
+		markdown, ok := digests.get(date)
+		if !ok {
+			return nil, fmt.Errorf("no digest for %s; generate one with generate_daily_digest", date)
+		}
+		return []interface{}{
+			mcp.TextResourceContents{
+				ResourceContents: mcp.ResourceContents{
+					URI:      req.Params.URI,
+					MIMEType: "text/markdown",
+				},
+				Text: markdown,
+			},
+		}, nil
+	}
+}
@@ -0,0 +1,165 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestLastDayWindow_AcrossMidnight(t *testing.T) {
+	loc := time.FixedZone("CDT", -5*60*60)
+	w := lastDayWindow(time.Date(2026, 10, 18, 0, 30, 0, 0, loc))
+
+	if w.Date != "2026-10-18" {
+		t.Errorf("Date = %s, want 2026-10-18", w.Date)
+	}
+	tests := []struct {
+		ts   string
+		want bool
+	}{
+		{"2026-10-17T00:20:00-05:00", false},
+		{"2026-10-17T00:30:00-05:00", true},
+		{"2026-10-17T05:30:00Z", true},
+		{"2026-10-17T23:50:00-05:00", true},
+		{"2026-10-18T00:10:00-05:00", true},
+		{"2026-10-18T00:30:00-05:00", false},
+		{"not a time", false},
+	}
+	for _, tt := range tests {
+		if got := w.contains(tt.ts); got != tt.want {
+			t.Errorf("contains(%s) = %v, want %v", tt.ts, got, tt.want)
+		}
+	}
+
+	str := func(s string) *string { return &s }
+	sessions := []struct {
+		name string
+		s    videoplatform.Session
+		want bool
+	}{
+		{"ended before window", videoplatform.Session{ActualStart: str("2026-10-16T18:00:00-05:00"), ActualEnd: str("2026-10-16T21:00:00-05:00")}, false},
+		{"spans start of window", videoplatform.Session{ActualStart: str("2026-10-16T23:00:00-05:00"), ActualEnd: str("2026-10-17T01:00:00-05:00")}, true},
+		{"still recording", videoplatform.Session{ActualStart: str("2026-10-17T23:45:00-05:00")}, true},
+		{"never started", videoplatform.Session{}, false},
+	}
+	for _, tt := range sessions {
+		if got := w.overlaps(tt.s); got != tt.want {
+			t.Errorf("overlaps(%s) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestCalendarDayWindow(t *testing.T) {
+	loc := time.FixedZone("CDT", -5*60*60)
+	w, err := calendarDayWindow("2026-10-17", loc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !w.contains("2026-10-17T00:00:00-05:00") || !w.contains("2026-10-17T23:59:59-05:00") || w.contains("2026-10-18T00:00:00-05:00") {
+		t.Errorf("window %v - %v has the wrong bounds", w.From, w.To)
+	}
+
+	if chicago, err := time.LoadLocation("America/Chicago"); err == nil {
+		w, _ := calendarDayWindow("2026-11-01", chicago)
+		if got := w.To.Sub(w.From); got != 25*time.Hour {
+			t.Errorf("fall-back day lasts %s, want 25h", got)
+		}
+	}
+
+	if _, err := calendarDayWindow("10/17/2026", loc); err == nil {
+		t.Error("calendarDayWindow() should reject a non-ISO date")
+	}
+}
+
+func TestGenerateDailyDigest(t *testing.T) {
+	now := time.Now()
+	ago := func(d time.Duration) string { return now.Add(-d).Format(time.RFC3339) }
+	started, ended := ago(5*time.Hour), ago(2*time.Hour)
+	oldStart, oldEnd := ago(50*time.Hour), ago(48*time.Hour)
+	errMsg := "no signal"
+
+	server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/sessions":
+			data := []videoplatform.Session{
+				{ID: "s-1", Name: "Week 3", Status: "completed", ActualStart: &started, ActualEnd: &ended},
+				{ID: "s-0", Name: "Week 2", Status: "completed", ActualStart: &oldStart, ActualEnd: &oldEnd},
+			}
+			json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Session]{Data: data, Total: len(data)})
+		case "/api/v1/clips":
+			data := []videoplatform.Clip{
+				{ID: "c-1", Status: "ready", DurationSeconds: 1800, CreatedAt: ago(4 * time.Hour)},
+				{ID: "c-2", Status: "ready", DurationSeconds: 1800, CreatedAt: ago(3 * time.Hour)},
+				{ID: "c-3", Status: "failed", CreatedAt: ago(3 * time.Hour)},
+				{ID: "c-0", Status: "ready", DurationSeconds: 600, CreatedAt: ago(49 * time.Hour)},
+			}
+			json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Clip]{Data: data, Total: len(data)})
+		case "/api/v1/tags":
+			data := []videoplatform.Tag{{ID: "t-1", CreatedAt: ago(time.Hour)}, {ID: "t-0", CreatedAt: ago(30 * time.Hour)}}
+			json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Tag]{Data: data, Total: len(data)})
+		case "/api/v1/channels":
+			data := []videoplatform.Channel{{ID: "ch-1", Name: "Sideline", Status: "active"}, {ID: "ch-2", Name: "End Zone", Status: "error", ErrorMessage: &errMsg}}
+			json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Channel]{Data: data, Total: len(data)})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer server.Close()
+
+	digests := newDigestStore()
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{}
+	result, err := makeGenerateDailyDigest(videoplatform.New(server.URL), newPresenter(true), digests)(context.Background(), req)
+	if err != nil || result.IsError {
+		t.Fatalf("Unexpected error: %v %v", err, result)
+	}
+	text := result.Content[0].(mcp.TextContent).Text
+
+	date := now.Format(digestDateLayout)
+	sections := []string{
+		"# Daily digest " + date,
+		"## Sessions (1)\n- [OK] Week 3 (s-1): completed",
+		"## Clips (3)\n- failed: 1\n- ready: 2",
+		"## Tags\n1 created",
+		"## Channel errors (1)\n- [FAIL] End Zone (ch-2): error - no signal",
+		"## Storage\n+1h 0m of media in 3 new clips",
+		"_Saved as video://digests/" + date + "_",
+	}
+	last := -1
+	for _, section := range sections {
+		i := strings.Index(text, section)
+		if i < 0 {
+			t.Errorf("digest missing %q:\n%s", section, text)
+			continue
+		}
+		if i < last {
+			t.Errorf("section %q out of order", section)
+		}
+		last = i
+	}
+	if strings.Contains(text, "Week 2") {
+		t.Errorf("digest includes a session outside the window:\n%s", text)
+	}
+
+	// The digest can be read back as a resource
+	resReq := mcp.ReadResourceRequest{}
+	resReq.Params.URI = digestURIPrefix + date
+	contents, err := makeDigestResource(digests)(context.Background(), resReq)
+	if err != nil {
+		t.Fatalf("digest resource: %v", err)
+	}
+	if got := contents[0].(mcp.TextResourceContents).Text; !strings.HasPrefix(got, "# Daily digest "+date) {
+		t.Errorf("digest resource = %q", got)
+	}
+
+	resReq.Params.URI = digestURIPrefix + "1999-01-01"
+	if _, err := makeDigestResource(digests)(context.Background(), resReq); err == nil {
+		t.Error("Expected an error for a digest that was never generated")
+	}
+}
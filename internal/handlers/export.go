@@ -0,0 +1,326 @@
+package handlers
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// exportInlineLimit is the largest bundle export_session_bundle returns
+// inline as base64; larger bundles are written under the data directory
+const exportInlineLimit = 512 << 10
+
+// exportDir is the subdirectory of -data-dir that bundles are written to
+const exportDir = "exports"
+
+// Formats of export_session
+const (
+	exportFormatJSON = "json"
+	exportFormatCSV  = "csv"
+)
+
+var (
+	tagsCSVHeader  = []string{"id", "clip_id", "quarter", "down", "distance", "play_type", "formation", "result", "yards_gained", "labels", "notes", "offset_seconds", "is_important", "is_reviewed", "created_at"}
+	clipsCSVHeader = []string{"id", "channel_id", "title", "start_time", "end_time", "duration_seconds", "status", "is_favorite", "favorite_note", "view_count", "tag_count", "created_at"}
+)
+
+// unsafeFileChars matches characters not allowed in bundle file names
+var unsafeFileChars = regexp.MustCompile(`[^A-Za-z0-9_-]+`)
+
+func csvString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func csvInt(n *int) string {
+	if n == nil {
+		return ""
+	}
+	return strconv.Itoa(*n)
+}
+
+func csvFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// writeCSV encodes a header and rows
+func writeCSV(header []string, rows [][]string) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(header); err != nil {
+		return nil, err
+	}
+	if err := w.WriteAll(rows); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// tagRow is the tagsCSVHeader columns of a tag. Labels are joined with
+// semicolons.
+func tagRow(t videoplatform.Tag) []string {
+	offset := ""
+	if t.OffsetSeconds != nil {
+		offset = csvFloat(*t.OffsetSeconds)
+	}
+	return []string{
+		t.ID, t.ClipID, csvInt(t.Quarter), csvInt(t.Down), csvInt(t.Distance),
+		csvString(t.PlayType), csvString(t.Formation), csvString(t.Result), csvInt(t.YardsGained),
+		strings.Join(t.Labels, ";"), csvString(t.Notes), offset,
+		strconv.FormatBool(t.IsImportant), strconv.FormatBool(t.IsReviewed), t.CreatedAt,
+	}
+}
+
+// tagsCSV exports tags one per row
+func tagsCSV(tags []videoplatform.Tag) ([]byte, error) {
+	rows := make([][]string, 0, len(tags))
+	for _, t := range tags {
+		rows = append(rows, tagRow(t))
+	}
+	return writeCSV(tagsCSVHeader, rows)
+}
+
+// timedTagsCSVHeader is tagsCSVHeader followed by the times of the tag's clip
+var timedTagsCSVHeader = append(append([]string(nil), tagsCSVHeader...), "clip_start_time", "clip_end_time")
+
+// timedTagsCSV exports tags one per row like tagsCSV, with the start and
+// end time of each tag's clip. Tags whose clip isn't among clips have empty
+// clip times.
+func timedTagsCSV(tags []videoplatform.Tag, clips []videoplatform.Clip) ([]byte, error) {
+	byID := make(map[string]videoplatform.Clip, len(clips))
+	for _, clip := range clips {
+		byID[clip.ID] = clip
+	}
+	rows := make([][]string, 0, len(tags))
+	for _, t := range tags {
+		clip := byID[t.ClipID]
+		rows = append(rows, append(tagRow(t), clip.StartTime, clip.EndTime))
+	}
+	return writeCSV(timedTagsCSVHeader, rows)
+}
+
+// clipsCSV exports clips one per row
+func clipsCSV(clips []videoplatform.Clip) ([]byte, error) {
+	rows := make([][]string, 0, len(clips))
+	for _, c := range clips {
+		rows = append(rows, []string{
+			c.ID, c.ChannelID, csvString(c.Title), c.StartTime, c.EndTime,
+			csvFloat(c.DurationSeconds), string(c.Status), strconv.FormatBool(c.IsFavorite),
+			csvString(c.FavoriteNote), strconv.Itoa(c.ViewCount), csvInt(c.TagCount), c.CreatedAt,
+		})
+	}
+	return writeCSV(clipsCSVHeader, rows)
+}
+
+// sessionExport is the JSON document of export_session
+type sessionExport struct {
+	ExportedAt string                `json:"exported_at"`
+	ClipCount  int                   `json:"clip_count"`
+	TagCount   int                   `json:"tag_count"`
+	Session    videoplatform.Session `json:"session"`
+	Clips      []videoplatform.Clip  `json:"clips"`
+	Tags       []videoplatform.Tag   `json:"tags"`
+}
+
+// bundleMember is one file in an export bundle
+type bundleMember struct {
+	Name string
+	Data []byte
+}
+
+// bundleMembers builds the files of a session bundle: tags and clips as CSV,
+// a JSON summary and, when report is set, the handoff briefing
+func bundleMembers(d *sessionData, report string, now time.Time) ([]bundleMember, error) {
+	tags, err := tagsCSV(d.Tags)
+	if err != nil {
+		return nil, fmt.Errorf("tags.csv: %w", err)
+	}
+	clips, err := clipsCSV(d.Clips)
+	if err != nil {
+		return nil, fmt.Errorf("clips.csv: %w", err)
+	}
+	summary, err := json.MarshalIndent(summarizeSession(d, now), "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("session.json: %w", err)
+	}
+
+	members := []bundleMember{
+		{Name: "session.json", Data: summary},
+		{Name: "clips.csv", Data: clips},
+		{Name: "tags.csv", Data: tags},
+	}
+	if report != "" {
+		members = append(members, bundleMember{Name: "report.md", Data: []byte(report)})
+	}
+	return members, nil
+}
+
+// zipMembers writes members into an in-memory ZIP archive
+func zipMembers(members []bundleMember, modified time.Time) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, m := range members {
+		w, err := zw.CreateHeader(&zip.FileHeader{Name: m.Name, Method: zip.Deflate, Modified: modified})
+		if err != nil {
+			return nil, err
+		}
+		if _, err := w.Write(m.Data); err != nil {
+			return nil, err
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// bundleFileName names a session's bundle file
+func bundleFileName(sessionID string, now time.Time) string {
+	return fmt.Sprintf("session-%s-%s.zip", unsafeFileChars.ReplaceAllString(sessionID, "_"), now.UTC().Format("20060102-150405"))
+}
+
+func makeExportSessionBundle(c *videoplatform.Client, p presenter, dataDir string, inlineLimit int) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		sessionID, _ := req.Params.Arguments["session_id"].(string)
+		if sessionID == "" {
+			return mcp.NewToolResultError("session_id is required"), nil
+		}
+		includeReport, _ := req.Params.Arguments["include_report"].(bool)
+
+		// The channels are only needed for the report
+		var d *sessionData
+		var h *handoffData
+		var err error
+		if includeReport {
+			if h, err = fetchHandoff(ctx, c, sessionID); err == nil {
+				d = &h.sessionData
+			}
+		} else {
+			d, err = fetchSessionData(ctx, c, sessionID)
+		}
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get session: %v", err)), nil
+		}
+		if d.ClipsErr != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list clips: %v", d.ClipsErr)), nil
+		}
+		if d.TagsErr != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list tags: %v", d.TagsErr)), nil
+		}
+
+		now := time.Now()
+		var report string
+		if h != nil {
+			report = handoffBriefing(h, p, now)
+		}
+		members, err := bundleMembers(d, report, now)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to export session: %v", err)), nil
+		}
+		archive, err := zipMembers(members, now)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to build bundle: %v", err)), nil
+		}
+
+		result := struct {
+			SessionID string   `json:"session_id"`
+			FileName  string   `json:"file_name"`
+			SizeBytes int      `json:"size_bytes"`
+			Members   []string `json:"members"`
+			Path      string   `json:"path,omitempty"`
+			Encoding  string   `json:"encoding,omitempty"`
+			Data      string   `json:"data,omitempty"`
+		}{SessionID: sessionID, FileName: bundleFileName(sessionID, now), SizeBytes: len(archive)}
+		for _, m := range members {
+			result.Members = append(result.Members, m.Name)
+		}
+
+		if len(archive) <= inlineLimit {
+			result.Encoding = "base64"
+			result.Data = base64.StdEncoding.EncodeToString(archive)
+		} else {
+			if dataDir == "" {
+				return mcp.NewToolResultError(fmt.Sprintf(
+					"Bundle is %d bytes, over the %d byte inline limit; start the server with -data-dir to write large bundles to disk",
+					len(archive), inlineLimit)), nil
+			}
+			dir := filepath.Join(dataDir, exportDir)
+			if err := os.MkdirAll(dir, 0o755); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to write bundle: %v", err)), nil
+			}
+			result.Path = filepath.Join(dir, result.FileName)
+			if err := os.WriteFile(result.Path, archive, 0o644); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to write bundle: %v", err)), nil
+			}
+		}
+
+		data, _ := json.MarshalIndent(result, "", "  ")
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
+
+func makeExportSession(c *videoplatform.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		sessionID, _ := req.Params.Arguments["session_id"].(string)
+		if sessionID == "" {
+			return mcp.NewToolResultError("session_id is required"), nil
+		}
+		format := exportFormatJSON
+		if f, ok := req.Params.Arguments["format"].(string); ok && f != "" {
+			format = strings.ToLower(f)
+		}
+		if format != exportFormatJSON && format != exportFormatCSV {
+			return mcp.NewToolResultError(fmt.Sprintf("invalid format %q: must be %s or %s", format, exportFormatJSON, exportFormatCSV)), nil
+		}
+
+		d, err := fetchSessionData(ctx, c, sessionID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get session: %v", err)), nil
+		}
+		if d.ClipsErr != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list clips: %v", d.ClipsErr)), nil
+		}
+		if d.TagsErr != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list tags: %v", d.TagsErr)), nil
+		}
+
+		var data []byte
+		if format == exportFormatCSV {
+			data, err = timedTagsCSV(d.Tags, d.Clips)
+		} else {
+			export := sessionExport{
+				ExportedAt: time.Now().UTC().Format(time.RFC3339),
+				ClipCount:  len(d.Clips),
+				TagCount:   len(d.Tags),
+				Session:    d.Session,
+				Clips:      append([]videoplatform.Clip{}, d.Clips...),
+				Tags:       append([]videoplatform.Tag{}, d.Tags...),
+			}
+			data, err = json.MarshalIndent(export, "", "  ")
+		}
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to export session: %v", err)), nil
+		}
+
+		// The counts lead the result so a client that truncates the
+		// bundle can tell
+		return mcp.NewToolResultText(fmt.Sprintf("Exported session %s as %s: %d clips, %d tags\n%s",
+			sessionID, strings.ToUpper(format), len(d.Clips), len(d.Tags), data)), nil
+	}
+}
@@ -0,0 +1,295 @@
+package handlers
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// bundlePlatform serves session s-1 and pages through the given clips and tags
+func bundlePlatform(t *testing.T, clips []videoplatform.Clip, tags []videoplatform.Tag) *videoplatform.Client {
+	server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/sessions/s-1":
+			json.NewEncoder(w).Encode(videoplatform.Session{ID: "s-1", Name: "Week 3 vs Eagles", Status: "completed"})
+		case "/api/v1/clips":
+			json.NewEncoder(w).Encode(page(r, clips))
+		case "/api/v1/tags":
+			json.NewEncoder(w).Encode(page(r, tags))
+		case "/api/v1/channels":
+			json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Channel]{})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	t.Cleanup(server.Close)
+	return videoplatform.New(server.URL)
+}
+
+type bundleResult struct {
+	FileName  string   `json:"file_name"`
+	SizeBytes int      `json:"size_bytes"`
+	Members   []string `json:"members"`
+	Path      string   `json:"path"`
+	Encoding  string   `json:"encoding"`
+	Data      string   `json:"data"`
+}
+
+func exportBundle(t *testing.T, c *videoplatform.Client, dataDir string, inlineLimit int, args map[string]interface{}) bundleResult {
+	t.Helper()
+	handler := makeExportSessionBundle(c, newPresenter(true), dataDir, inlineLimit)
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = args
+
+	result, err := handler(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Unexpected tool error: %v", result.Content)
+	}
+	var got bundleResult
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &got); err != nil {
+		t.Fatalf("result is not JSON: %v", err)
+	}
+	return got
+}
+
+// readBundle returns the members of a ZIP archive by name
+func readBundle(t *testing.T, archive []byte) map[string]string {
+	t.Helper()
+	zr, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		t.Fatalf("invalid ZIP: %v", err)
+	}
+	files := make(map[string]string)
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatalf("open %s: %v", f.Name, err)
+		}
+		data, _ := io.ReadAll(rc)
+		rc.Close()
+		files[f.Name] = string(data)
+	}
+	return files
+}
+
+func readCSV(t *testing.T, data string) [][]string {
+	t.Helper()
+	records, err := csv.NewReader(strings.NewReader(data)).ReadAll()
+	if err != nil {
+		t.Fatalf("invalid CSV: %v\n%s", err, data)
+	}
+	return records
+}
+
+func TestExportSessionBundle(t *testing.T) {
+	playType := "pass"
+	quarter := 2
+	title := "Opening drive, 1st play"
+	clips := []videoplatform.Clip{{ID: "c-1", ChannelID: "ch-1", Title: &title, DurationSeconds: 12.5, Status: "ready"}}
+	tags := []videoplatform.Tag{{ID: "t-1", ClipID: "c-1", Quarter: &quarter, PlayType: &playType, Labels: []string{"blitz", "red zone"}, IsImportant: true}}
+
+	got := exportBundle(t, bundlePlatform(t, clips, tags), "", exportInlineLimit,
+		map[string]interface{}{"session_id": "s-1", "include_report": true})
+
+	if got.Encoding != "base64" || got.Path != "" {
+		t.Fatalf("small bundle should be inline, got encoding %q path %q", got.Encoding, got.Path)
+	}
+	if !strings.HasPrefix(got.FileName, "session-s-1-") || !strings.HasSuffix(got.FileName, ".zip") {
+		t.Errorf("FileName = %q", got.FileName)
+	}
+	archive, err := base64.StdEncoding.DecodeString(got.Data)
+	if err != nil {
+		t.Fatalf("data is not base64: %v", err)
+	}
+	if got.SizeBytes != len(archive) {
+		t.Errorf("SizeBytes = %d, want %d", got.SizeBytes, len(archive))
+	}
+
+	files := readBundle(t, archive)
+	wantMembers := []string{"session.json", "clips.csv", "tags.csv", "report.md"}
+	if !reflect.DeepEqual(got.Members, wantMembers) {
+		t.Errorf("Members = %v, want %v", got.Members, wantMembers)
+	}
+	if len(files) != len(wantMembers) {
+		t.Errorf("archive has %d files, want %d", len(files), len(wantMembers))
+	}
+
+	tagRows := readCSV(t, files["tags.csv"])
+	if len(tagRows) != 2 || !reflect.DeepEqual(tagRows[0], tagsCSVHeader) {
+		t.Fatalf("tags.csv = %v", tagRows)
+	}
+	row := map[string]string{}
+	for i, col := range tagsCSVHeader {
+		row[col] = tagRows[1][i]
+	}
+	if row["quarter"] != "2" || row["play_type"] != "pass" || row["labels"] != "blitz;red zone" || row["down"] != "" || row["is_important"] != "true" {
+		t.Errorf("tags.csv row = %v", row)
+	}
+
+	clipRows := readCSV(t, files["clips.csv"])
+	if len(clipRows) != 2 || clipRows[1][2] != title || clipRows[1][5] != "12.5" {
+		t.Errorf("clips.csv = %v", clipRows)
+	}
+
+	var summary sessionSummary
+	if err := json.Unmarshal([]byte(files["session.json"]), &summary); err != nil {
+		t.Fatalf("session.json: %v", err)
+	}
+	if summary.Session.ID != "s-1" || summary.Clips != 1 || summary.Tags != 1 || summary.ImportantTags != 1 || summary.PlayTypes["pass"] != 1 {
+		t.Errorf("session.json = %+v", summary)
+	}
+
+	if !strings.Contains(files["report.md"], "# Handoff: Week 3 vs Eagles") {
+		t.Errorf("report.md = %q", files["report.md"])
+	}
+}
+
+func TestExportSessionBundle_NoTags(t *testing.T) {
+	got := exportBundle(t, bundlePlatform(t, nil, nil), "", exportInlineLimit, map[string]interface{}{"session_id": "s-1"})
+
+	archive, _ := base64.StdEncoding.DecodeString(got.Data)
+	files := readBundle(t, archive)
+	if _, ok := files["report.md"]; ok {
+		t.Error("report.md should be omitted unless include_report is set")
+	}
+	for name, header := range map[string][]string{"tags.csv": tagsCSVHeader, "clips.csv": clipsCSVHeader} {
+		rows := readCSV(t, files[name])
+		if len(rows) != 1 || !reflect.DeepEqual(rows[0], header) {
+			t.Errorf("%s = %v, want header only", name, rows)
+		}
+	}
+}
+
+func TestExportSessionBundle_Threshold(t *testing.T) {
+	c := bundlePlatform(t, nil, nil)
+
+	t.Run("written to data dir", func(t *testing.T) {
+		dir := t.TempDir()
+		got := exportBundle(t, c, dir, 1, map[string]interface{}{"session_id": "s-1"})
+
+		if got.Data != "" || got.Encoding != "" {
+			t.Error("large bundle should not be inline")
+		}
+		if got.Path != filepath.Join(dir, exportDir, got.FileName) {
+			t.Errorf("Path = %q", got.Path)
+		}
+		archive, err := os.ReadFile(got.Path)
+		if err != nil {
+			t.Fatalf("bundle not written: %v", err)
+		}
+		if len(archive) != got.SizeBytes {
+			t.Errorf("file is %d bytes, result says %d", len(archive), got.SizeBytes)
+		}
+		if _, ok := readBundle(t, archive)["session.json"]; !ok {
+			t.Error("written bundle is missing session.json")
+		}
+	})
+
+	t.Run("no data dir", func(t *testing.T) {
+		handler := makeExportSessionBundle(c, newPresenter(true), "", 1)
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"session_id": "s-1"}
+
+		result, _ := handler(context.Background(), req)
+		verifyError(t, result, "start the server with -data-dir")
+	})
+}
+
+func TestBundleFileName(t *testing.T) {
+	name := bundleFileName("../week 3/s:1", time.Date(2026, 10, 17, 19, 2, 3, 0, time.UTC))
+	if name != "session-_week_3_s_1-20261017-190203.zip" {
+		t.Errorf("bundleFileName() = %q", name)
+	}
+}
+
+func exportSession(t *testing.T, c *videoplatform.Client, args map[string]interface{}) (header, body string) {
+	t.Helper()
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = args
+	result, err := makeExportSession(c)(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Unexpected tool error: %v", result.Content)
+	}
+	header, body, _ = strings.Cut(result.Content[0].(mcp.TextContent).Text, "\n")
+	return header, body
+}
+
+func TestExportSession(t *testing.T) {
+	var clips []videoplatform.Clip
+	for i := 0; i < 120; i++ {
+		clips = append(clips, videoplatform.Clip{ID: fmt.Sprintf("c-%d", i), StartTime: fmt.Sprintf("2026-10-17T19:%02d:00Z", i%60), EndTime: "2026-10-17T21:00:00Z"})
+	}
+	playType := "run"
+	tags := []videoplatform.Tag{
+		{ID: "t-1", ClipID: "c-101", PlayType: &playType},
+		{ID: "t-2", ClipID: "c-gone"},
+	}
+	c := bundlePlatform(t, clips, tags)
+
+	t.Run("json", func(t *testing.T) {
+		header, body := exportSession(t, c, map[string]interface{}{"session_id": "s-1"})
+		if header != "Exported session s-1 as JSON: 120 clips, 2 tags" {
+			t.Errorf("header = %q", header)
+		}
+		var got sessionExport
+		if err := json.Unmarshal([]byte(body), &got); err != nil {
+			t.Fatalf("body is not JSON: %v", err)
+		}
+		if got.Session.ID != "s-1" || got.ClipCount != 120 || len(got.Clips) != 120 || got.TagCount != 2 || len(got.Tags) != 2 {
+			t.Errorf("export has session %q, %d/%d clips, %d/%d tags", got.Session.ID, got.ClipCount, len(got.Clips), got.TagCount, len(got.Tags))
+		}
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		header, body := exportSession(t, c, map[string]interface{}{"session_id": "s-1", "format": "CSV"})
+		if header != "Exported session s-1 as CSV: 120 clips, 2 tags" {
+			t.Errorf("header = %q", header)
+		}
+		rows := readCSV(t, body)
+		if len(rows) != 3 || !reflect.DeepEqual(rows[0], timedTagsCSVHeader) {
+			t.Fatalf("rows = %v", rows)
+		}
+		start, end := len(tagsCSVHeader), len(tagsCSVHeader)+1
+		if rows[1][0] != "t-1" || rows[1][5] != "run" || rows[1][start] != "2026-10-17T19:41:00Z" || rows[1][end] != "2026-10-17T21:00:00Z" {
+			t.Errorf("t-1 row = %v", rows[1])
+		}
+		if rows[2][start] != "" || rows[2][end] != "" {
+			t.Errorf("tag without a known clip should have empty clip times, got %v", rows[2])
+		}
+	})
+
+	t.Run("empty session", func(t *testing.T) {
+		_, body := exportSession(t, bundlePlatform(t, nil, nil), map[string]interface{}{"session_id": "s-1"})
+		if !strings.Contains(body, `"clips": []`) || !strings.Contains(body, `"tags": []`) {
+			t.Errorf("empty lists should export as [], got %s", body)
+		}
+	})
+
+	t.Run("invalid format", func(t *testing.T) {
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"session_id": "s-1", "format": "xml"}
+		result, _ := makeExportSession(c)(context.Background(), req)
+		verifyError(t, result, `invalid format "xml": must be json or csv`)
+	})
+}
@@ -0,0 +1,160 @@
+package handlers
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Prodro21/video-mcp/internal/config"
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+)
+
+// clockLabelPrefix marks the label that stores a tag's game clock
+const clockLabelPrefix = "clock:"
+
+// regulationQuarterLength bounds the clock in quarters 1-4
+const regulationQuarterLength = 15 * time.Minute
+
+// overtimeQuarter is the Quarter value stored for the first overtime
+// period; later periods count up from it
+const overtimeQuarter = 5
+
+// maxOvertimePeriods bounds the overtime periods a game clock may name, so
+// every stored Quarter is at most lastQuarter
+const maxOvertimePeriods = 10
+
+// lastQuarter is the Quarter value of the last overtime period accepted
+const lastQuarter = overtimeQuarter + maxOvertimePeriods - 1
+
+// gameClockPattern matches "Q3 04:12", "OT 1:00" and "OT2 3:30"
+var gameClockPattern = regexp.MustCompile(`^(?i)(Q(\d+)|OT(\d*))\s+(\d{1,2}):(\d{2})$`)
+
+// gameClock is a quarter plus the time remaining on the clock
+type gameClock struct {
+	Quarter   int
+	Remaining time.Duration
+}
+
+// parseGameClock parses a game clock like "Q3 04:12" or "OT 1:00". The clock
+// may not exceed the quarter length, or overtime for overtime periods
+// (config.DefaultOvertimeLength when zero).
+func parseGameClock(s string, overtime time.Duration) (gameClock, error) {
+	if overtime <= 0 {
+		overtime = config.DefaultOvertimeLength
+	}
+	m := gameClockPattern.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil {
+		return gameClock{}, fmt.Errorf("invalid game_clock %q: use e.g. \"Q3 04:12\" or \"OT 1:00\"", s)
+	}
+
+	var gc gameClock
+	limit := regulationQuarterLength
+	if m[2] != "" {
+		gc.Quarter, _ = strconv.Atoi(m[2])
+		if gc.Quarter < 1 || gc.Quarter > 4 {
+			return gameClock{}, fmt.Errorf("invalid game_clock %q: quarter must be Q1-Q4 or OT", s)
+		}
+	} else {
+		period := 1
+		if m[3] != "" {
+			period, _ = strconv.Atoi(m[3])
+			if period < 1 || period > maxOvertimePeriods {
+				return gameClock{}, fmt.Errorf("invalid game_clock %q: overtime period must be 1-%d", s, maxOvertimePeriods)
+			}
+		}
+		gc.Quarter = overtimeQuarter + period - 1
+		limit = overtime
+	}
+
+	minutes, _ := strconv.Atoi(m[4])
+	seconds, _ := strconv.Atoi(m[5])
+	if seconds > 59 {
+		return gameClock{}, fmt.Errorf("invalid game_clock %q: seconds must be 00-59", s)
+	}
+	gc.Remaining = time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second
+	if gc.Remaining > limit {
+		return gameClock{}, fmt.Errorf("invalid game_clock %q: clock exceeds %s", s, formatClock(limit))
+	}
+	return gc, nil
+}
+
+// formatClock renders a duration as mm:ss
+func formatClock(d time.Duration) string {
+	total := int(d / time.Second)
+	return fmt.Sprintf("%02d:%02d", total/60, total%60)
+}
+
+// quarterName renders a stored Quarter value as Q1-Q4, OT, OT2 and so on
+func quarterName(q int) string {
+	switch {
+	case q < overtimeQuarter:
+		return fmt.Sprintf("Q%d", q)
+	case q == overtimeQuarter:
+		return "OT"
+	default:
+		return fmt.Sprintf("OT%d", q-overtimeQuarter+1)
+	}
+}
+
+// Label returns the normalized clock label stored on the tag
+func (gc gameClock) Label() string {
+	return clockLabelPrefix + formatClock(gc.Remaining)
+}
+
+// withClockLabel replaces any existing clock label in labels
+func withClockLabel(labels []string, gc gameClock) []string {
+	out := make([]string, 0, len(labels)+1)
+	for _, label := range labels {
+		if !strings.HasPrefix(label, clockLabelPrefix) {
+			out = append(out, label)
+		}
+	}
+	return append(out, gc.Label())
+}
+
+// tagClock reads the time remaining back from a tag's clock label
+func tagClock(tag videoplatform.Tag) (time.Duration, bool) {
+	for _, label := range tag.Labels {
+		value, ok := strings.CutPrefix(label, clockLabelPrefix)
+		if !ok {
+			continue
+		}
+		minutes, seconds, ok := strings.Cut(value, ":")
+		if !ok {
+			continue
+		}
+		m, err1 := strconv.Atoi(minutes)
+		s, err2 := strconv.Atoi(seconds)
+		if err1 != nil || err2 != nil {
+			continue
+		}
+		return time.Duration(m)*time.Minute + time.Duration(s)*time.Second, true
+	}
+	return 0, false
+}
+
+// sortByGameClock orders tags by quarter and then by clock, counting down.
+// Tags without a quarter or clock keep their relative order after the ones
+// that have them.
+func sortByGameClock(tags []videoplatform.Tag) {
+	sort.SliceStable(tags, func(i, j int) bool {
+		qi, qj := tags[i].Quarter, tags[j].Quarter
+		switch {
+		case qi == nil || qj == nil:
+			return qi != nil && qj == nil
+		case *qi != *qj:
+			return *qi < *qj
+		}
+		ci, iok := tagClock(tags[i])
+		cj, jok := tagClock(tags[j])
+		switch {
+		case !iok || !jok:
+			return iok && !jok
+		default:
+			return ci > cj
+		}
+	})
+}
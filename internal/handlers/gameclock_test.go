@@ -0,0 +1,126 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Prodro21/video-mcp/internal/config"
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestParseGameClock(t *testing.T) {
+	tests := []struct {
+		in          string
+		wantQuarter int
+		wantLabel   string
+	}{
+		{"Q3 04:12", 3, "clock:04:12"},
+		{"q1 4:05", 1, "clock:04:05"},
+		{"Q4 15:00", 4, "clock:15:00"},
+		{"Q2 0:00", 2, "clock:00:00"},
+		{"OT 1:00", 5, "clock:01:00"},
+		{"OT2 10:00", 6, "clock:10:00"},
+	}
+	for _, tt := range tests {
+		gc, err := parseGameClock(tt.in, config.DefaultOvertimeLength)
+		if err != nil {
+			t.Errorf("parseGameClock(%q) unexpected error: %v", tt.in, err)
+			continue
+		}
+		if gc.Quarter != tt.wantQuarter || gc.Label() != tt.wantLabel {
+			t.Errorf("parseGameClock(%q) = Q%d %s, want Q%d %s", tt.in, gc.Quarter, gc.Label(), tt.wantQuarter, tt.wantLabel)
+		}
+	}
+}
+
+func TestParseGameClock_Bounds(t *testing.T) {
+	tests := []struct {
+		in       string
+		overtime time.Duration
+		wantErr  string
+	}{
+		{"Q5 01:00", 0, "quarter must be Q1-Q4 or OT"},
+		{"Q0 01:00", 0, "quarter must be Q1-Q4 or OT"},
+		{"Q2 15:01", 0, "clock exceeds 15:00"},
+		{"Q2 3:75", 0, "seconds must be 00-59"},
+		{"OT 10:01", 0, "clock exceeds 10:00"},
+		{"OT0 1:00", 0, "overtime period must be 1-10"},
+		{"OT11 1:00", 0, "overtime period must be 1-10"},
+		{"OT 6:00", 5 * time.Minute, "clock exceeds 05:00"},
+		{"third quarter", 0, "use e.g."},
+	}
+	for _, tt := range tests {
+		_, err := parseGameClock(tt.in, tt.overtime)
+		if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("parseGameClock(%q) error = %v, want %q", tt.in, err, tt.wantErr)
+		}
+	}
+
+	// A longer configured overtime accepts longer clocks
+	if _, err := parseGameClock("OT 12:00", 15*time.Minute); err != nil {
+		t.Errorf("parseGameClock(OT 12:00) with 15m overtime: %v", err)
+	}
+}
+
+func TestSortByGameClock_RoundTrip(t *testing.T) {
+	var tags []videoplatform.Tag
+	for _, clock := range []string{"Q2 01:30", "OT 2:00", "Q1 00:45", "Q2 12:00", "Q1 14:59"} {
+		gc, err := parseGameClock(clock, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tags = append(tags, videoplatform.Tag{
+			ID:      clock,
+			Quarter: &gc.Quarter,
+			Labels:  withClockLabel([]string{"redzone", "clock:99:99"}, gc),
+		})
+	}
+	tags = append(tags, videoplatform.Tag{ID: "untimed"})
+
+	sortByGameClock(tags)
+
+	var got []string
+	for _, tag := range tags {
+		got = append(got, tag.ID)
+	}
+	want := "Q1 14:59,Q1 00:45,Q2 12:00,Q2 01:30,OT 2:00,untimed"
+	if strings.Join(got, ",") != want {
+		t.Errorf("sortByGameClock() = %s, want %s", strings.Join(got, ","), want)
+	}
+	if labels := tags[0].Labels; len(labels) != 2 || labels[1] != "clock:14:59" {
+		t.Errorf("withClockLabel() = %v, want the old clock label replaced", labels)
+	}
+}
+
+func TestCreateTag_GameClock(t *testing.T) {
+	var got videoplatform.CreateTagRequest
+	server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&got)
+		json.NewEncoder(w).Encode(videoplatform.Tag{ID: "tag-1"})
+	})
+	defer server.Close()
+
+	handler := makeCreateTag(videoplatform.New(server.URL), config.DefaultOvertimeLength, nil)
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{
+		"clip_id":    "clip-1",
+		"session_id": "session-1",
+		"game_clock": "Q3 4:12",
+	}
+	result, err := handler(context.Background(), req)
+	if err != nil || result.IsError {
+		t.Fatalf("Expected success, got %v %v", result, err)
+	}
+	if got.Quarter == nil || *got.Quarter != 3 || len(got.Labels) != 1 || got.Labels[0] != "clock:04:12" {
+		t.Errorf("CreateTag request = quarter %v labels %v", got.Quarter, got.Labels)
+	}
+
+	req.Params.Arguments["game_clock"] = "Q3 16:00"
+	result, _ = handler(context.Background(), req)
+	verifyError(t, result, "clock exceeds 15:00")
+}
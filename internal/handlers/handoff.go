@@ -0,0 +1,264 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// handoffRecentTags is how many of the newest tags the handoff lists
+const handoffRecentTags = 5
+
+// stuckClipAge is how long a clip may stay pending or processing before
+// the handoff flags it
+const stuckClipAge = 10 * time.Minute
+
+// sessionData is a session with its clips and tags. A listing that failed
+// carries its error instead of data.
+type sessionData struct {
+	Session  videoplatform.Session
+	Clips    []videoplatform.Clip
+	ClipsErr error
+	Tags     []videoplatform.Tag
+	TagsErr  error
+}
+
+// fetchSessionData loads the session and its clips and tags concurrently.
+// Only a failure to load the session itself is an error.
+func fetchSessionData(ctx context.Context, c *videoplatform.Client, sessionID string) (*sessionData, error) {
+	var d sessionData
+	var sessionErr error
+	var wg sync.WaitGroup
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		var s *videoplatform.Session
+		if s, sessionErr = c.GetSession(ctx, sessionID); sessionErr == nil {
+			d.Session = *s
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		d.Clips, d.ClipsErr = c.ListAllClips(ctx, videoplatform.ListClipsParams{SessionID: sessionID})
+	}()
+	go func() {
+		defer wg.Done()
+		d.Tags, d.TagsErr = c.ListAllTags(ctx, videoplatform.ListTagsParams{SessionID: sessionID})
+	}()
+	wg.Wait()
+
+	if sessionErr != nil {
+		return nil, sessionErr
+	}
+	return &d, nil
+}
+
+// handoffData is everything the handoff briefing is composed from: the
+// session data plus the channels, whose listing may also have failed
+type handoffData struct {
+	sessionData
+	Channels    []videoplatform.Channel
+	ChannelsErr error
+}
+
+// fetchHandoff loads the session data and the channels concurrently. Only
+// a failure to load the session itself is an error.
+func fetchHandoff(ctx context.Context, c *videoplatform.Client, sessionID string) (*handoffData, error) {
+	var d handoffData
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		d.Channels, d.ChannelsErr = c.ListAllChannels(ctx, videoplatform.ListChannelsParams{})
+	}()
+	sd, err := fetchSessionData(ctx, c, sessionID)
+	wg.Wait()
+
+	if err != nil {
+		return nil, err
+	}
+	d.sessionData = *sd
+	return &d, nil
+}
+
+// handoffBriefing renders the handoff as a short markdown briefing
+func handoffBriefing(d *handoffData, p presenter, now time.Time) string {
+	s := d.Session
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# Handoff: %s\n\n", s.Name)
+	fmt.Fprintf(&b, "**Status:** %s", s.Status)
+	if s.ActualStart != nil {
+		if start, err := time.Parse(time.RFC3339, *s.ActualStart); err == nil {
+			end := now
+			if s.ActualEnd != nil {
+				if t, err := time.Parse(time.RFC3339, *s.ActualEnd); err == nil {
+					end = t
+				}
+			}
+			fmt.Fprintf(&b, ", %s elapsed (started %s)", formatElapsed(end.Sub(start)), start.Format("15:04"))
+		}
+	}
+	b.WriteString("\n")
+
+	if d.ClipsErr != nil {
+		fmt.Fprintf(&b, "**Clips:** %d recorded (details unavailable: %v)\n", s.ClipCount, d.ClipsErr)
+	} else if d.TagsErr != nil {
+		fmt.Fprintf(&b, "**Clips:** %d recorded (tag coverage unavailable: %v)\n", len(d.Clips), d.TagsErr)
+	} else {
+		tagged := make(map[string]bool)
+		for _, tag := range d.Tags {
+			tagged[tag.ClipID] = true
+		}
+		n := 0
+		for _, clip := range d.Clips {
+			if tagged[clip.ID] {
+				n++
+			}
+		}
+		fmt.Fprintf(&b, "**Clips:** %d recorded, %d tagged, %d untagged\n", len(d.Clips), n, len(d.Clips)-n)
+	}
+
+	b.WriteString("\n## Last tags\n")
+	switch {
+	case d.TagsErr != nil:
+		fmt.Fprintf(&b, "_Unavailable: %v_\n", d.TagsErr)
+	case len(d.Tags) == 0:
+		b.WriteString("_No tags yet_\n")
+	default:
+		recent := append([]videoplatform.Tag(nil), d.Tags...)
+		sort.SliceStable(recent, func(i, j int) bool { return recent[i].CreatedAt > recent[j].CreatedAt })
+		for _, tag := range recent[:min(len(recent), handoffRecentTags)] {
+			fmt.Fprintf(&b, "- %s\n", describeTag(tag))
+		}
+	}
+
+	b.WriteString("\n## Channels in error\n")
+	switch {
+	case d.ChannelsErr != nil:
+		fmt.Fprintf(&b, "_Unavailable: %v_\n", d.ChannelsErr)
+	default:
+		n := 0
+		for _, ch := range d.Channels {
+			if classifyStatus(ch.Status, ch.ErrorMessage) == levelFail {
+				fmt.Fprintf(&b, "- %s\n", p.channelLine(ch))
+				n++
+			}
+		}
+		if n == 0 {
+			b.WriteString("_None_\n")
+		}
+	}
+
+	b.WriteString("\n## Open items\n")
+	var open []string
+	if d.ClipsErr == nil {
+		for _, clip := range d.Clips {
+			if item, stuck := stuckClip(clip, now); stuck {
+				open = append(open, item)
+			}
+		}
+	}
+	if d.TagsErr == nil {
+		for _, tag := range d.Tags {
+			if tag.IsImportant && !tag.IsReviewed {
+				open = append(open, "Important tag not reviewed: "+describeTag(tag))
+			}
+		}
+	}
+	if len(open) == 0 {
+		b.WriteString("_None_\n")
+	}
+	for _, item := range open {
+		fmt.Fprintf(&b, "- %s\n", item)
+	}
+	return b.String()
+}
+
+// stuckClip reports a clip that failed or has sat in processing too long
+func stuckClip(clip videoplatform.Clip, now time.Time) (string, bool) {
+	switch clip.Status {
+	case videoplatform.ClipFailed:
+		return fmt.Sprintf("Clip %s failed processing", clip.ID), true
+	case videoplatform.ClipPending, videoplatform.ClipProcessing:
+		created, err := time.Parse(time.RFC3339, clip.CreatedAt)
+		if err != nil || now.Sub(created) < stuckClipAge {
+			return "", false
+		}
+		return fmt.Sprintf("Clip %s %s for %s", clip.ID, clip.Status, formatElapsed(now.Sub(created))), true
+	}
+	return "", false
+}
+
+// describeTag renders a tag as a one-line play summary
+func describeTag(tag videoplatform.Tag) string {
+	var parts []string
+	if tag.Quarter != nil {
+		q := quarterName(*tag.Quarter)
+		if clock, ok := tagClock(tag); ok {
+			q += " " + formatClock(clock)
+		}
+		parts = append(parts, q)
+	}
+	if tag.Down != nil && tag.Distance != nil {
+		parts = append(parts, fmt.Sprintf("%s & %d", ordinal(*tag.Down), *tag.Distance))
+	}
+	if tag.PlayType != nil {
+		parts = append(parts, *tag.PlayType)
+	}
+	if tag.Result != nil {
+		parts = append(parts, "→ "+*tag.Result)
+	}
+	if tag.YardsGained != nil {
+		parts = append(parts, fmt.Sprintf("%+d yds", *tag.YardsGained))
+	}
+	if len(parts) == 0 {
+		parts = append(parts, "untitled tag")
+	}
+	return fmt.Sprintf("%s (tag %s, clip %s)", strings.Join(parts, " "), tag.ID, tag.ClipID)
+}
+
+// ordinal renders a down as 1st, 2nd, 3rd or 4th
+func ordinal(n int) string {
+	switch n {
+	case 1:
+		return "1st"
+	case 2:
+		return "2nd"
+	case 3:
+		return "3rd"
+	default:
+		return fmt.Sprintf("%dth", n)
+	}
+}
+
+// formatElapsed renders a duration as e.g. "1h 12m" or "25m"
+func formatElapsed(d time.Duration) string {
+	d = d.Round(time.Minute)
+	if h := int(d.Hours()); h > 0 {
+		return fmt.Sprintf("%dh %dm", h, int(d.Minutes())%60)
+	}
+	return fmt.Sprintf("%dm", int(d.Minutes()))
+}
+
+func makeSessionHandoff(c *videoplatform.Client, p presenter) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		sessionID, _ := req.Params.Arguments["session_id"].(string)
+		if sessionID == "" {
+			return mcp.NewToolResultError("session_id is required"), nil
+		}
+
+		d, err := fetchHandoff(ctx, c, sessionID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get session: %v", err)), nil
+		}
+		return mcp.NewToolResultText(handoffBriefing(d, p, time.Now())), nil
+	}
+}
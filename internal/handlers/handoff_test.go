@@ -0,0 +1,171 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestSessionHandoff(t *testing.T) {
+	now := time.Now().UTC()
+	ago := func(d time.Duration) string { return now.Add(-d).Format(time.RFC3339) }
+	ptr := func(v int) *int { return &v }
+	str := func(v string) *string { return &v }
+
+	started := ago(75 * time.Minute)
+	session := videoplatform.Session{ID: "s-1", Name: "Week 3 vs Eagles", Status: "active", ActualStart: &started}
+	clips := []videoplatform.Clip{
+		{ID: "c-1", Status: "ready", CreatedAt: ago(60 * time.Minute)},
+		{ID: "c-2", Status: "ready", CreatedAt: ago(40 * time.Minute)},
+		{ID: "c-3", Status: "processing", CreatedAt: ago(30 * time.Minute)},
+		{ID: "c-4", Status: "failed", CreatedAt: ago(20 * time.Minute)},
+		{ID: "c-5", Status: "processing", CreatedAt: ago(time.Minute)},
+	}
+	var tags []videoplatform.Tag
+	for i := 1; i <= 6; i++ {
+		tags = append(tags, videoplatform.Tag{
+			ID:        fmt.Sprintf("t-%d", i),
+			ClipID:    []string{"c-1", "c-2"}[i%2],
+			Quarter:   ptr(2),
+			Down:      ptr(i%4 + 1),
+			Distance:  ptr(10),
+			PlayType:  str("run"),
+			Labels:    []string{fmt.Sprintf("clock:%02d:00", i)},
+			CreatedAt: ago(time.Duration(60-i) * time.Minute),
+		})
+	}
+	tags[5].IsImportant = true
+	tags[0].IsImportant, tags[0].IsReviewed = true, true
+	errMsg := "no signal"
+	channels := []videoplatform.Channel{
+		{ID: "ch-1", Name: "Sideline", Status: "active"},
+		{ID: "ch-2", Name: "End Zone", Status: "error", ErrorMessage: &errMsg},
+	}
+
+	tests := []struct {
+		name     string
+		session  videoplatform.Session
+		clips    []videoplatform.Clip
+		tags     []videoplatform.Tag
+		channels []videoplatform.Channel
+		failTags bool
+		want     []string
+		notWant  []string
+	}{
+		{
+			name:     "mid game",
+			session:  session,
+			clips:    clips,
+			tags:     tags,
+			channels: channels,
+			want: []string{
+				"# Handoff: Week 3 vs Eagles",
+				"**Status:** active, 1h 15m elapsed",
+				"**Clips:** 5 recorded, 2 tagged, 3 untagged",
+				"Q2 06:00 3rd & 10 run (tag t-6, clip c-1)",
+				"(tag t-2, clip c-1)",
+				"End Zone",
+				"Clip c-3 processing for 30m",
+				"Clip c-4 failed processing",
+				"Important tag not reviewed: Q2 06:00",
+			},
+			notWant: []string{"(tag t-1,", "Sideline", "c-5"},
+		},
+		{
+			name:     "empty sections",
+			session:  videoplatform.Session{ID: "s-1", Name: "Scrimmage", Status: "scheduled"},
+			channels: channels[:1],
+			want: []string{
+				"**Status:** scheduled\n",
+				"**Clips:** 0 recorded, 0 tagged, 0 untagged",
+				"## Last tags\n_No tags yet_",
+				"## Channels in error\n_None_",
+				"## Open items\n_None_",
+			},
+		},
+		{
+			name:     "tags unavailable",
+			session:  session,
+			clips:    clips,
+			channels: channels,
+			failTags: true,
+			want: []string{
+				"**Clips:** 5 recorded (tag coverage unavailable",
+				"## Last tags\n_Unavailable:",
+				"Clip c-4 failed processing",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/api/v1/sessions/s-1":
+					json.NewEncoder(w).Encode(tt.session)
+				case "/api/v1/clips":
+					json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Clip]{Data: tt.clips, Total: len(tt.clips)})
+				case "/api/v1/tags":
+					if tt.failTags {
+						w.WriteHeader(http.StatusInternalServerError)
+						return
+					}
+					json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Tag]{Data: tt.tags, Total: len(tt.tags)})
+				case "/api/v1/channels":
+					json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Channel]{Data: tt.channels, Total: len(tt.channels)})
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			})
+			defer server.Close()
+
+			handler := makeSessionHandoff(videoplatform.New(server.URL), newPresenter(false))
+			req := mcp.CallToolRequest{}
+			req.Params.Arguments = map[string]interface{}{"session_id": "s-1"}
+
+			result, err := handler(context.Background(), req)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result.IsError {
+				t.Fatalf("Unexpected tool error: %v", result.Content)
+			}
+			text := result.Content[0].(mcp.TextContent).Text
+			for _, want := range tt.want {
+				if !strings.Contains(text, want) {
+					t.Errorf("briefing missing %q:\n%s", want, text)
+				}
+			}
+			for _, notWant := range tt.notWant {
+				if strings.Contains(text, notWant) {
+					t.Errorf("briefing should not contain %q:\n%s", notWant, text)
+				}
+			}
+		})
+	}
+}
+
+func TestSessionHandoff_SessionNotFound(t *testing.T) {
+	server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/sessions/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Tag]{})
+	})
+	defer server.Close()
+
+	handler := makeSessionHandoff(videoplatform.New(server.URL), newPresenter(false))
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{"session_id": "missing"}
+
+	result, _ := handler(context.Background(), req)
+	verifyError(t, result, "Failed to get session")
+}
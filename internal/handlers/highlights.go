@@ -0,0 +1,128 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// reelClip is one clip of a highlight reel. Note is the clip's favorite
+// note, used as its caption.
+type reelClip struct {
+	ClipID          string  `json:"clip_id"`
+	Title           *string `json:"title,omitempty"`
+	StartTime       string  `json:"start_time"`
+	DurationSeconds float64 `json:"duration_seconds"`
+	Note            *string `json:"note,omitempty"`
+}
+
+// highlightClips picks the favorites for a reel: those with a tag of the
+// play type, ignoring case, when one is given, in chronological order, at
+// most maxClips of them when maxClips is positive
+func highlightClips(favorites []videoplatform.Clip, tags []videoplatform.Tag, playType string, maxClips int) []videoplatform.Clip {
+	var matching map[string]bool
+	if playType != "" {
+		matching = make(map[string]bool)
+		for _, tag := range tags {
+			if tag.PlayType != nil && strings.EqualFold(strings.TrimSpace(*tag.PlayType), strings.TrimSpace(playType)) {
+				matching[tag.ClipID] = true
+			}
+		}
+	}
+
+	var clips []videoplatform.Clip
+	for _, clip := range favorites {
+		if matching == nil || matching[clip.ID] {
+			clips = append(clips, clip)
+		}
+	}
+	sortByStartTime(clips)
+	if maxClips > 0 && len(clips) > maxClips {
+		clips = clips[:maxClips]
+	}
+	return clips
+}
+
+func makeGenerateHighlightReel(c *videoplatform.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		sessionID, _ := req.Params.Arguments["session_id"].(string)
+		if sessionID == "" {
+			return mcp.NewToolResultError("session_id is required"), nil
+		}
+		playType, _ := req.Params.Arguments["play_type"].(string)
+		maxClips := 0
+		if n, ok := req.Params.Arguments["max_clips"].(float64); ok {
+			if n < 1 {
+				return mcp.NewToolResultError("max_clips must be at least 1"), nil
+			}
+			maxClips = int(n)
+		}
+
+		session, err := c.GetSession(ctx, sessionID)
+		if err != nil {
+			if apiStatus(err) == http.StatusNotFound {
+				return mcp.NewToolResultError(fmt.Sprintf("Session %s not found", sessionID)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get session: %v", err)), nil
+		}
+		favorite := true
+		favorites, err := c.ListAllClips(ctx, videoplatform.ListClipsParams{SessionID: sessionID, Favorite: &favorite})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list clips: %v", err)), nil
+		}
+		var tags []videoplatform.Tag
+		if playType != "" && len(favorites) > 0 {
+			if tags, err = c.ListAllTags(ctx, videoplatform.ListTagsParams{SessionID: sessionID, PlayType: playType}); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to list tags: %v", err)), nil
+			}
+		}
+
+		clips := highlightClips(favorites, tags, playType, maxClips)
+		if len(clips) == 0 {
+			scope := ""
+			if playType != "" {
+				scope = fmt.Sprintf(" tagged %s", playType)
+			}
+			return mcp.NewToolResultText(fmt.Sprintf(
+				"Session '%s' has no favorite clips%s yet, so no playlist was created. Run the review_clips prompt for session %s first and mark the plays worth keeping with set_clip_favorite.",
+				session.Name, scope, sessionID)), nil
+		}
+
+		playlist, err := c.CreatePlaylist(ctx, videoplatform.CreatePlaylistRequest{Name: session.Name + " highlights"})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create playlist: %v", err)), nil
+		}
+		ids := make([]string, len(clips))
+		for i, clip := range clips {
+			ids[i] = clip.ID
+		}
+		if _, err := c.AddClipsToPlaylist(ctx, playlist.ID, ids); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Created playlist %s but failed to add its clips: %v", playlist.ID, err)), nil
+		}
+
+		result := struct {
+			PlaylistID string     `json:"playlist_id"`
+			Name       string     `json:"name"`
+			Favorites  int        `json:"favorites"`
+			Clips      []reelClip `json:"clips"`
+		}{PlaylistID: playlist.ID, Name: playlist.Name, Favorites: len(favorites)}
+		for _, clip := range clips {
+			result.Clips = append(result.Clips, reelClip{
+				ClipID:          clip.ID,
+				Title:           clip.Title,
+				StartTime:       clip.StartTime,
+				DurationSeconds: clipDuration(clip),
+				Note:            clip.FavoriteNote,
+			})
+		}
+
+		data, _ := json.MarshalIndent(result, "", "  ")
+		return mcp.NewToolResultText(fmt.Sprintf("Created highlight reel %s with %d clips:\n%s", playlist.ID, len(clips), data)), nil
+	}
+}
@@ -0,0 +1,138 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestGenerateHighlightReel(t *testing.T) {
+	title, note, pass, run := "Johnson 45yd TD", "Great release off the line", "pass", "run"
+	clips := []videoplatform.Clip{
+		{ID: "clip-3", SessionID: "s-1", StartTime: "2026-10-17T19:30:00Z", IsFavorite: true, DurationSeconds: 9},
+		{ID: "clip-1", SessionID: "s-1", StartTime: "2026-10-17T19:05:00Z", IsFavorite: true, Title: &title, FavoriteNote: &note, DurationSeconds: 12},
+		{ID: "clip-2", SessionID: "s-1", StartTime: "2026-10-17T19:10:00Z"},
+		{ID: "clip-4", SessionID: "s-1", StartTime: "2026-10-17T19:20:00Z", IsFavorite: true, DurationSeconds: 7},
+	}
+	tags := []videoplatform.Tag{
+		{ID: "tag-1", ClipID: "clip-1", PlayType: &pass},
+		{ID: "tag-3", ClipID: "clip-3", PlayType: &pass},
+		{ID: "tag-4", ClipID: "clip-4", PlayType: &run},
+	}
+
+	newPlatform := func(t *testing.T, clips []videoplatform.Clip) (*playlistPlatform, *videoplatform.Client) {
+		playlists := &playlistPlatform{playlists: make(map[string]*videoplatform.Playlist)}
+		server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+			q := r.URL.Query()
+			switch {
+			case strings.HasPrefix(r.URL.Path, "/api/v1/playlists"):
+				playlists.ServeHTTP(w, r)
+			case r.URL.Path == "/api/v1/sessions/s-1":
+				json.NewEncoder(w).Encode(videoplatform.Session{ID: "s-1", Name: "Week 3 vs Eagles"})
+			case r.URL.Path == "/api/v1/clips":
+				var data []videoplatform.Clip
+				for _, clip := range clips {
+					if q.Get("favorite") != "true" || clip.IsFavorite {
+						data = append(data, clip)
+					}
+				}
+				json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Clip]{Data: data, Total: len(data)})
+			case r.URL.Path == "/api/v1/tags":
+				var data []videoplatform.Tag
+				for _, tag := range tags {
+					// The platform matches play types ignoring case
+					if q.Get("play_type") == "" || strings.EqualFold(*tag.PlayType, q.Get("play_type")) {
+						data = append(data, tag)
+					}
+				}
+				json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Tag]{Data: data, Total: len(data)})
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		})
+		t.Cleanup(server.Close)
+		return playlists, videoplatform.New(server.URL)
+	}
+	generate := func(t *testing.T, c *videoplatform.Client, args map[string]interface{}) *mcp.CallToolResult {
+		t.Helper()
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = args
+		result, err := makeGenerateHighlightReel(c)(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return result
+	}
+
+	t.Run("favorites in chronological order", func(t *testing.T) {
+		p, c := newPlatform(t, clips)
+		result := generate(t, c, map[string]interface{}{"session_id": "s-1"})
+		if result.IsError {
+			t.Fatalf("Unexpected tool error: %v", result.Content)
+		}
+		playlist := p.playlists["pl-1"]
+		if playlist == nil || playlist.Name != "Week 3 vs Eagles highlights" {
+			t.Fatalf("playlist = %+v", playlist)
+		}
+		if want := []string{"clip-1", "clip-4", "clip-3"}; !reflect.DeepEqual(playlist.ClipIDs, want) {
+			t.Errorf("playlist clips = %v, want %v", playlist.ClipIDs, want)
+		}
+		text := result.Content[0].(mcp.TextContent).Text
+		if !strings.Contains(text, `"title": "Johnson 45yd TD"`) || !strings.Contains(text, `"note": "Great release off the line"`) {
+			t.Errorf("Expected clip titles and notes in the result, got:\n%s", text)
+		}
+	})
+
+	t.Run("play type and max clips", func(t *testing.T) {
+		p, c := newPlatform(t, clips)
+		result := generate(t, c, map[string]interface{}{"session_id": "s-1", "play_type": "pass", "max_clips": float64(1)})
+		if result.IsError {
+			t.Fatalf("Unexpected tool error: %v", result.Content)
+		}
+		if got := p.playlists["pl-1"].ClipIDs; !reflect.DeepEqual(got, []string{"clip-1"}) {
+			t.Errorf("playlist clips = %v, want clip-1", got)
+		}
+	})
+
+	t.Run("play type ignores case", func(t *testing.T) {
+		p, c := newPlatform(t, clips)
+		result := generate(t, c, map[string]interface{}{"session_id": "s-1", "play_type": "Pass"})
+		if result.IsError {
+			t.Fatalf("Unexpected tool error: %v", result.Content)
+		}
+		playlist := p.playlists["pl-1"]
+		if playlist == nil {
+			t.Fatalf("Expected a playlist, got %v", result.Content)
+		}
+		if got, want := playlist.ClipIDs, []string{"clip-1", "clip-3"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("playlist clips = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("no favorites", func(t *testing.T) {
+		p, c := newPlatform(t, clips[2:3])
+		result := generate(t, c, map[string]interface{}{"session_id": "s-1"})
+		if result.IsError {
+			t.Fatalf("Unexpected tool error: %v", result.Content)
+		}
+		if text := result.Content[0].(mcp.TextContent).Text; !strings.Contains(text, "Run the review_clips prompt") {
+			t.Errorf("Expected a suggestion to review first, got %q", text)
+		}
+		if len(p.playlists) != 0 {
+			t.Errorf("Created %d playlists, want none", len(p.playlists))
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, c := newPlatform(t, clips)
+		verifyError(t, generate(t, c, map[string]interface{}{}), "session_id is required")
+		verifyError(t, generate(t, c, map[string]interface{}{"session_id": "s-1", "max_clips": float64(0)}), "max_clips must be at least 1")
+		verifyError(t, generate(t, c, map[string]interface{}{"session_id": "missing"}), "Session missing not found")
+	})
+}
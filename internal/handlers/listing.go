@@ -0,0 +1,98 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// limitHint tells the model how to see results past the page
+const limitHint = "increase limit to see more"
+
+// listEnvelope is a page of results as returned by the list tools. The
+// paging fields come first so they aren't lost below a long page.
+// Truncated is set when more results match than the page holds.
+type listEnvelope[T any] struct {
+	Total     int    `json:"total"`
+	Limit     int    `json:"limit"`
+	Offset    int    `json:"offset"`
+	Truncated bool   `json:"truncated,omitempty"`
+	Notice    string `json:"notice,omitempty"`
+	Data      []T    `json:"data"`
+
+	// scanned is how many results the platform returned for the page when
+	// some were filtered out afterwards; paging counts those
+	scanned int
+}
+
+// newListEnvelope wraps data with the paging of the response it came from
+func newListEnvelope[T, U any](data []T, resp *videoplatform.PaginatedResponse[U]) listEnvelope[T] {
+	if data == nil {
+		data = []T{}
+	}
+	return listEnvelope[T]{Data: data, Total: resp.Total, Limit: resp.Limit, Offset: resp.Offset}
+}
+
+// withRequested fills in the requested paging where the platform didn't
+// echo it
+func (e listEnvelope[T]) withRequested(limit, offset int) listEnvelope[T] {
+	if e.Limit == 0 {
+		e.Limit = limit
+	}
+	if e.Offset == 0 {
+		e.Offset = offset
+	}
+	return e
+}
+
+// filterPage drops the results keep rejects from a page the platform
+// returned, keeping the paging of the unfiltered page
+func (e listEnvelope[T]) filterPage(keep func(T) bool) listEnvelope[T] {
+	e.scanned = e.pageLen()
+	kept := []T{}
+	for _, item := range e.Data {
+		if keep(item) {
+			kept = append(kept, item)
+		}
+	}
+	e.Data = kept
+	return e
+}
+
+// pageLen is how many of the platform's results the page covers
+func (e listEnvelope[T]) pageLen() int {
+	if e.scanned > 0 {
+		return e.scanned
+	}
+	return len(e.Data)
+}
+
+// nextPageHint tells the model which results the page holds and which
+// offset fetches the following page, e.g. "showing 21–40 of 312; call
+// again with offset=40"
+func (e listEnvelope[T]) nextPageHint() string {
+	next := e.Offset + e.pageLen()
+	return fmt.Sprintf("showing %d–%d of %d; call again with offset=%d", e.Offset+1, next, e.Total, next)
+}
+
+// remaining returns how many matching results lie past this page
+func (e listEnvelope[T]) remaining() int {
+	return max(e.Total-e.Offset-e.pageLen(), 0)
+}
+
+// listResult renders a page as JSON. When results were cut off it sets
+// truncated and appends a warning naming how many more there are, so the
+// model doesn't mistake one page for the whole list. noun is plural.
+func listResult[T any](e listEnvelope[T], noun, hint string, p presenter) *mcp.CallToolResult {
+	more := e.remaining()
+	e.Truncated = more > 0
+
+	data, _ := json.MarshalIndent(e, "", "  ")
+	text := string(data)
+	if e.Truncated {
+		text += fmt.Sprintf("\n\n%s %d more %s not shown — %s", p.glyphs[levelWarn], more, noun, hint)
+	}
+	return mcp.NewToolResultText(text)
+}
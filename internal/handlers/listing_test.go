@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestListResult_Truncation(t *testing.T) {
+	page := func(n int) []videoplatform.Clip { return make([]videoplatform.Clip, n) }
+
+	tests := []struct {
+		name        string
+		data        []videoplatform.Clip
+		total       int
+		offset      int
+		wantWarning string
+	}{
+		{"first page of many", page(20), 250, 0, "⚠️ 230 more clips not shown — increase limit to see more"},
+		{"middle page", page(20), 250, 100, "⚠️ 130 more clips not shown"},
+		{"exact fit", page(20), 20, 0, ""},
+		{"last page", page(10), 110, 100, ""},
+		{"empty", nil, 0, 0, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &videoplatform.PaginatedResponse[videoplatform.Clip]{Data: tt.data, Total: tt.total, Offset: tt.offset}
+			result := listResult(newListEnvelope(resp.Data, resp), "clips", limitHint, newPresenter(false))
+			text := result.Content[0].(mcp.TextContent).Text
+
+			body, warning, _ := strings.Cut(text, "\n\n")
+			if tt.wantWarning == "" && warning != "" {
+				t.Errorf("Unexpected warning %q", warning)
+			}
+			if tt.wantWarning != "" && !strings.HasPrefix(warning, tt.wantWarning) {
+				t.Errorf("warning = %q, want %q", warning, tt.wantWarning)
+			}
+			if strings.Count(text, "not shown") > 1 {
+				t.Errorf("warning repeated in %q", text)
+			}
+
+			var envelope map[string]interface{}
+			if err := json.Unmarshal([]byte(body), &envelope); err != nil {
+				t.Fatalf("Failed to parse envelope: %v", err)
+			}
+			if truncated, _ := envelope["truncated"].(bool); truncated != (tt.wantWarning != "") {
+				t.Errorf("truncated = %v, want %v", envelope["truncated"], tt.wantWarning != "")
+			}
+		})
+	}
+}
+
+func TestNextPageHint(t *testing.T) {
+	tests := []struct {
+		name             string
+		offset, n, total int
+		want             string
+	}{
+		{"first page", 0, 20, 312, "showing 1–20 of 312; call again with offset=20"},
+		{"middle page", 20, 20, 312, "showing 21–40 of 312; call again with offset=40"},
+		{"short page", 300, 5, 312, "showing 301–305 of 312; call again with offset=305"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := listEnvelope[int]{Offset: tt.offset, Data: make([]int, tt.n), Total: tt.total}
+			if got := e.nextPageHint(); got != tt.want {
+				t.Errorf("nextPageHint() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	// The last page has nothing left, so no hint is shown
+	last := listEnvelope[int]{Offset: 300, Data: make([]int, 12), Total: 312, Limit: 20}
+	if text := listResult(last, "clips", last.nextPageHint(), newPresenter(false)).Content[0].(mcp.TextContent).Text; strings.Contains(text, "offset=") {
+		t.Errorf("last page shows a next-page hint: %s", text)
+	}
+}
+
+func TestListResult_PlainGlyph(t *testing.T) {
+	resp := &videoplatform.PaginatedResponse[videoplatform.Session]{Data: make([]videoplatform.Session, 2), Total: 5}
+	result := listResult(newListEnvelope(resp.Data, resp), "sessions", limitHint, newPresenter(true))
+	if text := result.Content[0].(mcp.TextContent).Text; !strings.HasSuffix(text, "[WARN] 3 more sessions not shown — increase limit to see more") {
+		t.Errorf("Unexpected text %q", text)
+	}
+}
+
+func TestListSessions_TruncationWarning(t *testing.T) {
+	server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Session]{
+			Data:  []videoplatform.Session{{ID: "s-1"}, {ID: "s-2"}},
+			Total: 42,
+			Limit: 2,
+		})
+	})
+	defer server.Close()
+
+	handler := makeListSessions(videoplatform.New(server.URL), 2, nil, newPresenter(false))
+	result, err := handler(context.Background(), mcp.CallToolRequest{})
+	if err != nil || result.IsError {
+		t.Fatalf("Expected success, got %v %v", result, err)
+	}
+	if text := result.Content[0].(mcp.TextContent).Text; !strings.Contains(text, "40 more sessions not shown") || !strings.Contains(text, `"truncated": true`) {
+		t.Errorf("Expected truncation warning, got %s", text)
+	}
+}
@@ -0,0 +1,166 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// defaultLocker identifies locks taken without an explicit locked_by
+const defaultLocker = "mcp-assistant"
+
+// sessionLocks tracks edit locks on sessions. The platform's lock endpoints
+// are authoritative; when the platform doesn't provide them, locks are kept
+// in memory for the life of this process.
+type sessionLocks struct {
+	c     *videoplatform.Client
+	mu    sync.Mutex
+	local map[string]videoplatform.SessionLock
+}
+
+func newSessionLocks(c *videoplatform.Client) *sessionLocks {
+	return &sessionLocks{
+		c:     c,
+		local: make(map[string]videoplatform.SessionLock),
+	}
+}
+
+// get returns the current lock on a session, or nil if it is unlocked
+func (l *sessionLocks) get(ctx context.Context, sessionID string) (*videoplatform.SessionLock, error) {
+	lock, err := l.c.GetSessionLock(ctx, sessionID)
+	if err == nil {
+		if !lock.Locked {
+			return nil, nil
+		}
+		return lock, nil
+	}
+	if !videoplatform.IsNotSupported(err) {
+		return nil, err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if local, ok := l.local[sessionID]; ok {
+		return &local, nil
+	}
+	return nil, nil
+}
+
+// lock acquires a lock, reporting whether the in-memory fallback was used
+func (l *sessionLocks) lock(ctx context.Context, sessionID string, req videoplatform.LockSessionRequest) (*videoplatform.SessionLock, bool, error) {
+	lock, err := l.c.LockSession(ctx, sessionID, req)
+	if err == nil {
+		return lock, false, nil
+	}
+	if !videoplatform.IsNotSupported(err) {
+		return nil, false, err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if existing, ok := l.local[sessionID]; ok {
+		return nil, true, fmt.Errorf("session is already locked by %s", existing.LockedBy)
+	}
+
+	local := videoplatform.SessionLock{
+		SessionID: sessionID,
+		Locked:    true,
+		LockedBy:  req.LockedBy,
+		Note:      req.Note,
+		LockedAt:  time.Now().UTC().Format(time.RFC3339),
+	}
+	l.local[sessionID] = local
+	return &local, true, nil
+}
+
+// unlock releases a lock, reporting whether the in-memory fallback was used
+func (l *sessionLocks) unlock(ctx context.Context, sessionID string) (bool, error) {
+	err := l.c.UnlockSession(ctx, sessionID)
+	if err == nil {
+		return false, nil
+	}
+	if !videoplatform.IsNotSupported(err) {
+		return false, err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, ok := l.local[sessionID]; !ok {
+		return true, fmt.Errorf("session is not locked")
+	}
+	delete(l.local, sessionID)
+	return true, nil
+}
+
+// guard returns a refusal result when the session is locked and the caller
+// did not pass override_lock, or nil when the mutation may proceed. If the
+// lock state can't be determined the mutation is refused as well.
+func (l *sessionLocks) guard(ctx context.Context, req mcp.CallToolRequest, sessionID string) *mcp.CallToolResult {
+	if override, _ := req.Params.Arguments["override_lock"].(bool); override {
+		return nil
+	}
+
+	lock, err := l.get(ctx, sessionID)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Could not verify lock on session %s: %v. Pass override_lock: true to proceed anyway.", sessionID, err))
+	}
+	if lock == nil {
+		return nil
+	}
+
+	msg := fmt.Sprintf("Session %s is locked by %s", sessionID, lock.LockedBy)
+	if lock.LockedAt != "" {
+		msg += fmt.Sprintf(" since %s", lock.LockedAt)
+	}
+	if lock.Note != "" {
+		msg += fmt.Sprintf(": %q", lock.Note)
+	}
+	return mcp.NewToolResultError(msg + ". Pass override_lock: true to proceed anyway.")
+}
+
+func makeLockSession(locks *sessionLocks) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		sessionID, _ := req.Params.Arguments["session_id"].(string)
+		if sessionID == "" {
+			return mcp.NewToolResultError("session_id is required"), nil
+		}
+
+		lockReq := videoplatform.LockSessionRequest{LockedBy: defaultLocker}
+		if lockedBy, ok := req.Params.Arguments["locked_by"].(string); ok && lockedBy != "" {
+			lockReq.LockedBy = lockedBy
+		}
+		if note, ok := req.Params.Arguments["note"].(string); ok {
+			lockReq.Note = note
+		}
+
+		lock, fallback, err := locks.lock(ctx, sessionID, lockReq)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to lock session: %v", err)), nil
+		}
+
+		text := fmt.Sprintf("Session %s locked by %s", sessionID, lock.LockedBy)
+		if fallback {
+			text += " (held by this MCP server only; the platform does not support session locks)"
+		}
+		return mcp.NewToolResultText(text), nil
+	}
+}
+
+func makeUnlockSession(locks *sessionLocks) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		sessionID, _ := req.Params.Arguments["session_id"].(string)
+		if sessionID == "" {
+			return mcp.NewToolResultError("session_id is required"), nil
+		}
+
+		if _, err := locks.unlock(ctx, sessionID); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to unlock session: %v", err)), nil
+		}
+		return mcp.NewToolResultText(fmt.Sprintf("Session %s unlocked", sessionID)), nil
+	}
+}
@@ -0,0 +1,201 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestLockSession(t *testing.T) {
+	t.Run("acquires lock via API", func(t *testing.T) {
+		server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != "POST" || r.URL.Path != "/api/v1/sessions/session-1/lock" {
+				t.Errorf("Expected POST /api/v1/sessions/session-1/lock, got %s %s", r.Method, r.URL.Path)
+			}
+
+			var req videoplatform.LockSessionRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			if req.Note != "Live game" {
+				t.Errorf("Expected note 'Live game', got %q", req.Note)
+			}
+			if req.LockedBy != defaultLocker {
+				t.Errorf("Expected locked_by %q, got %q", defaultLocker, req.LockedBy)
+			}
+
+			json.NewEncoder(w).Encode(videoplatform.SessionLock{SessionID: "session-1", Locked: true, LockedBy: req.LockedBy, Note: req.Note})
+		})
+		defer server.Close()
+
+		handler := makeLockSession(newSessionLocks(videoplatform.New(server.URL)))
+
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{
+			"session_id": "session-1",
+			"note":       "Live game",
+		}
+
+		result, err := handler(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result.IsError {
+			t.Errorf("Expected success, got error: %v", result.Content)
+		}
+		content := result.Content[0].(mcp.TextContent)
+		if strings.Contains(content.Text, "platform does not support") {
+			t.Errorf("Expected API-backed lock, got: %s", content.Text)
+		}
+	})
+
+	t.Run("missing session_id", func(t *testing.T) {
+		handler := makeLockSession(newSessionLocks(videoplatform.New("http://localhost:8080")))
+
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{}
+
+		result, err := handler(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !result.IsError {
+			t.Error("Expected error for missing session_id")
+		}
+	})
+}
+
+func TestSessionLockGuard(t *testing.T) {
+	// lockedPlatform reports session-1 as locked and records whether the
+	// complete endpoint was reached
+	lockedPlatform := func(completed *bool) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/api/v1/sessions/session-1/lock":
+				json.NewEncoder(w).Encode(videoplatform.SessionLock{
+					SessionID: "session-1",
+					Locked:    true,
+					LockedBy:  "press-box",
+					Note:      "Live game, do not complete",
+				})
+			case "/api/v1/sessions/session-1":
+				json.NewEncoder(w).Encode(videoplatform.Session{ID: "session-1", Name: "Game 1", Status: "active"})
+			case "/api/v1/sessions/session-1/complete":
+				*completed = true
+				json.NewEncoder(w).Encode(videoplatform.Session{ID: "session-1", Name: "Game 1", Status: "completed"})
+			}
+		}
+	}
+
+	t.Run("refuses while locked", func(t *testing.T) {
+		completed := false
+		server := mockServer(t, lockedPlatform(&completed))
+		defer server.Close()
+
+		c := videoplatform.New(server.URL)
+		handler := makeCompleteSession(c, newSessionLocks(c))
+
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"session_id": "session-1"}
+
+		result, err := handler(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		verifyError(t, result, "Live game, do not complete")
+		verifyError(t, result, "press-box")
+		if completed {
+			t.Error("Complete endpoint should not be called while locked")
+		}
+	})
+
+	t.Run("override_lock proceeds", func(t *testing.T) {
+		completed := false
+		server := mockServer(t, lockedPlatform(&completed))
+		defer server.Close()
+
+		c := videoplatform.New(server.URL)
+		handler := makeCompleteSession(c, newSessionLocks(c))
+
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{
+			"session_id":    "session-1",
+			"override_lock": true,
+		}
+
+		result, err := handler(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result.IsError {
+			t.Errorf("Expected success with override, got: %v", result.Content)
+		}
+		if !completed {
+			t.Error("Expected complete endpoint to be called with override")
+		}
+	})
+}
+
+func TestSessionLockFallback(t *testing.T) {
+	paused := false
+	server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/lock") {
+			http.NotFound(w, r)
+			return
+		}
+		if r.Method == http.MethodGet {
+			json.NewEncoder(w).Encode(videoplatform.Session{ID: "session-1", Name: "Game 1", Status: "active"})
+			return
+		}
+		paused = true
+		json.NewEncoder(w).Encode(videoplatform.Session{ID: "session-1", Name: "Game 1", Status: "paused"})
+	})
+	defer server.Close()
+
+	c := videoplatform.New(server.URL)
+	locks := newSessionLocks(c)
+
+	lockReq := mcp.CallToolRequest{}
+	lockReq.Params.Arguments = map[string]interface{}{
+		"session_id": "session-1",
+		"locked_by":  "press-box",
+		"note":       "Halftime review",
+	}
+	result, _ := makeLockSession(locks)(context.Background(), lockReq)
+	if result.IsError {
+		t.Fatalf("Expected fallback lock to succeed, got: %v", result.Content)
+	}
+	if !strings.Contains(result.Content[0].(mcp.TextContent).Text, "platform does not support") {
+		t.Errorf("Expected fallback notice, got: %v", result.Content)
+	}
+
+	// A second lock on the same session is rejected
+	result, _ = makeLockSession(locks)(context.Background(), lockReq)
+	verifyError(t, result, "already locked by press-box")
+
+	pauseReq := mcp.CallToolRequest{}
+	pauseReq.Params.Arguments = map[string]interface{}{"session_id": "session-1"}
+	result, _ = makePauseSession(c, locks)(context.Background(), pauseReq)
+	verifyError(t, result, "Halftime review")
+	if paused {
+		t.Error("Pause endpoint should not be called while locked")
+	}
+
+	unlockReq := mcp.CallToolRequest{}
+	unlockReq.Params.Arguments = map[string]interface{}{"session_id": "session-1"}
+	result, _ = makeUnlockSession(locks)(context.Background(), unlockReq)
+	if result.IsError {
+		t.Fatalf("Expected fallback unlock to succeed, got: %v", result.Content)
+	}
+
+	result, _ = makePauseSession(c, locks)(context.Background(), pauseReq)
+	if result.IsError {
+		t.Errorf("Expected pause to succeed after unlock, got: %v", result.Content)
+	}
+	if !paused {
+		t.Error("Expected pause endpoint to be called after unlock")
+	}
+}
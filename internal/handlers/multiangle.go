@@ -0,0 +1,138 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// defaultAngleTolerance is how far apart, in seconds, two cameras' clips
+// of the same play may start or end
+const defaultAngleTolerance = 2
+
+// angleClip is one camera's clip of a play
+type angleClip struct {
+	ClipID          string  `json:"clip_id"`
+	ChannelID       string  `json:"channel_id"`
+	Title           *string `json:"title,omitempty"`
+	StartTime       string  `json:"start_time"`
+	DurationSeconds float64 `json:"duration_seconds"`
+}
+
+// angleGroup is the clips of one play from different channels.
+// SingleAngle marks plays only one camera caught.
+type angleGroup struct {
+	StartTime   string      `json:"start_time"`
+	SingleAngle bool        `json:"single_angle,omitempty"`
+	Clips       []angleClip `json:"clips"`
+}
+
+// angleReport is the result of multi_angle_clips
+type angleReport struct {
+	SessionID        string       `json:"session_id"`
+	ToleranceSeconds float64      `json:"tolerance_seconds"`
+	Clips            int          `json:"clips"`
+	SingleAngle      int          `json:"single_angle"`
+	Groups           []angleGroup `json:"groups"`
+}
+
+// groupAngles groups clips from different channels whose time ranges
+// overlap once widened by tolerance. Each clip joins the earliest group
+// whose first clip it overlaps and whose channels don't include its own,
+// so back-to-back plays from one camera stay apart. Clips without
+// parseable times get a group each, after the rest.
+func groupAngles(clips []videoplatform.Clip, tolerance time.Duration) []angleGroup {
+	clips = append([]videoplatform.Clip(nil), clips...)
+	sortByStartTime(clips)
+
+	type span struct{ start, end time.Time }
+	type group struct {
+		anchor   span
+		channels map[string]bool
+		clips    []videoplatform.Clip
+	}
+	var groups []*group
+	var unplaced []videoplatform.Clip
+	for _, clip := range clips {
+		start, err := time.Parse(time.RFC3339, clip.StartTime)
+		if err != nil {
+			unplaced = append(unplaced, clip)
+			continue
+		}
+		s := span{start, start.Add(time.Duration(clipDuration(clip) * float64(time.Second)))}
+
+		var joined *group
+		for _, g := range groups {
+			if g.channels[clip.ChannelID] {
+				continue
+			}
+			if !s.start.After(g.anchor.end.Add(tolerance)) && !s.end.Before(g.anchor.start.Add(-tolerance)) {
+				joined = g
+				break
+			}
+		}
+		if joined == nil {
+			joined = &group{anchor: s, channels: make(map[string]bool)}
+			groups = append(groups, joined)
+		}
+		joined.channels[clip.ChannelID] = true
+		joined.clips = append(joined.clips, clip)
+	}
+	for _, clip := range unplaced {
+		groups = append(groups, &group{clips: []videoplatform.Clip{clip}})
+	}
+
+	result := make([]angleGroup, 0, len(groups))
+	for _, g := range groups {
+		ag := angleGroup{StartTime: g.clips[0].StartTime, SingleAngle: len(g.clips) == 1}
+		for _, clip := range g.clips {
+			ag.Clips = append(ag.Clips, angleClip{
+				ClipID:          clip.ID,
+				ChannelID:       clip.ChannelID,
+				Title:           clip.Title,
+				StartTime:       clip.StartTime,
+				DurationSeconds: clipDuration(clip),
+			})
+		}
+		result = append(result, ag)
+	}
+	return result
+}
+
+func makeMultiAngleClips(c *videoplatform.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		sessionID, _ := req.Params.Arguments["session_id"].(string)
+		if sessionID == "" {
+			return mcp.NewToolResultError("session_id is required"), nil
+		}
+		tolerance := float64(defaultAngleTolerance)
+		if n, ok := req.Params.Arguments["tolerance_seconds"].(float64); ok {
+			if n < 0 {
+				return mcp.NewToolResultError("tolerance_seconds must not be negative"), nil
+			}
+			tolerance = n
+		}
+
+		clips, err := c.ListAllClips(ctx, videoplatform.ListClipsParams{SessionID: sessionID})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list clips: %v", err)), nil
+		}
+
+		groups := groupAngles(clips, time.Duration(tolerance*float64(time.Second)))
+		report := angleReport{SessionID: sessionID, ToleranceSeconds: tolerance, Clips: len(clips), Groups: groups}
+		for _, g := range groups {
+			if g.SingleAngle {
+				report.SingleAngle++
+			}
+		}
+
+		data, _ := json.MarshalIndent(report, "", "  ")
+		return mcp.NewToolResultText(fmt.Sprintf("%d clips in %d plays, %d with a single angle\n%s",
+			report.Clips, len(groups), report.SingleAngle, data)), nil
+	}
+}
@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestGroupAngles(t *testing.T) {
+	clips := []videoplatform.Clip{
+		{ID: "b-2", ChannelID: "end-zone", StartTime: "2026-10-17T19:01:01Z", DurationSeconds: 8},
+		{ID: "a-1", ChannelID: "sideline", StartTime: "2026-10-17T19:00:00Z", DurationSeconds: 10},
+		{ID: "b-1", ChannelID: "end-zone", StartTime: "2026-10-17T19:00:01Z", DurationSeconds: 10},
+		{ID: "a-2", ChannelID: "sideline", StartTime: "2026-10-17T19:00:11Z", DurationSeconds: 6},
+		{ID: "a-3", ChannelID: "sideline", StartTime: "2026-10-17T19:01:00Z", DurationSeconds: 9},
+		{ID: "odd", ChannelID: "sideline", StartTime: "soon"},
+	}
+	var got [][]string
+	var single []bool
+	for _, g := range groupAngles(clips, 2*time.Second) {
+		var ids []string
+		for _, clip := range g.Clips {
+			ids = append(ids, clip.ClipID)
+		}
+		got = append(got, ids)
+		single = append(single, g.SingleAngle)
+	}
+	// a-2 starts within the tolerance of a-1's end but is the same camera
+	want := [][]string{{"a-1", "b-1"}, {"a-2"}, {"a-3", "b-2"}, {"odd"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("groups = %v, want %v", got, want)
+	}
+	if !reflect.DeepEqual(single, []bool{false, true, false, true}) {
+		t.Errorf("single_angle = %v", single)
+	}
+}
+
+func TestMultiAngleClips(t *testing.T) {
+	srv := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		clips := []videoplatform.Clip{
+			{ID: "a-1", ChannelID: "sideline", StartTime: "2026-10-17T19:00:00Z", DurationSeconds: 10},
+			{ID: "b-1", ChannelID: "end-zone", StartTime: "2026-10-17T19:00:13Z", DurationSeconds: 10},
+		}
+		json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Clip]{Data: clips, Total: len(clips)})
+	})
+	defer srv.Close()
+	handler := makeMultiAngleClips(videoplatform.New(srv.URL))
+
+	call := func(args map[string]interface{}) *mcp.CallToolResult {
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = args
+		result, err := handler(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return result
+	}
+
+	result := call(map[string]interface{}{"session_id": "s-1"})
+	if text := result.Content[0].(mcp.TextContent).Text; !strings.HasPrefix(text, "2 clips in 2 plays, 2 with a single angle") {
+		t.Errorf("default tolerance: %q", text)
+	}
+	result = call(map[string]interface{}{"session_id": "s-1", "tolerance_seconds": float64(5)})
+	if text := result.Content[0].(mcp.TextContent).Text; !strings.HasPrefix(text, "2 clips in 1 plays, 0 with a single angle") {
+		t.Errorf("wider tolerance: %q", text)
+	}
+
+	verifyError(t, call(map[string]interface{}{}), "session_id is required")
+	verifyError(t, call(map[string]interface{}{"session_id": "s-1", "tolerance_seconds": float64(-1)}), "tolerance_seconds must not be negative")
+}
@@ -0,0 +1,104 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+func makeCreatePlaylist(c *videoplatform.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		name, _ := req.Params.Arguments["name"].(string)
+		if name = strings.TrimSpace(name); name == "" {
+			return mcp.NewToolResultError("name is required"), nil
+		}
+		createReq := videoplatform.CreatePlaylistRequest{Name: name}
+		if description, ok := req.Params.Arguments["description"].(string); ok && strings.TrimSpace(description) != "" {
+			createReq.Description = &description
+		}
+
+		playlist, err := c.CreatePlaylist(ctx, createReq)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create playlist: %v", err)), nil
+		}
+
+		data, _ := json.MarshalIndent(playlist, "", "  ")
+		return mcp.NewToolResultText(fmt.Sprintf("Playlist created:\n%s", string(data))), nil
+	}
+}
+
+// makeAddClipsToPlaylist appends clips to a playlist. Clips already in it,
+// or listed twice, are reported as skipped rather than added again.
+func makeAddClipsToPlaylist(c *videoplatform.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		playlistID, _ := req.Params.Arguments["playlist_id"].(string)
+		if playlistID == "" {
+			return mcp.NewToolResultError("playlist_id is required"), nil
+		}
+		clipIDs, err := clipIDsArg(req.Params.Arguments)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if len(clipIDs) == 0 {
+			return mcp.NewToolResultError("clip_ids must list at least one clip"), nil
+		}
+
+		playlist, err := c.GetPlaylist(ctx, playlistID)
+		if err != nil {
+			if apiStatus(err) == http.StatusNotFound {
+				return mcp.NewToolResultError(fmt.Sprintf("Playlist %s not found", playlistID)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get playlist: %v", err)), nil
+		}
+
+		present := make(map[string]bool, len(playlist.ClipIDs))
+		for _, id := range playlist.ClipIDs {
+			present[id] = true
+		}
+		result := struct {
+			PlaylistID string   `json:"playlist_id"`
+			Added      []string `json:"added"`
+			Skipped    []string `json:"skipped"`
+			ClipCount  int      `json:"clip_count"`
+		}{PlaylistID: playlistID, Added: []string{}, Skipped: []string{}}
+		for _, id := range clipIDs {
+			if present[id] {
+				result.Skipped = append(result.Skipped, id)
+				continue
+			}
+			present[id] = true
+			result.Added = append(result.Added, id)
+		}
+
+		result.ClipCount = len(playlist.ClipIDs)
+		if len(result.Added) > 0 {
+			updated, err := c.AddClipsToPlaylist(ctx, playlistID, result.Added)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to add clips to playlist: %v", err)), nil
+			}
+			result.ClipCount = len(updated.ClipIDs)
+		}
+
+		data, _ := json.MarshalIndent(result, "", "  ")
+		return mcp.NewToolResultText(fmt.Sprintf("Added %d clips to playlist %s (%d already in it, skipped):\n%s", len(result.Added), playlistID, len(result.Skipped), data)), nil
+	}
+}
+
+func makeListPlaylists(c *videoplatform.Client, p presenter) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		params := videoplatform.ListPlaylistsParams{}
+		params.Search, _ = req.Params.Arguments["search"].(string)
+
+		resp, err := c.ListPlaylists(ctx, params)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list playlists: %v", err)), nil
+		}
+		return listResult(newListEnvelope(resp.Data, resp), "playlists", "narrow the search to find the rest", p), nil
+	}
+}
@@ -0,0 +1,158 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// playlistPlatform keeps playlists in memory and records the clips added
+// by each request
+type playlistPlatform struct {
+	mu        sync.Mutex
+	playlists map[string]*videoplatform.Playlist
+	adds      [][]string
+}
+
+func (p *playlistPlatform) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	path := strings.TrimPrefix(r.URL.Path, "/api/v1/playlists")
+	id, sub, _ := strings.Cut(strings.TrimPrefix(path, "/"), "/")
+	switch {
+	case path == "" && r.Method == http.MethodPost:
+		var req videoplatform.CreatePlaylistRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		playlist := &videoplatform.Playlist{ID: fmt.Sprintf("pl-%d", len(p.playlists)+1), Name: req.Name, Description: req.Description, ClipIDs: []string{}}
+		p.playlists[playlist.ID] = playlist
+		json.NewEncoder(w).Encode(playlist)
+	case path == "":
+		var data []videoplatform.Playlist
+		for _, playlist := range p.playlists {
+			if strings.Contains(playlist.Name, r.URL.Query().Get("search")) {
+				data = append(data, *playlist)
+			}
+		}
+		json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Playlist]{Data: data, Total: len(data)})
+	case p.playlists[id] == nil:
+		w.WriteHeader(http.StatusNotFound)
+	case sub == "clips" && r.Method == http.MethodPost:
+		var body struct {
+			ClipIDs []string `json:"clip_ids"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		p.adds = append(p.adds, body.ClipIDs)
+		p.playlists[id].ClipIDs = append(p.playlists[id].ClipIDs, body.ClipIDs...)
+		json.NewEncoder(w).Encode(p.playlists[id])
+	case sub == "":
+		json.NewEncoder(w).Encode(p.playlists[id])
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func newPlaylistPlatform(t *testing.T, playlists ...videoplatform.Playlist) (*playlistPlatform, *videoplatform.Client) {
+	t.Helper()
+	p := &playlistPlatform{playlists: make(map[string]*videoplatform.Playlist)}
+	for i := range playlists {
+		p.playlists[playlists[i].ID] = &playlists[i]
+	}
+	server := mockServer(t, p.ServeHTTP)
+	t.Cleanup(server.Close)
+	return p, videoplatform.New(server.URL)
+}
+
+func TestCreatePlaylist(t *testing.T) {
+	p, c := newPlaylistPlatform(t)
+	handler := makeCreatePlaylist(c)
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{"name": "Busted coverages", "description": "Week 3 install"}
+	result, err := handler(context.Background(), req)
+	if err != nil || result.IsError {
+		t.Fatalf("Expected success, got %v %v", result, err)
+	}
+	created := p.playlists["pl-1"]
+	if created == nil || created.Name != "Busted coverages" || created.Description == nil || *created.Description != "Week 3 install" {
+		t.Errorf("created playlist = %+v", created)
+	}
+
+	req.Params.Arguments = map[string]interface{}{"name": "  "}
+	result, _ = handler(context.Background(), req)
+	verifyError(t, result, "name is required")
+}
+
+func TestAddClipsToPlaylist(t *testing.T) {
+	add := func(t *testing.T, c *videoplatform.Client, args map[string]interface{}) *mcp.CallToolResult {
+		t.Helper()
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = args
+		result, err := makeAddClipsToPlaylist(c)(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return result
+	}
+
+	t.Run("skips clips already in the playlist", func(t *testing.T) {
+		p, c := newPlaylistPlatform(t, videoplatform.Playlist{ID: "pl-1", Name: "Reel", ClipIDs: []string{"clip-1"}})
+		result := add(t, c, map[string]interface{}{"playlist_id": "pl-1", "clip_ids": []interface{}{"clip-2", "clip-1", "clip-3", "clip-2"}})
+		if result.IsError {
+			t.Fatalf("Unexpected tool error: %v", result.Content)
+		}
+		if text := result.Content[0].(mcp.TextContent).Text; !strings.HasPrefix(text, "Added 2 clips to playlist pl-1 (2 already in it, skipped)") {
+			t.Errorf("result = %q", text)
+		}
+		if want := [][]string{{"clip-2", "clip-3"}}; !reflect.DeepEqual(p.adds, want) {
+			t.Errorf("adds = %v, want %v", p.adds, want)
+		}
+	})
+
+	t.Run("all already present", func(t *testing.T) {
+		p, c := newPlaylistPlatform(t, videoplatform.Playlist{ID: "pl-1", Name: "Reel", ClipIDs: []string{"clip-1"}})
+		result := add(t, c, map[string]interface{}{"playlist_id": "pl-1", "clip_ids": []interface{}{"clip-1"}})
+		if result.IsError {
+			t.Fatalf("Unexpected tool error: %v", result.Content)
+		}
+		if len(p.adds) != 0 {
+			t.Errorf("adds = %v, want no request", p.adds)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, c := newPlaylistPlatform(t)
+		verifyError(t, add(t, c, map[string]interface{}{"clip_ids": []interface{}{"clip-1"}}), "playlist_id is required")
+		verifyError(t, add(t, c, map[string]interface{}{"playlist_id": "pl-1"}), "at least one clip")
+		verifyError(t, add(t, c, map[string]interface{}{"playlist_id": "pl-1", "clip_ids": "clip-1"}), "must be an array of clip IDs")
+		verifyError(t, add(t, c, map[string]interface{}{"playlist_id": "missing", "clip_ids": []interface{}{"clip-1"}}), "Playlist missing not found")
+	})
+}
+
+func TestListPlaylists(t *testing.T) {
+	_, c := newPlaylistPlatform(t,
+		videoplatform.Playlist{ID: "pl-1", Name: "Busted coverages"},
+		videoplatform.Playlist{ID: "pl-2", Name: "Red zone"},
+	)
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{"search": "Red"}
+	result, err := makeListPlaylists(c, newPresenter(false))(context.Background(), req)
+	if err != nil || result.IsError {
+		t.Fatalf("Expected success, got %v %v", result, err)
+	}
+	var e listEnvelope[videoplatform.Playlist]
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &e); err != nil {
+		t.Fatalf("result is not JSON: %v", err)
+	}
+	if len(e.Data) != 1 || e.Data[0].ID != "pl-2" {
+		t.Errorf("playlists = %+v, want only pl-2", e.Data)
+	}
+}
@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// playTypeClip is a clip with the tags that put it under a play type
+type playTypeClip struct {
+	videoplatform.Clip
+	Tags []timelineTag `json:"play_tags"`
+}
+
+// playTypeReport is the result of clips_by_play_type. UnresolvedTags
+// counts matching tags without a clip or whose clip no longer exists.
+type playTypeReport struct {
+	SessionID      string         `json:"session_id"`
+	PlayType       string         `json:"play_type"`
+	Tags           int            `json:"tags"`
+	UnresolvedTags int            `json:"unresolved_tags"`
+	Clips          []playTypeClip `json:"clips"`
+}
+
+func makeClipsByPlayType(c *videoplatform.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		sessionID, _ := req.Params.Arguments["session_id"].(string)
+		if sessionID == "" {
+			return mcp.NewToolResultError("session_id is required"), nil
+		}
+		playType, _ := req.Params.Arguments["play_type"].(string)
+		if playType = strings.TrimSpace(playType); playType == "" {
+			return mcp.NewToolResultError("play_type is required"), nil
+		}
+
+		// The platform's play_type filter is an exact match, so the session's
+		// tags are matched here to ignore case
+		tags, err := c.ListAllTags(ctx, videoplatform.ListTagsParams{SessionID: sessionID})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list tags: %v", err)), nil
+		}
+		report := playTypeReport{SessionID: sessionID, PlayType: playType, Clips: []playTypeClip{}}
+		byClip := make(map[string][]timelineTag)
+		var clipIDs []string
+		for _, tag := range tags {
+			if tag.PlayType == nil || !strings.EqualFold(strings.TrimSpace(*tag.PlayType), playType) {
+				continue
+			}
+			report.Tags++
+			if tag.ClipID == "" {
+				report.UnresolvedTags++
+				continue
+			}
+			if _, seen := byClip[tag.ClipID]; !seen {
+				clipIDs = append(clipIDs, tag.ClipID)
+			}
+			byClip[tag.ClipID] = append(byClip[tag.ClipID], timelineTag{
+				ID:        tag.ID,
+				GameClock: tagGameClock(tag),
+				Down:      tag.Down,
+				Distance:  tag.Distance,
+				PlayType:  tag.PlayType,
+				Result:    tag.Result,
+			})
+		}
+
+		var clips []videoplatform.Clip
+		for _, r := range c.GetClipsInOrder(ctx, clipIDs) {
+			switch {
+			case r.Err == nil:
+				clips = append(clips, *r.Clip)
+			case apiStatus(r.Err) == http.StatusNotFound:
+				report.UnresolvedTags += len(byClip[r.ID])
+			default:
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to get clip %s: %v", r.ID, r.Err)), nil
+			}
+		}
+		sortByStartTime(clips)
+		for _, clip := range clips {
+			report.Clips = append(report.Clips, playTypeClip{Clip: clip, Tags: byClip[clip.ID]})
+		}
+
+		text := fmt.Sprintf("%d %s clips in session %s", len(report.Clips), playType, sessionID)
+		if report.UnresolvedTags > 0 {
+			text += fmt.Sprintf("; %d of %d matching tags had no resolvable clip", report.UnresolvedTags, report.Tags)
+		}
+		data, _ := json.MarshalIndent(report, "", "  ")
+		return mcp.NewToolResultText(fmt.Sprintf("%s\n%s", text, data)), nil
+	}
+}
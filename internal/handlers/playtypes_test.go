@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestClipsByPlayType(t *testing.T) {
+	screen, upper, run, complete := "screen", "Screen", "run", "complete"
+	down, distance := 3, 7
+	clips := map[string]videoplatform.Clip{
+		"clip-1": {ID: "clip-1", SessionID: "s-1", StartTime: "2026-10-17T19:30:00Z"},
+		"clip-2": {ID: "clip-2", SessionID: "s-1", StartTime: "2026-10-17T19:05:00Z"},
+		"clip-3": {ID: "clip-3", SessionID: "s-1", StartTime: "2026-10-17T19:10:00Z"},
+	}
+	tags := []videoplatform.Tag{
+		{ID: "tag-1", ClipID: "clip-1", PlayType: &screen, Down: &down, Distance: &distance, Result: &complete},
+		{ID: "tag-2", ClipID: "clip-2", PlayType: &upper},
+		{ID: "tag-3", ClipID: "clip-1", PlayType: &screen},
+		{ID: "tag-4", ClipID: "clip-3", PlayType: &run},
+		{ID: "tag-5", ClipID: "gone", PlayType: &screen},
+		{ID: "tag-6", PlayType: &screen},
+	}
+	srv := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/v1/tags":
+			json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Tag]{Data: tags, Total: len(tags)})
+		case strings.HasPrefix(r.URL.Path, "/api/v1/clips/"):
+			clip, ok := clips[strings.TrimPrefix(r.URL.Path, "/api/v1/clips/")]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			json.NewEncoder(w).Encode(clip)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer srv.Close()
+	handler := makeClipsByPlayType(videoplatform.New(srv.URL))
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{"session_id": "s-1", "play_type": "SCREEN"}
+	result, err := handler(context.Background(), req)
+	if err != nil || result.IsError {
+		t.Fatalf("Expected success, got %v %v", result, err)
+	}
+	text := result.Content[0].(mcp.TextContent).Text
+	header, body, _ := strings.Cut(text, "\n")
+	if want := "2 SCREEN clips in session s-1; 2 of 5 matching tags had no resolvable clip"; header != want {
+		t.Errorf("header = %q, want %q", header, want)
+	}
+	var report playTypeReport
+	if err := json.Unmarshal([]byte(body), &report); err != nil {
+		t.Fatalf("result is not JSON: %v", err)
+	}
+	if len(report.Clips) != 2 || report.Clips[0].ID != "clip-2" || report.Clips[1].ID != "clip-1" {
+		t.Fatalf("clips = %+v, want clip-2 then clip-1", report.Clips)
+	}
+	if tags := report.Clips[1].Tags; len(tags) != 2 || tags[0].Down == nil || *tags[0].Down != 3 || *tags[0].Result != "complete" {
+		t.Errorf("clip-1 tags = %+v", tags)
+	}
+
+	req.Params.Arguments = map[string]interface{}{"session_id": "s-1", "play_type": " "}
+	result, _ = handler(context.Background(), req)
+	verifyError(t, result, "play_type is required")
+}
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// prefetchTimeout bounds how long startup prefetching may take
+const prefetchTimeout = 30 * time.Second
+
+// prefetchCalls are the read-only tool calls that warm the cache: the
+// channel list and the first page of sessions, as a client would ask for
+// them with no arguments
+var prefetchCalls = []string{"list_channels", "list_sessions"}
+
+// Prefetch runs the prefetch calls concurrently so their results land in
+// the tool cache, and returns how long that took. The calls bypass metrics
+// and the audit log since no client made them. Failed calls are not
+// cached; the error joins their failures.
+func (r *Registry) Prefetch(ctx context.Context) (time.Duration, error) {
+	if r.cache == nil {
+		return 0, errors.New("tool cache is disabled")
+	}
+	ctx, cancel := context.WithTimeout(ctx, prefetchTimeout)
+	defer cancel()
+
+	start := time.Now()
+	errs := make([]error, len(prefetchCalls))
+	var wg sync.WaitGroup
+	for i, name := range prefetchCalls {
+		handler, ok := r.handlers[name]
+		if !ok || !r.readOnly[name] {
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req := mcp.CallToolRequest{}
+			req.Params.Name = name
+			result, _, err := r.call(ctx, name, true, handler, req)
+			if err == nil && result != nil && result.IsError {
+				err = errors.New(result.Content[0].(mcp.TextContent).Text)
+			}
+			if err != nil {
+				errs[i] = fmt.Errorf("%s: %w", name, err)
+			}
+		}()
+	}
+	wg.Wait()
+	return time.Since(start), errors.Join(errs...)
+}
@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Prodro21/video-mcp/internal/config"
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// callTool drives a tools/call with the given arguments through the server
+func callTool(t *testing.T, s *server.MCPServer, name, args string) *mcp.CallToolResult {
+	t.Helper()
+	msg := json.RawMessage(`{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"` + name + `","arguments":` + args + `}}`)
+	resp, ok := s.HandleMessage(context.Background(), msg).(mcp.JSONRPCResponse)
+	if !ok {
+		t.Fatalf("Expected %s to return a response", name)
+	}
+	result, ok := resp.Result.(*mcp.CallToolResult)
+	if !ok {
+		t.Fatalf("Unexpected %s result %T", name, resp.Result)
+	}
+	return result
+}
+
+func TestPrefetch(t *testing.T) {
+	var requests atomic.Int32
+	platform := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		switch r.URL.Path {
+		case "/api/v1/channels":
+			json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Channel]{
+				Data: []videoplatform.Channel{{ID: "camera-1", Name: "Main Camera", Status: "active"}}, Total: 1,
+			})
+		case "/api/v1/sessions":
+			if limit := r.URL.Query().Get("limit"); limit != "20" {
+				t.Errorf("Expected the first 20 sessions, got limit=%s", limit)
+			}
+			json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Session]{
+				Data: []videoplatform.Session{{ID: "session-1", Name: "Week 1"}}, Total: 1,
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	defer platform.Close()
+
+	s := server.NewMCPServer("video-platform", "test")
+	registry := RegisterTools(s, videoplatform.New(platform.URL), &config.Config{ToolCacheTTL: time.Minute})
+
+	if _, err := registry.Prefetch(context.Background()); err != nil {
+		t.Fatalf("Prefetch() unexpected error: %v", err)
+	}
+	if got := requests.Load(); got != 2 {
+		t.Fatalf("Expected 2 platform requests while prefetching, got %d", got)
+	}
+
+	for _, name := range []string{"list_channels", "list_sessions"} {
+		if result := callTool(t, s, name, `{}`); result.IsError {
+			t.Errorf("%s failed: %v", name, result.Content)
+		}
+	}
+	if got := requests.Load(); got != 2 {
+		t.Errorf("Expected prefetched calls to be served from the cache, platform saw %d requests", got)
+	}
+	if entries := registry.AuditEntries(); len(entries) != 2 || !entries[0].Cached || !entries[1].Cached {
+		t.Errorf("Expected only the two cached client calls in the audit log, got %+v", entries)
+	}
+}
+
+func TestPrefetch_Unreachable(t *testing.T) {
+	var up atomic.Bool
+	platform := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if !up.Load() {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Channel]{
+			Data: []videoplatform.Channel{{ID: "camera-1", Name: "Main Camera", Status: "active"}}, Total: 1,
+		})
+	})
+	defer platform.Close()
+
+	s := server.NewMCPServer("video-platform", "test")
+	registry := RegisterTools(s, videoplatform.New(platform.URL), &config.Config{ToolCacheTTL: time.Minute})
+
+	_, err := registry.Prefetch(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "list_channels") || !strings.Contains(err.Error(), "list_sessions") {
+		t.Fatalf("Prefetch() error = %v, want failures for both calls", err)
+	}
+
+	// Failures aren't cached, so the server serves fresh results once the
+	// platform is back
+	up.Store(true)
+	result := callTool(t, s, "list_channels", `{}`)
+	if result.IsError || !strings.Contains(result.Content[0].(mcp.TextContent).Text, "Main Camera") {
+		t.Errorf("Expected list_channels to reach the platform after a failed prefetch, got %v", result.Content)
+	}
+}
+
+func TestPrefetch_CacheDisabled(t *testing.T) {
+	s := server.NewMCPServer("video-platform", "test")
+	registry := RegisterTools(s, videoplatform.New("http://localhost"), &config.Config{})
+
+	if _, err := registry.Prefetch(context.Background()); err == nil {
+		t.Error("Prefetch() should fail without a tool cache")
+	}
+}
@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// sessionProgress describes how long a session has been recording at now
+// and what it has captured so far. The platform doesn't report when a
+// session was paused, so a paused session shows the time since it started.
+func sessionProgress(s videoplatform.Session, now time.Time) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Session '%s' (%s) is %s", s.Name, s.ID, s.Status)
+
+	switch {
+	case s.ActualStart == nil:
+		b.WriteString(" and hasn't started recording yet.\n")
+	default:
+		start, err := time.Parse(time.RFC3339, *s.ActualStart)
+		if err != nil {
+			fmt.Fprintf(&b, "; its start time %q can't be read.\n", *s.ActualStart)
+			break
+		}
+		if s.ActualEnd != nil {
+			if end, err := time.Parse(time.RFC3339, *s.ActualEnd); err == nil {
+				fmt.Fprintf(&b, ": recorded for %s (%s to %s).\n", formatElapsed(end.Sub(start)), start.Format("15:04"), end.Format("15:04"))
+				break
+			}
+		}
+		if s.Status == videoplatform.SessionPaused {
+			fmt.Fprintf(&b, ": %s since recording started at %s (currently paused).\n", formatElapsed(now.Sub(start)), start.Format("15:04"))
+			break
+		}
+		fmt.Fprintf(&b, ": recording for %s (started %s).\n", formatElapsed(now.Sub(start)), start.Format("15:04"))
+	}
+
+	fmt.Fprintf(&b, "Clips: %d, tags: %d", s.ClipCount, s.TagCount)
+	return b.String()
+}
+
+func makeSessionProgress(c *videoplatform.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		sessionID, _ := req.Params.Arguments["session_id"].(string)
+		if sessionID == "" {
+			return mcp.NewToolResultError("session_id is required"), nil
+		}
+
+		s, err := c.GetSession(ctx, sessionID)
+		if err != nil {
+			if apiStatus(err) == http.StatusNotFound {
+				return mcp.NewToolResultError(fmt.Sprintf("Session %s not found", sessionID)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get session: %v", err)), nil
+		}
+
+		return mcp.NewToolResultText(sessionProgress(*s, time.Now())), nil
+	}
+}
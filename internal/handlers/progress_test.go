@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestSessionProgress(t *testing.T) {
+	now := time.Date(2026, 10, 17, 20, 12, 0, 0, time.UTC)
+	start, end, bad := "2026-10-17T19:00:00Z", "2026-10-17T19:45:00Z", "soon"
+
+	tests := []struct {
+		name    string
+		session videoplatform.Session
+		want    string
+	}{
+		{
+			name:    "active",
+			session: videoplatform.Session{ID: "s-1", Name: "Week 3", Status: videoplatform.SessionActive, ActualStart: &start, ClipCount: 14, TagCount: 32},
+			want:    "Session 'Week 3' (s-1) is active: recording for 1h 12m (started 19:00).\nClips: 14, tags: 32",
+		},
+		{
+			name:    "paused",
+			session: videoplatform.Session{ID: "s-1", Name: "Week 3", Status: videoplatform.SessionPaused, ActualStart: &start},
+			want:    "is paused: 1h 12m since recording started at 19:00 (currently paused).",
+		},
+		{
+			name:    "ended",
+			session: videoplatform.Session{ID: "s-1", Name: "Week 3", Status: videoplatform.SessionCompleted, ActualStart: &start, ActualEnd: &end},
+			want:    "is completed: recorded for 45m (19:00 to 19:45).",
+		},
+		{
+			name:    "not started",
+			session: videoplatform.Session{ID: "s-1", Name: "Week 3", Status: videoplatform.SessionScheduled},
+			want:    "is scheduled and hasn't started recording yet.\nClips: 0, tags: 0",
+		},
+		{
+			name:    "unreadable start",
+			session: videoplatform.Session{ID: "s-1", Name: "Week 3", Status: videoplatform.SessionActive, ActualStart: &bad},
+			want:    `its start time "soon" can't be read`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sessionProgress(tt.session, now); !strings.Contains(got, tt.want) {
+				t.Errorf("sessionProgress() = %q, want it to contain %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSessionProgressTool(t *testing.T) {
+	server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/sessions/s-1" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode(videoplatform.Session{ID: "s-1", Name: "Week 3", Status: videoplatform.SessionScheduled})
+	})
+	defer server.Close()
+	handler := makeSessionProgress(videoplatform.New(server.URL))
+
+	t.Run("progress", func(t *testing.T) {
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"session_id": "s-1"}
+		result, err := handler(context.Background(), req)
+		if err != nil || result.IsError {
+			t.Fatalf("Expected success, got %v %v", result, err)
+		}
+		if text := result.Content[0].(mcp.TextContent).Text; !strings.Contains(text, "hasn't started recording yet") {
+			t.Errorf("result = %q", text)
+		}
+	})
+
+	t.Run("session not found", func(t *testing.T) {
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"session_id": "missing"}
+		result, _ := handler(context.Background(), req)
+		verifyError(t, result, "Session missing not found")
+	})
+
+	t.Run("missing session_id", func(t *testing.T) {
+		result, _ := handler(context.Background(), mcp.CallToolRequest{})
+		verifyError(t, result, "session_id is required")
+	})
+}
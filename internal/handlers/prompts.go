@@ -80,8 +80,8 @@ Focus area: %s
 
 Please follow these steps:
 
-1. First, read the session summary resource:
-   video://sessions/%s/summary
+1. First, get the session summary with the session_summary tool
+   (session_id: %s)
 
 2. Review the session metadata including:
    - Session type (game/practice)
@@ -160,7 +160,8 @@ Please help me review and analyze the clips:
    - Highlights worth keeping
    - Clips that may need re-recording
 
-Let me know if you need me to create tags for any clips or mark specific ones as favorites.`, sessionID, playTypeFilter, sessionID, playTypeFilter)
+Let me know if you need me to create tags for any clips or mark specific ones as favorites.
+Once we're done, mark the tags we went through as reviewed with mark_tags_reviewed.`, sessionID, playTypeFilter, sessionID, playTypeFilter)
 
 	return &mcp.GetPromptResult{
 		Messages: []mcp.PromptMessage{
@@ -183,8 +184,8 @@ func handleGameReport(ctx context.Context, req mcp.GetPromptRequest) (*mcp.GetPr
 
 	prompt := fmt.Sprintf(`Generate a comprehensive game report for session: %s
 
-1. First, fetch the complete session summary:
-   video://sessions/%s/summary
+1. First, fetch the complete session summary with the session_summary
+   tool (session_id: %s)
 
 2. Create a structured game report with these sections:
 
@@ -240,14 +241,16 @@ Format the report professionally with clear headers and bullet points.`, session
 func handleSystemStatus(ctx context.Context, req mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
 	prompt := `Check the current status of the video platform system:
 
-1. Use the list_channels tool to check all video input channels:
-   - Which channels are active?
-   - Are there any channels in error state?
-   - When were channels last seen?
+Start by reading video://status for a one-line-per-item overview, then dig into anything not marked OK.
+
+1. Use the channel_health tool to check all video input channels:
+   - How many channels are active?
+   - Which channels are in error state, and why?
+   - Which active channels have gone stale (not seen recently)?
 
 2. Use the list_sessions tool to check active sessions:
    - Are there any sessions currently recording (status: active)?
-   - Are there scheduled sessions that should have started?
+   - Are there scheduled sessions that should have started? (the upcoming_sessions tool lists them as overdue)
    - Recent completed sessions
 
 3. Provide a status summary:
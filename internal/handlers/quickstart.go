@@ -0,0 +1,140 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Prodro21/video-mcp/internal/index"
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// Step outcomes in a quick start report
+const (
+	stepOK      = "ok"
+	stepFailed  = "failed"
+	stepSkipped = "skipped"
+)
+
+// quickStartStep is one action taken by quick_start_session
+type quickStartStep struct {
+	Step   string
+	Status string
+	Detail string
+}
+
+// quickStartReport collects the steps of a quick start
+type quickStartReport struct {
+	Steps []quickStartStep
+	Next  string
+}
+
+func (r *quickStartReport) add(step, status, detail string) {
+	r.Steps = append(r.Steps, quickStartStep{Step: step, Status: status, Detail: detail})
+}
+
+// Text renders the report one step per line
+func (r *quickStartReport) Text() string {
+	var b strings.Builder
+	for _, s := range r.Steps {
+		fmt.Fprintf(&b, "[%s] %s: %s\n", s.Status, s.Step, s.Detail)
+	}
+	if r.Next != "" {
+		fmt.Fprintf(&b, "\nNext: %s\n", r.Next)
+	}
+	return b.String()
+}
+
+// quickStartChannels activates the requested channels, or every inactive
+// channel when activateAll is set
+func quickStartChannels(ctx context.Context, c *videoplatform.Client, report *quickStartReport, channelIDs []string, activateAll bool) {
+	if activateAll {
+		channels, err := c.ListAllChannels(ctx, videoplatform.ListChannelsParams{})
+		if err != nil {
+			report.add("list channels", stepFailed, err.Error())
+			return
+		}
+		channelIDs = nil
+		for _, ch := range channels {
+			if ch.Status != videoplatform.ChannelActive {
+				channelIDs = append(channelIDs, ch.ID)
+			}
+		}
+		if len(channelIDs) == 0 {
+			report.add("activate channels", stepSkipped, "all channels already active")
+			return
+		}
+	}
+
+	for _, id := range channelIDs {
+		ch, err := c.ActivateChannel(ctx, id)
+		if err != nil {
+			report.add("activate channel "+id, stepFailed, err.Error())
+			continue
+		}
+		report.add("activate channel "+id, stepOK, fmt.Sprintf("%s is %s", ch.Name, ch.Status))
+	}
+}
+
+func makeQuickStartSession(c *videoplatform.Client, sessionTypes []string, idx *index.Index) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		name, _ := req.Params.Arguments["name"].(string)
+		sessionType, err := sessionTypeArg(req.Params.Arguments, sessionTypes)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if name == "" || sessionType == "" {
+			return mcp.NewToolResultError("name and session_type are required"), nil
+		}
+
+		createReq := videoplatform.CreateSessionRequest{Name: name, SessionType: sessionType}
+		opponents, err := opponentsArg(req.Params.Arguments)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		createReq.Opponents = opponents
+		if location, ok := req.Params.Arguments["location"].(string); ok {
+			createReq.Location = &location
+		}
+
+		var channelIDs []string
+		if ids, ok := req.Params.Arguments["channel_ids"].([]interface{}); ok {
+			for _, id := range ids {
+				if s, ok := id.(string); ok && s != "" {
+					channelIDs = append(channelIDs, s)
+				}
+			}
+		}
+		activateAll, _ := req.Params.Arguments["activate_all_channels"].(bool)
+
+		report := &quickStartReport{}
+
+		session, err := c.CreateSession(ctx, createReq)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create session: %v", err)), nil
+		}
+		idx.Upsert(*session)
+		report.add("create session", stepOK, fmt.Sprintf("%s (%s)", session.Name, session.ID))
+
+		if len(channelIDs) > 0 || activateAll {
+			quickStartChannels(ctx, c, report, channelIDs, activateAll)
+		}
+
+		if _, err := c.StartSession(ctx, session.ID); err != nil {
+			report.add("start session", stepFailed, err.Error())
+			report.Next = fmt.Sprintf("Session %s was created but left in scheduled state. Fix the problem above, then call start_session with session_id %q.", session.ID, session.ID)
+			return mcp.NewToolResultError(report.Text()), nil
+		}
+		report.add("start session", stepOK, "recording")
+
+		for _, s := range report.Steps {
+			if s.Status == stepFailed {
+				report.Next = "The session is recording, but some channels failed to activate; retry them with activate_channel."
+				break
+			}
+		}
+		return mcp.NewToolResultText(report.Text()), nil
+	}
+}
@@ -0,0 +1,137 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// quickStartPlatform creates session-1, activates channels except those in
+// failChannels, and fails to start when startFails is set. It records every
+// mutating call.
+func quickStartPlatform(failChannels map[string]bool, startFails bool, mu *sync.Mutex, calls *[]string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			mu.Lock()
+			*calls = append(*calls, r.URL.Path)
+			mu.Unlock()
+		}
+
+		switch {
+		case r.URL.Path == "/api/v1/sessions":
+			json.NewEncoder(w).Encode(videoplatform.Session{ID: "session-1", Name: "Week 3", Status: "scheduled"})
+		case r.URL.Path == "/api/v1/sessions/session-1/start":
+			if startFails {
+				w.WriteHeader(http.StatusConflict)
+				w.Write([]byte(`{"error": "no active channels"}`))
+				return
+			}
+			json.NewEncoder(w).Encode(videoplatform.Session{ID: "session-1", Status: "active"})
+		case r.URL.Path == "/api/v1/channels":
+			data := []videoplatform.Channel{
+				{ID: "ch-1", Name: "Sideline", Status: "inactive"},
+				{ID: "ch-2", Name: "Endzone", Status: "active"},
+				{ID: "ch-3", Name: "Press box", Status: "error"},
+			}
+			json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Channel]{Data: data, Total: 3})
+		case strings.HasSuffix(r.URL.Path, "/activate"):
+			id := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/api/v1/channels/"), "/activate")
+			if failChannels[id] {
+				w.WriteHeader(http.StatusInternalServerError)
+				w.Write([]byte(`{"error": "encoder offline"}`))
+				return
+			}
+			json.NewEncoder(w).Encode(videoplatform.Channel{ID: id, Name: id, Status: "active"})
+		}
+	}
+}
+
+func TestQuickStartSession(t *testing.T) {
+	run := func(t *testing.T, failChannels map[string]bool, startFails bool, args map[string]interface{}) (*mcp.CallToolResult, []string) {
+		var mu sync.Mutex
+		var calls []string
+		server := mockServer(t, quickStartPlatform(failChannels, startFails, &mu, &calls))
+		defer server.Close()
+
+		handler := makeQuickStartSession(videoplatform.New(server.URL), nil, nil)
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = args
+
+		result, err := handler(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return result, calls
+	}
+
+	t.Run("full success", func(t *testing.T) {
+		result, calls := run(t, nil, false, map[string]interface{}{
+			"name":         "Week 3",
+			"session_type": "game",
+			"channel_ids":  []interface{}{"ch-1", "ch-2"},
+		})
+		if result.IsError {
+			t.Fatalf("Expected success, got %s", result.Content[0].(mcp.TextContent).Text)
+		}
+
+		want := []string{
+			"/api/v1/sessions",
+			"/api/v1/channels/ch-1/activate",
+			"/api/v1/channels/ch-2/activate",
+			"/api/v1/sessions/session-1/start",
+		}
+		if strings.Join(calls, ",") != strings.Join(want, ",") {
+			t.Errorf("Expected calls %v, got %v", want, calls)
+		}
+		text := result.Content[0].(mcp.TextContent).Text
+		if strings.Contains(text, "Next:") {
+			t.Errorf("Expected no follow-up on full success, got %s", text)
+		}
+	})
+
+	t.Run("activate all with partial failure", func(t *testing.T) {
+		result, calls := run(t, map[string]bool{"ch-3": true}, false, map[string]interface{}{
+			"name":                  "Week 3",
+			"session_type":          "game",
+			"activate_all_channels": true,
+		})
+		if result.IsError {
+			t.Fatal("Expected the session to start despite a channel failure")
+		}
+
+		text := result.Content[0].(mcp.TextContent).Text
+		if !strings.Contains(text, "[ok] activate channel ch-1") || !strings.Contains(text, "[failed] activate channel ch-3") {
+			t.Errorf("Expected per-channel outcomes, got %s", text)
+		}
+		for _, call := range calls {
+			if call == "/api/v1/channels/ch-2/activate" {
+				t.Error("Already active channel should not be activated again")
+			}
+		}
+		if !strings.Contains(text, "activate_channel") {
+			t.Errorf("Expected retry advice, got %s", text)
+		}
+	})
+
+	t.Run("failed start leaves session scheduled", func(t *testing.T) {
+		result, _ := run(t, nil, true, map[string]interface{}{
+			"name":         "Week 3",
+			"session_type": "game",
+		})
+		verifyError(t, result, "[failed] start session")
+		verifyError(t, result, "no active channels")
+		verifyError(t, result, `left in scheduled state`)
+		verifyError(t, result, `call start_session with session_id "session-1"`)
+	})
+
+	t.Run("missing name", func(t *testing.T) {
+		result, _ := run(t, nil, false, map[string]interface{}{"session_type": "game"})
+		verifyError(t, result, "name and session_type are required")
+	})
+}
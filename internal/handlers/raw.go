@@ -0,0 +1,104 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// rawMethods are the HTTP methods platform_request accepts
+var rawMethods = []string{"GET", "POST", "PUT", "PATCH", "DELETE"}
+
+// validateRawPath accepts only paths under /api/ on the configured platform.
+// The checks run on the percent-decoded path so an encoded form such as
+// /api/%2e%2e/admin can't slip past them.
+func validateRawPath(p string) error {
+	decoded, err := url.PathUnescape(p)
+	if err != nil {
+		return fmt.Errorf("path has invalid percent-encoding: %v", err)
+	}
+	switch {
+	case !strings.HasPrefix(p, "/api/"):
+		return fmt.Errorf("path must begin with /api/")
+	case strings.Contains(decoded, "://"):
+		return fmt.Errorf("path must be relative, not an absolute URL")
+	case strings.ContainsAny(decoded, "?#\\"):
+		return fmt.Errorf("path must not contain ?, # or backslashes; pass query parameters in query")
+	case strings.Contains(decoded, ".."):
+		return fmt.Errorf("path must not contain ..")
+	case !strings.HasPrefix(path.Clean(decoded), "/api/"):
+		return fmt.Errorf("path must stay under /api/")
+	}
+	return nil
+}
+
+// rawQuery converts a query argument object into URL values
+func rawQuery(args map[string]interface{}) url.Values {
+	query := url.Values{}
+	for key, v := range args {
+		switch v := v.(type) {
+		case []interface{}:
+			for _, item := range v {
+				query.Add(key, fmt.Sprint(item))
+			}
+		default:
+			query.Set(key, fmt.Sprint(v))
+		}
+	}
+	return query
+}
+
+func makePlatformRequest(c *videoplatform.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		method, _ := req.Params.Arguments["method"].(string)
+		path, _ := req.Params.Arguments["path"].(string)
+		if method == "" || path == "" {
+			return mcp.NewToolResultError("method and path are required"), nil
+		}
+
+		method = strings.ToUpper(method)
+		allowed := false
+		for _, m := range rawMethods {
+			allowed = allowed || m == method
+		}
+		if !allowed {
+			return mcp.NewToolResultError(fmt.Sprintf("method must be one of %s", strings.Join(rawMethods, ", "))), nil
+		}
+		if err := validateRawPath(path); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if confirm, _ := req.Params.Arguments["confirm"].(bool); method != "GET" && !confirm {
+			return mcp.NewToolResultError(fmt.Sprintf("%s %s may change platform data; pass confirm: true to send it", method, path)), nil
+		}
+
+		var query url.Values
+		if q, ok := req.Params.Arguments["query"].(map[string]interface{}); ok {
+			query = rawQuery(q)
+		}
+
+		resp, err := c.Do(ctx, method, path, query, req.Params.Arguments["body"])
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to call platform: %v", err)), nil
+		}
+
+		result := struct {
+			Status int         `json:"status"`
+			Body   interface{} `json:"body,omitempty"`
+		}{Status: resp.StatusCode}
+		if json.Valid(resp.Body) {
+			result.Body = json.RawMessage(resp.Body)
+		} else if len(resp.Body) > 0 {
+			result.Body = string(resp.Body)
+		}
+
+		data, _ := json.MarshalIndent(result, "", "  ")
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
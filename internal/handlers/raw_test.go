@@ -0,0 +1,132 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Prodro21/video-mcp/internal/config"
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+func TestPlatformRequest_PathRestriction(t *testing.T) {
+	handler := makePlatformRequest(videoplatform.New("http://localhost"))
+
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"/health", "must begin with /api/"},
+		{"http://evil.example/api/v1", "must begin with /api/"},
+		{"/api//evil.example://x", "absolute URL"},
+		{"/api/v1/sessions?limit=1", "must not contain ?"},
+		{"/api/../admin", "must not contain .."},
+		{"/api/%2e%2e/admin", "must not contain .."},
+		{"/api/v1%2F%2E%2E%2F%2E%2E%2Fadmin", "must not contain .."},
+		{"/api/v1/sessions%3Flimit=1", "must not contain ?"},
+		{"/api/v1/%zz", "invalid percent-encoding"},
+		{"/api/", "must stay under /api/"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			req := mcp.CallToolRequest{}
+			req.Params.Arguments = map[string]interface{}{"method": "GET", "path": tt.path}
+			result, _ := handler(context.Background(), req)
+			verifyError(t, result, tt.want)
+		})
+	}
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{"method": "TRACE", "path": "/api/v1/sessions"}
+	result, _ := handler(context.Background(), req)
+	verifyError(t, result, "method must be one of")
+}
+
+func TestPlatformRequest_RoundTrip(t *testing.T) {
+	var gotQuery, gotBody string
+	server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.RawQuery
+		data, _ := io.ReadAll(r.Body)
+		gotBody = string(data)
+
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/v1/sessions/s-1/notes":
+			w.Write([]byte(`[{"text":"good drive"}]`))
+		case r.Method == "POST" && r.URL.Path == "/api/v1/sessions/s-1/notes":
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id":"n-2"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte("not found"))
+		}
+	})
+	handler := makePlatformRequest(videoplatform.New(server.URL))
+
+	call := func(args map[string]interface{}) map[string]interface{} {
+		t.Helper()
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = args
+		result, err := handler(context.Background(), req)
+		if err != nil || result.IsError {
+			t.Fatalf("handler(%v) = %v, %v", args, result.Content, err)
+		}
+		var out map[string]interface{}
+		if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &out); err != nil {
+			t.Fatalf("Failed to parse result: %v", err)
+		}
+		return out
+	}
+
+	out := call(map[string]interface{}{
+		"method": "get",
+		"path":   "/api/v1/sessions/s-1/notes",
+		"query":  map[string]interface{}{"limit": float64(5)},
+	})
+	if out["status"] != float64(200) || gotQuery != "limit=5" {
+		t.Errorf("GET = %v (query %q)", out, gotQuery)
+	}
+	if notes, _ := out["body"].([]interface{}); len(notes) != 1 {
+		t.Errorf("GET body = %v, want parsed JSON", out["body"])
+	}
+
+	// Mutating methods are refused without confirm
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{"method": "POST", "path": "/api/v1/sessions/s-1/notes"}
+	result, _ := handler(context.Background(), req)
+	verifyError(t, result, "confirm: true")
+
+	out = call(map[string]interface{}{
+		"method":  "POST",
+		"path":    "/api/v1/sessions/s-1/notes",
+		"body":    map[string]interface{}{"text": "fumble"},
+		"confirm": true,
+	})
+	if out["status"] != float64(201) || !strings.Contains(gotBody, `"text":"fumble"`) {
+		t.Errorf("POST = %v (body %q)", out, gotBody)
+	}
+
+	// Error statuses are reported, with non-JSON bodies kept as text
+	out = call(map[string]interface{}{"method": "GET", "path": "/api/v1/unknown"})
+	if out["status"] != float64(404) || out["body"] != "not found" {
+		t.Errorf("GET unknown = %v", out)
+	}
+}
+
+func TestPlatformRequest_FlagGate(t *testing.T) {
+	c := videoplatform.New("http://localhost")
+
+	r := RegisterTools(server.NewMCPServer("test", "1.0"), c, &config.Config{})
+	if containsString(r.Tools(), "platform_request") {
+		t.Error("platform_request registered without -enable-raw-requests")
+	}
+
+	r = RegisterTools(server.NewMCPServer("test", "1.0"), c, &config.Config{EnableRawRequests: true})
+	if !containsString(r.Tools(), "platform_request") {
+		t.Error("platform_request not registered with -enable-raw-requests")
+	}
+}
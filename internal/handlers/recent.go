@@ -0,0 +1,102 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Prodro21/video-mcp/internal/index"
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// recentWindows are the window values of recent_sessions
+var recentWindows = []string{"today", "yesterday", "last_7_days", "last_30_days"}
+
+// recentWindow resolves a named window relative to now in now's location.
+// today and yesterday are calendar days; the last_N_days windows end now.
+func recentWindow(name string, now time.Time) (digestWindow, error) {
+	y, m, d := now.Date()
+	midnight := time.Date(y, m, d, 0, 0, 0, 0, now.Location())
+	var w digestWindow
+	switch name {
+	case "today":
+		w = digestWindow{From: midnight, To: time.Date(y, m, d+1, 0, 0, 0, 0, now.Location())}
+	case "yesterday":
+		w = digestWindow{From: time.Date(y, m, d-1, 0, 0, 0, 0, now.Location()), To: midnight}
+	case "last_7_days":
+		w = digestWindow{From: now.AddDate(0, 0, -7), To: now}
+	case "last_30_days":
+		w = digestWindow{From: now.AddDate(0, 0, -30), To: now}
+	default:
+		return digestWindow{}, fmt.Errorf("invalid window %q: must be one of %s", name, strings.Join(recentWindows, ", "))
+	}
+	w.Date = w.From.Format(digestDateLayout)
+	return w, nil
+}
+
+// recentSessions lists the sessions whose date (actual start, else
+// scheduled start, else creation) falls in the window, newest first. The
+// window is also sent as a date range, but since not every platform
+// applies it the results are filtered here too.
+func recentSessions(ctx context.Context, c *videoplatform.Client, w digestWindow, sessionType videoplatform.SessionType) ([]videoplatform.Session, error) {
+	sessions, err := c.ListAllSessions(ctx, videoplatform.ListSessionsParams{
+		SessionType: sessionType,
+		DateFrom:    w.From.UTC().Format(time.RFC3339),
+		DateTo:      w.To.UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	dates := make(map[string]time.Time, len(sessions))
+	recent := []videoplatform.Session{}
+	for _, s := range sessions {
+		date := index.EntryFromSession(s).Date
+		if !w.contains(date) {
+			continue
+		}
+		dates[s.ID], _ = time.Parse(time.RFC3339, date)
+		recent = append(recent, s)
+	}
+	sort.SliceStable(recent, func(i, j int) bool {
+		return dates[recent[i].ID].After(dates[recent[j].ID])
+	})
+	return recent, nil
+}
+
+func makeRecentSessions(c *videoplatform.Client, sessionTypes []string) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		name, _ := req.Params.Arguments["window"].(string)
+		if name == "" {
+			return mcp.NewToolResultError(fmt.Sprintf("window is required: one of %s", strings.Join(recentWindows, ", "))), nil
+		}
+		w, err := recentWindow(name, time.Now())
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		sessionType, err := sessionTypeArg(req.Params.Arguments, sessionTypes)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		sessions, err := recentSessions(ctx, c, w, sessionType)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list sessions: %v", err)), nil
+		}
+
+		result := struct {
+			Window   string                  `json:"window"`
+			From     string                  `json:"from"`
+			To       string                  `json:"to"`
+			Count    int                     `json:"count"`
+			Sessions []videoplatform.Session `json:"sessions"`
+		}{name, w.From.Format(time.RFC3339), w.To.Format(time.RFC3339), len(sessions), sessions}
+		data, _ := json.MarshalIndent(result, "", "  ")
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
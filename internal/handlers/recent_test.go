@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestRecentWindow(t *testing.T) {
+	loc := time.FixedZone("CDT", -5*60*60)
+	now := time.Date(2026, 10, 17, 0, 30, 0, 0, loc)
+
+	tests := []struct {
+		name     string
+		from, to time.Time
+	}{
+		{"today", time.Date(2026, 10, 17, 0, 0, 0, 0, loc), time.Date(2026, 10, 18, 0, 0, 0, 0, loc)},
+		{"yesterday", time.Date(2026, 10, 16, 0, 0, 0, 0, loc), time.Date(2026, 10, 17, 0, 0, 0, 0, loc)},
+		{"last_7_days", time.Date(2026, 10, 10, 0, 30, 0, 0, loc), now},
+		{"last_30_days", time.Date(2026, 9, 17, 0, 30, 0, 0, loc), now},
+	}
+	for _, tt := range tests {
+		w, err := recentWindow(tt.name, now)
+		if err != nil {
+			t.Fatalf("recentWindow(%s): %v", tt.name, err)
+		}
+		if !w.From.Equal(tt.from) || !w.To.Equal(tt.to) {
+			t.Errorf("recentWindow(%s) = [%v, %v), want [%v, %v)", tt.name, w.From, w.To, tt.from, tt.to)
+		}
+	}
+
+	if _, err := recentWindow("last_week", now); err == nil || err.Error() != `invalid window "last_week": must be one of today, yesterday, last_7_days, last_30_days` {
+		t.Errorf("unknown window error = %v", err)
+	}
+}
+
+func TestRecentSessions(t *testing.T) {
+	now := time.Now()
+	ts := func(d time.Duration) *string {
+		s := now.Add(-d).Format(time.RFC3339)
+		return &s
+	}
+	var query map[string][]string
+	server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		// The platform ignores the date range
+		sessions := []videoplatform.Session{
+			{ID: "two-days", ActualStart: ts(48 * time.Hour)},
+			{ID: "last-month", ActualStart: ts(40 * 24 * time.Hour)},
+			{ID: "scheduled-recently", ScheduledStart: ts(time.Hour)},
+			{ID: "created-three-days", CreatedAt: *ts(72 * time.Hour)},
+		}
+		json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Session]{Data: sessions, Total: len(sessions)})
+	})
+	defer server.Close()
+	handler := makeRecentSessions(videoplatform.New(server.URL), nil)
+
+	t.Run("filters and sorts newest first", func(t *testing.T) {
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"window": "last_7_days", "session_type": "game"}
+		result, err := handler(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result.IsError {
+			t.Fatalf("Unexpected tool error: %v", result.Content)
+		}
+
+		var got struct {
+			Window   string                  `json:"window"`
+			Count    int                     `json:"count"`
+			Sessions []videoplatform.Session `json:"sessions"`
+		}
+		if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &got); err != nil {
+			t.Fatalf("result is not JSON: %v", err)
+		}
+		var ids []string
+		for _, s := range got.Sessions {
+			ids = append(ids, s.ID)
+		}
+		if got.Window != "last_7_days" || got.Count != 3 || len(ids) != 3 || ids[0] != "scheduled-recently" || ids[1] != "two-days" || ids[2] != "created-three-days" {
+			t.Errorf("window %q, count %d, sessions %v", got.Window, got.Count, ids)
+		}
+
+		if query["session_type"][0] != "game" {
+			t.Errorf("session_type = %v, want game", query["session_type"])
+		}
+		from, err := time.Parse(time.RFC3339, query["date_from"][0])
+		if err != nil || now.Sub(from) < 7*24*time.Hour-time.Minute || now.Sub(from) > 7*24*time.Hour+time.Minute {
+			t.Errorf("date_from = %v, want about 7 days ago", query["date_from"])
+		}
+		if len(query["date_to"]) != 1 {
+			t.Errorf("date_to = %v, want the window end", query["date_to"])
+		}
+	})
+
+	t.Run("unknown window", func(t *testing.T) {
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"window": "this_season"}
+		result, _ := handler(context.Background(), req)
+		verifyError(t, result, "must be one of today, yesterday, last_7_days, last_30_days")
+	})
+
+	t.Run("missing window", func(t *testing.T) {
+		result, _ := handler(context.Background(), mcp.CallToolRequest{})
+		verifyError(t, result, "window is required")
+	})
+}
@@ -0,0 +1,388 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Prodro21/video-mcp/internal/transport"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// auditLogSize bounds how many tool calls the audit log retains
+const auditLogSize = 100
+
+// toolWaitBudget is how long a call waits for a free slot once the
+// concurrency cap is reached before it fails as busy
+const toolWaitBudget = 2 * time.Second
+
+// maxPollingCalls caps how many polling tool calls run at once under a
+// concurrency limit; they take these slots instead of the shared ones
+const maxPollingCalls = 2
+
+// Registry wraps tool registration so every call is counted and audited,
+// and remembers which tools were registered for diagnostics
+type Registry struct {
+	s        *server.MCPServer
+	tools    []string
+	readOnly map[string]bool
+	// mutatingFlags names, per read-only tool, a boolean argument that
+	// makes a call mutating, e.g. get_clip's record_view
+	mutatingFlags map[string]string
+	// polling marks tools that sleep between status checks; they take a
+	// slot of their own pool so a long wait can't starve other calls
+	polling map[string]bool
+	// uncached marks tools that don't change platform state but whose
+	// results are stale at once, e.g. live readings; they bypass the cache
+	uncached map[string]bool
+	handlers map[string]server.ToolHandlerFunc
+	metrics  *Metrics
+	audit    *auditLog
+	cache    *toolCache
+	// digests keeps what generate_daily_digest produced for the
+	// video://digests resource
+	digests *digestStore
+	logger  *log.Logger
+	started time.Time
+	slots   chan struct{}
+	// pollSlots is the smaller pool polling tools run in
+	pollSlots chan struct{}
+	wait      time.Duration
+
+	transport atomic.Pointer[transport.SSE]
+}
+
+func newRegistry(s *server.MCPServer) *Registry {
+	return &Registry{
+		s:             s,
+		readOnly:      make(map[string]bool),
+		mutatingFlags: make(map[string]string),
+		polling:       make(map[string]bool),
+		uncached:      make(map[string]bool),
+		handlers:      make(map[string]server.ToolHandlerFunc),
+		metrics:       newMetrics(),
+		audit:         newAuditLog(auditLogSize),
+		started:       time.Now(),
+	}
+}
+
+// setConcurrencyLimit caps how many tool calls execute at once, with
+// polling tools capped separately at up to maxPollingCalls; zero leaves
+// calls uncapped
+func (r *Registry) setConcurrencyLimit(n int) {
+	if n > 0 {
+		r.slots = make(chan struct{}, n)
+		r.pollSlots = make(chan struct{}, min(n, maxPollingCalls))
+		r.wait = toolWaitBudget
+	}
+}
+
+// addTool registers a tool with the server behind the shared wrapper. Calls
+// are treated as mutating: they are never cached and clear the tool cache.
+func (r *Registry) addTool(tool mcp.Tool, handler server.ToolHandlerFunc) {
+	r.tools = append(r.tools, tool.Name)
+	r.handlers[tool.Name] = handler
+	r.s.AddTool(tool, r.wrap(tool.Name, handler))
+}
+
+// addReadOnlyTool registers a tool that doesn't change platform state, so
+// its results may be served from the tool cache
+func (r *Registry) addReadOnlyTool(tool mcp.Tool, handler server.ToolHandlerFunc) {
+	r.readOnly[tool.Name] = true
+	r.addTool(tool, handler)
+}
+
+// addReadOnlyToolUnless registers a read-only tool whose calls are treated
+// as mutating when the boolean argument flag is true
+func (r *Registry) addReadOnlyToolUnless(flag string, tool mcp.Tool, handler server.ToolHandlerFunc) {
+	r.mutatingFlags[tool.Name] = flag
+	r.addReadOnlyTool(tool, handler)
+}
+
+// addUncachedTool registers a tool that doesn't change platform state but
+// must not be served from the cache, e.g. because it reports live readings
+// or advances its own watermark. Its calls neither hit nor clear the cache.
+func (r *Registry) addUncachedTool(tool mcp.Tool, handler server.ToolHandlerFunc) {
+	r.uncached[tool.Name] = true
+	r.addTool(tool, handler)
+}
+
+// addPollingTool registers a mutating tool that spends most of a call
+// sleeping between status checks, so it runs in the polling pool rather
+// than holding one of the shared concurrency slots
+func (r *Registry) addPollingTool(tool mcp.Tool, handler server.ToolHandlerFunc) {
+	r.polling[tool.Name] = true
+	r.addTool(tool, handler)
+}
+
+// addUncachedPollingTool registers a polling tool that only reads, e.g. a
+// wait for a status; like an uncached tool its calls neither hit nor clear
+// the cache
+func (r *Registry) addUncachedPollingTool(tool mcp.Tool, handler server.ToolHandlerFunc) {
+	r.uncached[tool.Name] = true
+	r.addPollingTool(tool, handler)
+}
+
+// Tools returns the names of all registered tools, sorted
+func (r *Registry) Tools() []string {
+	names := append([]string(nil), r.tools...)
+	sort.Strings(names)
+	return names
+}
+
+// Metrics returns the registry's call counters
+func (r *Registry) Metrics() *Metrics {
+	return r.metrics
+}
+
+// AuditEntries returns the retained tool calls, oldest first
+func (r *Registry) AuditEntries() []AuditEntry {
+	return r.audit.entries()
+}
+
+func (r *Registry) wrap(name string, handler server.ToolHandlerFunc) server.ToolHandlerFunc {
+	readOnly := r.readOnly[name]
+	flag := r.mutatingFlags[name]
+
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		callReadOnly := readOnly
+		if on, _ := req.Params.Arguments[flag].(bool); flag != "" && on {
+			callReadOnly = false
+		}
+
+		start := time.Now()
+		result, cached, err := r.limited(ctx, name, callReadOnly, handler, req)
+		elapsed := time.Since(start)
+
+		failed := err != nil || (result != nil && result.IsError)
+		r.metrics.record(name, elapsed, failed)
+		r.audit.add(AuditEntry{
+			Time:       start.UTC().Format(time.RFC3339),
+			Tool:       name,
+			Arguments:  argumentKeys(req.Params.Arguments),
+			DurationMS: elapsed.Milliseconds(),
+			IsError:    failed,
+			Cached:     cached,
+		})
+		return result, err
+	}
+}
+
+// limited runs the call within the concurrency cap, failing with a busy
+// error when no slot frees up within the wait budget. Polling tools are
+// capped by their own pool.
+func (r *Registry) limited(ctx context.Context, name string, readOnly bool, handler server.ToolHandlerFunc, req mcp.CallToolRequest) (*mcp.CallToolResult, bool, error) {
+	slots, kind := r.slots, "tool"
+	if r.polling[name] {
+		slots, kind = r.pollSlots, "polling tool"
+	}
+	if !r.acquire(ctx, slots) {
+		return mcp.NewToolResultError(fmt.Sprintf("Server busy: %d %s calls already running, retry shortly", cap(slots), kind)), false, nil
+	}
+	defer r.release(slots)
+
+	r.metrics.begin()
+	defer r.metrics.end()
+	return r.call(ctx, name, readOnly, handler, req)
+}
+
+// acquire takes an execution slot from slots, waiting up to the wait
+// budget
+func (r *Registry) acquire(ctx context.Context, slots chan struct{}) bool {
+	if slots == nil {
+		return true
+	}
+	select {
+	case slots <- struct{}{}:
+		return true
+	default:
+	}
+
+	timer := time.NewTimer(r.wait)
+	defer timer.Stop()
+	select {
+	case slots <- struct{}{}:
+		return true
+	case <-timer.C:
+		return false
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func (r *Registry) release(slots chan struct{}) {
+	if slots != nil {
+		<-slots
+	}
+}
+
+// call runs the handler, serving read-only tools from the cache when it is
+// enabled and clearing the cache after any other tool except uncached ones
+func (r *Registry) call(ctx context.Context, name string, readOnly bool, handler server.ToolHandlerFunc, req mcp.CallToolRequest) (*mcp.CallToolResult, bool, error) {
+	if r.cache == nil || r.uncached[name] {
+		result, err := handler(ctx, req)
+		return result, false, err
+	}
+	if !readOnly {
+		defer r.cache.invalidate()
+		result, err := handler(ctx, req)
+		return result, false, err
+	}
+
+	key, ok := toolCacheKey(name, req.Params.Arguments)
+	if ok {
+		if result, hit := r.cache.get(key); hit {
+			if r.logger != nil {
+				r.logger.Printf("tool %s (cache hit)", name)
+			}
+			return result, true, nil
+		}
+	}
+
+	gen := r.cache.generation()
+	result, err := handler(ctx, req)
+	if ok && err == nil && result != nil && !result.IsError {
+		r.cache.put(key, gen, result)
+	}
+	return result, false, err
+}
+
+// ToolMetrics holds call counters for a single tool
+type ToolMetrics struct {
+	Calls        int64 `json:"calls"`
+	Errors       int64 `json:"errors"`
+	TotalLatency int64 `json:"total_latency_ms"`
+}
+
+// ConcurrencyMetrics reports how many tool calls are executing at once
+type ConcurrencyMetrics struct {
+	Current int64 `json:"current"`
+	Peak    int64 `json:"peak"`
+}
+
+// Metrics counts tool calls, errors and latency per tool, and tracks how
+// many calls run concurrently
+type Metrics struct {
+	mu      sync.Mutex
+	tools   map[string]*ToolMetrics
+	current int64
+	peak    int64
+}
+
+func newMetrics() *Metrics {
+	return &Metrics{tools: make(map[string]*ToolMetrics)}
+}
+
+func (m *Metrics) record(tool string, elapsed time.Duration, failed bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	tm, ok := m.tools[tool]
+	if !ok {
+		tm = &ToolMetrics{}
+		m.tools[tool] = tm
+	}
+	tm.Calls++
+	tm.TotalLatency += elapsed.Milliseconds()
+	if failed {
+		tm.Errors++
+	}
+}
+
+func (m *Metrics) begin() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.current++
+	m.peak = max(m.peak, m.current)
+}
+
+func (m *Metrics) end() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.current--
+}
+
+// Concurrency returns the current and peak number of executing calls
+func (m *Metrics) Concurrency() ConcurrencyMetrics {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return ConcurrencyMetrics{Current: m.current, Peak: m.peak}
+}
+
+// Snapshot returns a copy of the per-tool counters
+func (m *Metrics) Snapshot() map[string]ToolMetrics {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	out := make(map[string]ToolMetrics, len(m.tools))
+	for name, tm := range m.tools {
+		out[name] = *tm
+	}
+	return out
+}
+
+// AuditEntry records a single tool call. Only the names of the arguments
+// are kept, since values can carry request bodies and stream credentials.
+type AuditEntry struct {
+	Time       string   `json:"time"`
+	Tool       string   `json:"tool"`
+	Arguments  []string `json:"arguments,omitempty"`
+	DurationMS int64    `json:"duration_ms"`
+	IsError    bool     `json:"is_error"`
+	Cached     bool     `json:"cached,omitempty"`
+}
+
+// argumentKeys returns the sorted argument names of a call
+func argumentKeys(args map[string]interface{}) []string {
+	if len(args) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(args))
+	for k := range args {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// auditLog is a fixed-size ring buffer of recent tool calls
+type auditLog struct {
+	mu   sync.Mutex
+	buf  []AuditEntry
+	pos  int
+	size int
+}
+
+func newAuditLog(size int) *auditLog {
+	return &auditLog{buf: make([]AuditEntry, 0, size), size: size}
+}
+
+func (a *auditLog) add(e AuditEntry) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if len(a.buf) < a.size {
+		a.buf = append(a.buf, e)
+		return
+	}
+	a.buf[a.pos] = e
+	a.pos = (a.pos + 1) % a.size
+}
+
+func (a *auditLog) entries() []AuditEntry {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	out := make([]AuditEntry, 0, len(a.buf))
+	out = append(out, a.buf[a.pos:]...)
+	out = append(out, a.buf[:a.pos]...)
+	return out
+}
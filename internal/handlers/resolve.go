@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/Prodro21/video-mcp/internal/index"
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// sessionEntries returns every known session, from the local index when it
+// is enabled and by scanning the platform otherwise
+func sessionEntries(ctx context.Context, c *videoplatform.Client, idx *index.Index) ([]index.SessionEntry, error) {
+	if idx != nil {
+		return idx.Sessions(ctx)
+	}
+
+	sessions, err := c.ListAllSessions(ctx, videoplatform.ListSessionsParams{})
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]index.SessionEntry, 0, len(sessions))
+	for _, s := range sessions {
+		entries = append(entries, index.EntryFromSession(s))
+	}
+	return entries, nil
+}
+
+// findSessions returns sessions whose name or any opponent contains query,
+// ignoring case
+func findSessions(ctx context.Context, c *videoplatform.Client, idx *index.Index, query string) ([]index.SessionEntry, error) {
+	entries, err := sessionEntries(ctx, c, idx)
+	if err != nil {
+		return nil, err
+	}
+
+	query = strings.ToLower(query)
+	matches := []index.SessionEntry{}
+	for _, entry := range entries {
+		match := strings.Contains(strings.ToLower(entry.Name), query)
+		for _, opponent := range entry.OpponentNames() {
+			match = match || strings.Contains(strings.ToLower(opponent), query)
+		}
+		if match {
+			matches = append(matches, entry)
+		}
+	}
+	return matches, nil
+}
+
+func makeFindSession(c *videoplatform.Client, idx *index.Index) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		query, _ := req.Params.Arguments["query"].(string)
+		if strings.TrimSpace(query) == "" {
+			return mcp.NewToolResultError("query is required"), nil
+		}
+
+		matches, err := findSessions(ctx, c, idx, strings.TrimSpace(query))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to find sessions: %v", err)), nil
+		}
+
+		data, _ := json.MarshalIndent(matches, "", "  ")
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
+
+func makeGetActiveSession(c *videoplatform.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		resp, err := c.ListSessions(ctx, videoplatform.ListSessionsParams{Status: videoplatform.SessionActive})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list sessions: %v", err)), nil
+		}
+
+		switch {
+		case len(resp.Data) == 0:
+			return mcp.NewToolResultText("No active session. Start one with start_session or quick_start_session."), nil
+		case len(resp.Data) == 1 && resp.Total <= 1:
+			data, _ := json.MarshalIndent(resp.Data[0], "", "  ")
+			return mcp.NewToolResultText(string(data)), nil
+		}
+
+		candidates := make([]index.SessionEntry, 0, len(resp.Data))
+		for _, s := range resp.Data {
+			candidates = append(candidates, index.EntryFromSession(s))
+		}
+		count := max(resp.Total, len(resp.Data))
+		data, _ := json.MarshalIndent(candidates, "", "  ")
+		return mcp.NewToolResultText(fmt.Sprintf("%d sessions are active; ask which one is meant and use its id:\n%s", count, data)), nil
+	}
+}
@@ -4,21 +4,29 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
 
-	"github.com/Prodro21/video-mcp/internal/client"
+	"github.com/Prodro21/video-mcp/internal/config"
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 )
 
-// RegisterResources adds all resource handlers to the server
-func RegisterResources(s *server.MCPServer, c *client.Client) {
+// RegisterResources adds all resource handlers to the server. Digests are
+// served from the registry RegisterTools returned.
+func RegisterResources(s *server.MCPServer, c *videoplatform.Client, cfg *config.Config, r *Registry) {
+	limit := min(cfg.ResourcePageLimit(), maxResourceLimit)
+
 	// Sessions list
 	s.AddResource(mcp.Resource{
 		URI:         "video://sessions",
 		Name:        "All Sessions",
 		Description: "List of all recording sessions",
 		MIMEType:    "application/json",
-	}, makeSessionsResource(c))
+	}, makeSessionsResource(c, limit))
 
 	// Clips list
 	s.AddResource(mcp.Resource{
@@ -26,7 +34,7 @@ func RegisterResources(s *server.MCPServer, c *client.Client) {
 		Name:        "All Clips",
 		Description: "List of all video clips",
 		MIMEType:    "application/json",
-	}, makeClipsResource(c))
+	}, makeClipsResource(c, limit))
 
 	// Channels list
 	s.AddResource(mcp.Resource{
@@ -42,17 +50,51 @@ func RegisterResources(s *server.MCPServer, c *client.Client) {
 		Name:        "All Tags",
 		Description: "List of all clip annotations/tags",
 		MIMEType:    "application/json",
-	}, makeTagsResource(c))
+	}, makeTagsResource(c, limit))
+
+	// Paged variants, e.g. video://sessions?offset=200&limit=100
+	for _, t := range []struct {
+		base, name string
+		handler    server.ResourceTemplateHandlerFunc
+	}{
+		{"video://sessions", "Sessions Page", server.ResourceTemplateHandlerFunc(makeSessionsResource(c, limit))},
+		{"video://clips", "Clips Page", server.ResourceTemplateHandlerFunc(makeClipsResource(c, limit))},
+		{"video://tags", "Tags Page", server.ResourceTemplateHandlerFunc(makeTagsResource(c, limit))},
+	} {
+		s.AddResourceTemplate(mcp.ResourceTemplate{
+			URITemplate: t.base + "{?offset,limit}",
+			Name:        t.name,
+			Description: fmt.Sprintf("One page of %s; follow next/prev in the response to walk the list (limit defaults to %d, up to %d)", t.base, limit, maxResourceLimit),
+			MIMEType:    "application/json",
+		}, t.handler)
+	}
+
+	// Digests generated by generate_daily_digest
+	s.AddResourceTemplate(mcp.ResourceTemplate{
+		URITemplate: digestURIPrefix + "{date}",
+		Name:        "Daily Digest",
+		Description: "A markdown digest generated by generate_daily_digest this run, by date (YYYY-MM-DD)",
+		MIMEType:    "text/markdown",
+	}, makeDigestResource(r.digests))
+
+	// At-a-glance status
+	s.AddResource(mcp.Resource{
+		URI:         "video://status",
+		Name:        "Platform Status",
+		Description: "One status line per channel and live session, prefixed with a health glyph",
+		MIMEType:    "text/plain",
+	}, makeStatusResource(c, newPresenter(cfg.NoEmoji)))
 }
 
-func makeSessionsResource(c *client.Client) server.ResourceHandlerFunc {
+func makeSessionsResource(c *videoplatform.Client, limit int) server.ResourceHandlerFunc {
 	return func(ctx context.Context, req mcp.ReadResourceRequest) ([]interface{}, error) {
-		resp, err := c.ListSessions(ctx, client.ListSessionsParams{Limit: 100})
+		base, page := parsePageURI(req.Params.URI, limit)
+		resp, err := c.ListSessions(ctx, videoplatform.ListSessionsParams{Limit: page.Limit, Offset: page.Offset})
 		if err != nil {
 			return nil, fmt.Errorf("failed to fetch sessions: %w", err)
 		}
 
-		data, _ := json.MarshalIndent(resp, "", "  ")
+		data, _ := json.MarshalIndent(newPagedResource(base, page, resp), "", "  ")
 		return []interface{}{
 			mcp.TextResourceContents{
 				ResourceContents: mcp.ResourceContents{
@@ -65,15 +107,15 @@ func makeSessionsResource(c *client.Client) server.ResourceHandlerFunc {
 	}
 }
 
-
-func makeClipsResource(c *client.Client) server.ResourceHandlerFunc {
+func makeClipsResource(c *videoplatform.Client, limit int) server.ResourceHandlerFunc {
 	return func(ctx context.Context, req mcp.ReadResourceRequest) ([]interface{}, error) {
-		resp, err := c.ListClips(ctx, client.ListClipsParams{Limit: 100})
+		base, page := parsePageURI(req.Params.URI, limit)
+		resp, err := c.ListClips(ctx, videoplatform.ListClipsParams{Limit: page.Limit, Offset: page.Offset})
 		if err != nil {
 			return nil, fmt.Errorf("failed to fetch clips: %w", err)
 		}
 
-		data, _ := json.MarshalIndent(resp, "", "  ")
+		data, _ := json.MarshalIndent(newPagedResource(base, page, resp), "", "  ")
 		return []interface{}{
 			mcp.TextResourceContents{
 				ResourceContents: mcp.ResourceContents{
@@ -86,8 +128,7 @@ func makeClipsResource(c *client.Client) server.ResourceHandlerFunc {
 	}
 }
 
-
-func makeChannelsResource(c *client.Client) server.ResourceHandlerFunc {
+func makeChannelsResource(c *videoplatform.Client) server.ResourceHandlerFunc {
 	return func(ctx context.Context, req mcp.ReadResourceRequest) ([]interface{}, error) {
 		resp, err := c.ListChannels(ctx)
 		if err != nil {
@@ -107,14 +148,15 @@ func makeChannelsResource(c *client.Client) server.ResourceHandlerFunc {
 	}
 }
 
-func makeTagsResource(c *client.Client) server.ResourceHandlerFunc {
+func makeTagsResource(c *videoplatform.Client, limit int) server.ResourceHandlerFunc {
 	return func(ctx context.Context, req mcp.ReadResourceRequest) ([]interface{}, error) {
-		resp, err := c.ListTags(ctx, client.ListTagsParams{Limit: 100})
+		base, page := parsePageURI(req.Params.URI, limit)
+		resp, err := c.ListTags(ctx, videoplatform.ListTagsParams{Limit: page.Limit, Offset: page.Offset})
 		if err != nil {
 			return nil, fmt.Errorf("failed to fetch tags: %w", err)
 		}
 
-		data, _ := json.MarshalIndent(resp, "", "  ")
+		data, _ := json.MarshalIndent(newPagedResource(base, page, resp), "", "  ")
 		return []interface{}{
 			mcp.TextResourceContents{
 				ResourceContents: mcp.ResourceContents{
@@ -127,3 +169,109 @@ func makeTagsResource(c *client.Client) server.ResourceHandlerFunc {
 	}
 }
 
+// maxResourceLimit caps the page size of paged resources
+const maxResourceLimit = 500
+
+// pageQuery is the paging requested in a resource URI's query string
+type pageQuery struct {
+	Offset  int
+	Limit   int
+	Notices []string
+}
+
+// parsePageURI splits a resource URI like video://sessions?offset=200&limit=100
+// into its base and paging, using defaultLimit when no limit is given.
+// Out-of-range values are clamped and unknown or malformed parameters are
+// ignored, with a notice for each.
+func parsePageURI(uri string, defaultLimit int) (string, pageQuery) {
+	page := pageQuery{Limit: defaultLimit}
+
+	base, rawQuery, found := strings.Cut(uri, "?")
+	if !found {
+		return base, page
+	}
+
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		page.Notices = append(page.Notices, fmt.Sprintf("ignored malformed query %q", rawQuery))
+		return base, page
+	}
+
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		value := query.Get(key)
+		switch key {
+		case "offset":
+			n, err := strconv.Atoi(value)
+			switch {
+			case err != nil:
+				page.Notices = append(page.Notices, fmt.Sprintf("ignored invalid offset %q", value))
+			case n < 0:
+				page.Notices = append(page.Notices, "offset clamped to 0")
+			default:
+				page.Offset = n
+			}
+		case "limit":
+			n, err := strconv.Atoi(value)
+			switch {
+			case err != nil:
+				page.Notices = append(page.Notices, fmt.Sprintf("ignored invalid limit %q", value))
+			case n < 1:
+				page.Limit = 1
+				page.Notices = append(page.Notices, "limit clamped to 1")
+			case n > maxResourceLimit:
+				page.Limit = maxResourceLimit
+				page.Notices = append(page.Notices, fmt.Sprintf("limit clamped to %d", maxResourceLimit))
+			default:
+				page.Limit = n
+			}
+		default:
+			page.Notices = append(page.Notices, fmt.Sprintf("ignored unknown query parameter %q", key))
+		}
+	}
+	return base, page
+}
+
+// pageURI builds the resource URI for one page
+func pageURI(base string, offset, limit int) string {
+	return fmt.Sprintf("%s?offset=%d&limit=%d", base, offset, limit)
+}
+
+// pagedResource is a page of a list resource with links to its neighbours
+type pagedResource[T any] struct {
+	Data   []T    `json:"data"`
+	Total  int    `json:"total"`
+	Limit  int    `json:"limit"`
+	Offset int    `json:"offset"`
+	Next   string `json:"next,omitempty"`
+	Prev   string `json:"prev,omitempty"`
+	Notice string `json:"notice,omitempty"`
+}
+
+func newPagedResource[T any](base string, page pageQuery, resp *videoplatform.PaginatedResponse[T]) pagedResource[T] {
+	out := pagedResource[T]{
+		Data:   resp.Data,
+		Total:  resp.Total,
+		Limit:  page.Limit,
+		Offset: page.Offset,
+		Notice: strings.Join(page.Notices, "; "),
+	}
+
+	if page.Offset+page.Limit < resp.Total {
+		out.Next = pageURI(base, page.Offset+page.Limit, page.Limit)
+	}
+	if page.Offset > 0 {
+		prev := page.Offset - page.Limit
+		// Past the end, step back to the last real page
+		if page.Offset >= resp.Total && resp.Total > 0 {
+			prev = (resp.Total - 1) / page.Limit * page.Limit
+		}
+		out.Prev = pageURI(base, max(prev, 0), page.Limit)
+	}
+	return out
+}
@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Prodro21/video-mcp/internal/config"
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestParsePageURI(t *testing.T) {
+	tests := []struct {
+		uri        string
+		wantBase   string
+		wantOffset int
+		wantLimit  int
+		wantNotice string
+	}{
+		{"video://sessions", "video://sessions", 0, 100, ""},
+		{"video://sessions?offset=200&limit=50", "video://sessions", 200, 50, ""},
+		{"video://clips?limit=10000", "video://clips", 0, maxResourceLimit, "limit clamped to 500"},
+		{"video://clips?limit=0", "video://clips", 0, 1, "limit clamped to 1"},
+		{"video://tags?offset=-5", "video://tags", 0, 100, "offset clamped to 0"},
+		{"video://tags?offset=abc", "video://tags", 0, 100, `ignored invalid offset "abc"`},
+		{"video://tags?sort=name&offset=10", "video://tags", 10, 100, `ignored unknown query parameter "sort"`},
+	}
+
+	for _, tt := range tests {
+		base, page := parsePageURI(tt.uri, config.DefaultResourceLimit)
+		if base != tt.wantBase || page.Offset != tt.wantOffset || page.Limit != tt.wantLimit {
+			t.Errorf("parsePageURI(%q) = %s offset=%d limit=%d, want %s offset=%d limit=%d",
+				tt.uri, base, page.Offset, page.Limit, tt.wantBase, tt.wantOffset, tt.wantLimit)
+		}
+		notice := strings.Join(page.Notices, "; ")
+		if notice != tt.wantNotice {
+			t.Errorf("parsePageURI(%q) notice = %q, want %q", tt.uri, notice, tt.wantNotice)
+		}
+	}
+}
+
+func TestPagedResourceLinks(t *testing.T) {
+	tests := []struct {
+		name     string
+		offset   int
+		limit    int
+		total    int
+		wantNext string
+		wantPrev string
+	}{
+		{"first page", 0, 100, 250, "video://sessions?offset=100&limit=100", ""},
+		{"middle page", 100, 100, 250, "video://sessions?offset=200&limit=100", "video://sessions?offset=0&limit=100"},
+		{"last partial page", 200, 100, 250, "", "video://sessions?offset=100&limit=100"},
+		{"exact last page", 100, 100, 200, "", "video://sessions?offset=0&limit=100"},
+		{"unaligned offset", 50, 100, 250, "video://sessions?offset=150&limit=100", "video://sessions?offset=0&limit=100"},
+		{"past the end", 900, 100, 250, "", "video://sessions?offset=200&limit=100"},
+		{"single page", 0, 100, 5, "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page := pageQuery{Offset: tt.offset, Limit: tt.limit}
+			resp := &videoplatform.PaginatedResponse[videoplatform.Session]{Total: tt.total}
+
+			out := newPagedResource("video://sessions", page, resp)
+			if out.Next != tt.wantNext {
+				t.Errorf("Next = %q, want %q", out.Next, tt.wantNext)
+			}
+			if out.Prev != tt.wantPrev {
+				t.Errorf("Prev = %q, want %q", out.Prev, tt.wantPrev)
+			}
+		})
+	}
+}
+
+func TestPagedResourceRead(t *testing.T) {
+	server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("offset") != "2" || r.URL.Query().Get("limit") != "2" {
+			t.Errorf("Expected offset=2 limit=2, got %s", r.URL.RawQuery)
+		}
+		resp := videoplatform.PaginatedResponse[videoplatform.Clip]{
+			Data:  []videoplatform.Clip{{ID: "clip-3"}, {ID: "clip-4"}},
+			Total: 5,
+		}
+		json.NewEncoder(w).Encode(resp)
+	})
+	defer server.Close()
+
+	s := newTestMCPServer(videoplatform.New(server.URL))
+	msg := json.RawMessage(`{"jsonrpc":"2.0","id":1,"method":"resources/read","params":{"uri":"video://clips?offset=2&limit=2"}}`)
+	resp, ok := s.HandleMessage(context.Background(), msg).(mcp.JSONRPCResponse)
+	if !ok {
+		t.Fatalf("Expected JSON-RPC response, got %T", resp)
+	}
+
+	contents := resp.Result.(mcp.ReadResourceResult).Contents
+	text := contents[0].(mcp.TextResourceContents).Text
+
+	var page pagedResource[videoplatform.Clip]
+	if err := json.Unmarshal([]byte(text), &page); err != nil {
+		t.Fatalf("Failed to parse page: %v", err)
+	}
+	if page.Next != "video://clips?offset=4&limit=2" || page.Prev != "video://clips?offset=0&limit=2" {
+		t.Errorf("Unexpected links next=%q prev=%q", page.Next, page.Prev)
+	}
+}
@@ -0,0 +1,275 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// retentionDefault is the argument value that clears an override
+const retentionDefault = "default"
+
+// parseRetention converts a retention like "30d", "12w", "1y" or "forever"
+// into days. A bare number is read as days.
+func parseRetention(s string) (int, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if s == "forever" {
+		return videoplatform.RetentionForever, nil
+	}
+	if s == "" {
+		return 0, fmt.Errorf("retention is empty")
+	}
+
+	unit := s[len(s)-1]
+	number := s
+	if unit < '0' || unit > '9' {
+		number = strings.TrimSpace(s[:len(s)-1])
+	} else {
+		unit = 'd'
+	}
+
+	n, err := strconv.Atoi(number)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid retention %q: use e.g. 30d, 12w, 1y or forever", s)
+	}
+
+	var days int
+	switch unit {
+	case 'h':
+		if n%24 != 0 && n > 24 {
+			return 0, fmt.Errorf("invalid retention %q: must be a whole number of days", s)
+		}
+		days = n / 24
+	case 'd':
+		days = n
+	case 'w':
+		days = n * 7
+	case 'y':
+		days = n * 365
+	default:
+		return 0, fmt.Errorf("invalid retention %q: use e.g. 30d, 12w, 1y or forever", s)
+	}
+
+	if days < 1 {
+		return 0, fmt.Errorf("invalid retention %q: must be at least 1 day", s)
+	}
+	return days, nil
+}
+
+// formatRetention renders days the way parseRetention accepts them
+func formatRetention(days int) string {
+	if days == videoplatform.RetentionForever {
+		return "forever"
+	}
+	return fmt.Sprintf("%dd", days)
+}
+
+// effectiveRetention returns the retention that applies to a session and
+// where it came from: a session override wins over the session type, which
+// wins over the default
+func effectiveRetention(policy videoplatform.RetentionPolicy, s videoplatform.Session) (int, string) {
+	if s.RetentionDays != nil {
+		return *s.RetentionDays, "session"
+	}
+	if days, ok := policy.SessionTypes[string(s.SessionType)]; ok {
+		return days, "session_type"
+	}
+	return policy.DefaultDays, "default"
+}
+
+// retentionViolation is a session whose media is older than its retention
+type retentionViolation struct {
+	SessionID     string                    `json:"session_id"`
+	Name          string                    `json:"name"`
+	SessionType   videoplatform.SessionType `json:"session_type"`
+	AgeDays       int                       `json:"age_days"`
+	Retention     string                    `json:"retention"`
+	Source        string                    `json:"retention_source"`
+	MediaSeconds  int                       `json:"media_seconds"`
+	OverdueByDays int                       `json:"overdue_by_days"`
+}
+
+// sessionAge returns how long ago a session ended, falling back to its
+// actual start, scheduled start and creation times
+func sessionAge(s videoplatform.Session, now time.Time) (time.Duration, bool) {
+	for _, ts := range []*string{s.ActualEnd, s.ActualStart, s.ScheduledStart, &s.CreatedAt} {
+		if ts == nil || *ts == "" {
+			continue
+		}
+		if t, err := time.Parse(time.RFC3339, *ts); err == nil {
+			return now.Sub(t), true
+		}
+	}
+	return 0, false
+}
+
+// retentionViolations lists sessions whose media exceeds their retention,
+// most overdue first
+func retentionViolations(policy videoplatform.RetentionPolicy, sessions []videoplatform.Session, now time.Time) []retentionViolation {
+	violations := []retentionViolation{}
+	for _, s := range sessions {
+		days, source := effectiveRetention(policy, s)
+		if days == videoplatform.RetentionForever || s.TotalDurationSeconds == 0 {
+			continue
+		}
+		age, ok := sessionAge(s, now)
+		if !ok {
+			continue
+		}
+		ageDays := int(age.Hours() / 24)
+		if ageDays <= days {
+			continue
+		}
+		violations = append(violations, retentionViolation{
+			SessionID:     s.ID,
+			Name:          s.Name,
+			SessionType:   s.SessionType,
+			AgeDays:       ageDays,
+			Retention:     formatRetention(days),
+			Source:        source,
+			MediaSeconds:  s.TotalDurationSeconds,
+			OverdueByDays: ageDays - days,
+		})
+	}
+	sort.SliceStable(violations, func(i, j int) bool {
+		return violations[i].OverdueByDays > violations[j].OverdueByDays
+	})
+	return violations
+}
+
+// retentionPolicyView is the human-readable form of a policy
+type retentionPolicyView struct {
+	Default      string            `json:"default"`
+	SessionTypes map[string]string `json:"session_types,omitempty"`
+}
+
+func viewRetentionPolicy(policy videoplatform.RetentionPolicy) retentionPolicyView {
+	view := retentionPolicyView{Default: formatRetention(policy.DefaultDays)}
+	if len(policy.SessionTypes) > 0 {
+		view.SessionTypes = make(map[string]string, len(policy.SessionTypes))
+		for sessionType, days := range policy.SessionTypes {
+			view.SessionTypes[sessionType] = formatRetention(days)
+		}
+	}
+	return view
+}
+
+func makeGetRetentionPolicy(c *videoplatform.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		policy, err := c.GetRetentionPolicy(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get retention policy: %v", err)), nil
+		}
+
+		data, _ := json.MarshalIndent(viewRetentionPolicy(*policy), "", "  ")
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
+
+func makeSetRetentionPolicy(c *videoplatform.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		defaultArg, hasDefault := req.Params.Arguments["default"].(string)
+		typesArg, hasTypes := req.Params.Arguments["session_types"].(map[string]interface{})
+		if !hasDefault && !hasTypes {
+			return mcp.NewToolResultError("default or session_types is required"), nil
+		}
+
+		policy, err := c.GetRetentionPolicy(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get retention policy: %v", err)), nil
+		}
+
+		if hasDefault {
+			days, err := parseRetention(defaultArg)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			policy.DefaultDays = days
+		}
+		if hasTypes {
+			if policy.SessionTypes == nil {
+				policy.SessionTypes = make(map[string]int)
+			}
+			for sessionType, v := range typesArg {
+				value, _ := v.(string)
+				if strings.EqualFold(value, retentionDefault) {
+					delete(policy.SessionTypes, sessionType)
+					continue
+				}
+				days, err := parseRetention(value)
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("%s: %v", sessionType, err)), nil
+				}
+				policy.SessionTypes[sessionType] = days
+			}
+		}
+
+		updated, err := c.SetRetentionPolicy(ctx, *policy)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to set retention policy: %v", err)), nil
+		}
+
+		data, _ := json.MarshalIndent(viewRetentionPolicy(*updated), "", "  ")
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
+
+func makeSetSessionRetention(c *videoplatform.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		sessionID, _ := req.Params.Arguments["session_id"].(string)
+		retention, _ := req.Params.Arguments["retention"].(string)
+		if sessionID == "" || retention == "" {
+			return mcp.NewToolResultError("session_id and retention are required"), nil
+		}
+
+		var retentionReq videoplatform.SessionRetentionRequest
+		if !strings.EqualFold(retention, retentionDefault) {
+			days, err := parseRetention(retention)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			retentionReq.Days = &days
+		}
+
+		if _, err := c.SetSessionRetention(ctx, sessionID, retentionReq); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to set session retention: %v", err)), nil
+		}
+
+		if retentionReq.Days == nil {
+			return mcp.NewToolResultText(fmt.Sprintf("Session %s now follows the retention policy", sessionID)), nil
+		}
+		return mcp.NewToolResultText(fmt.Sprintf("Session %s retention set to %s", sessionID, formatRetention(*retentionReq.Days))), nil
+	}
+}
+
+func makeRetentionReport(c *videoplatform.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		policy, err := c.GetRetentionPolicy(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get retention policy: %v", err)), nil
+		}
+		sessions, err := c.ListAllSessions(ctx, videoplatform.ListSessionsParams{})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list sessions: %v", err)), nil
+		}
+
+		report := struct {
+			Policy     retentionPolicyView  `json:"policy"`
+			OverPolicy []retentionViolation `json:"over_policy"`
+		}{
+			Policy:     viewRetentionPolicy(*policy),
+			OverPolicy: retentionViolations(*policy, sessions, time.Now()),
+		}
+
+		data, _ := json.MarshalIndent(report, "", "  ")
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
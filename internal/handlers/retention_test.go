@@ -0,0 +1,131 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func intPtr(i int) *int { return &i }
+
+func TestParseRetention(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    int
+		wantErr bool
+	}{
+		{"30d", 30, false},
+		{"30", 30, false},
+		{"2w", 14, false},
+		{"1y", 365, false},
+		{"48h", 2, false},
+		{"Forever", videoplatform.RetentionForever, false},
+		{" 7D ", 7, false},
+		{"0d", 0, true},
+		{"12h", 0, true},
+		{"36h", 0, true},
+		{"-3d", 0, true},
+		{"3m", 0, true},
+		{"soon", 0, true},
+		{"", 0, true},
+	}
+
+	for _, tt := range tests {
+		got, err := parseRetention(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseRetention(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if !tt.wantErr && got != tt.want {
+			t.Errorf("parseRetention(%q) = %d, want %d", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestRetentionViolations(t *testing.T) {
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	daysAgo := func(d int) *string {
+		ts := now.Add(-time.Duration(d) * 24 * time.Hour).Format(time.RFC3339)
+		return &ts
+	}
+
+	policy := videoplatform.RetentionPolicy{
+		DefaultDays:  90,
+		SessionTypes: map[string]int{"practice": 30, "game": videoplatform.RetentionForever},
+	}
+	sessions := []videoplatform.Session{
+		// Practice past its type retention
+		{ID: "practice-old", SessionType: "practice", ActualEnd: daysAgo(45), TotalDurationSeconds: 600},
+		// Practice within retention
+		{ID: "practice-new", SessionType: "practice", ActualEnd: daysAgo(10), TotalDurationSeconds: 600},
+		// Games are kept forever
+		{ID: "game-old", SessionType: "game", ActualEnd: daysAgo(400), TotalDurationSeconds: 600},
+		// Session override beats the type: kept forever
+		{ID: "practice-pinned", SessionType: "practice", ActualEnd: daysAgo(45), TotalDurationSeconds: 600, RetentionDays: intPtr(videoplatform.RetentionForever)},
+		// Session override beats the type: shorter than the game rule
+		{ID: "game-trimmed", SessionType: "game", ActualEnd: daysAgo(20), TotalDurationSeconds: 600, RetentionDays: intPtr(7)},
+		// Untyped session falls back to the default
+		{ID: "other-old", SessionType: "other", ActualEnd: daysAgo(100), TotalDurationSeconds: 600},
+		// No media, nothing to delete
+		{ID: "empty-old", SessionType: "practice", ActualEnd: daysAgo(100)},
+	}
+
+	violations := retentionViolations(policy, sessions, now)
+
+	got := map[string]retentionViolation{}
+	for _, v := range violations {
+		got[v.SessionID] = v
+	}
+	if len(got) != 3 {
+		t.Fatalf("retentionViolations() = %+v, want practice-old, game-trimmed, other-old", violations)
+	}
+	if got["practice-old"].Source != "session_type" {
+		t.Errorf("practice-old source = %s, want session_type", got["practice-old"].Source)
+	}
+	if got["game-trimmed"].Source != "session" || got["game-trimmed"].Retention != "7d" {
+		t.Errorf("game-trimmed = %+v, want session override of 7d", got["game-trimmed"])
+	}
+	if got["other-old"].Source != "default" {
+		t.Errorf("other-old source = %s, want default", got["other-old"].Source)
+	}
+	if violations[0].SessionID != "practice-old" {
+		t.Errorf("retentionViolations() should sort most overdue first, got %s", violations[0].SessionID)
+	}
+}
+
+func TestSetSessionRetention(t *testing.T) {
+	t.Run("sends override", func(t *testing.T) {
+		var body map[string]interface{}
+		server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+			json.NewDecoder(r.Body).Decode(&body)
+			json.NewEncoder(w).Encode(videoplatform.Session{ID: "session-1"})
+		})
+		defer server.Close()
+
+		handler := makeSetSessionRetention(videoplatform.New(server.URL))
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"session_id": "session-1", "retention": "2w"}
+
+		result, err := handler(context.Background(), req)
+		if err != nil || result.IsError {
+			t.Fatalf("Expected success, got %v %+v", err, result)
+		}
+		if body["days"] != float64(14) {
+			t.Errorf("Expected days 14, got %v", body["days"])
+		}
+	})
+
+	t.Run("rejects less than a day", func(t *testing.T) {
+		handler := makeSetSessionRetention(videoplatform.New("http://localhost:0"))
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"session_id": "session-1", "retention": "6h"}
+
+		result, _ := handler(context.Background(), req)
+		verifyError(t, result, `invalid retention "6h": must be at least 1 day`)
+	})
+}
@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// parseScheduledStart converts a scheduled start into RFC 3339. Besides
+// RFC 3339 it accepts a duration from now ("+2h", "+1h30m") and a time of
+// day today or tomorrow ("tomorrow 18:00"), in now's time zone.
+func parseScheduledStart(s string, now time.Time) (string, error) {
+	s = strings.TrimSpace(s)
+	invalid := fmt.Errorf("invalid scheduled_start %q: use RFC 3339 (e.g. 2026-10-17T19:00:00Z), a duration from now like \"+2h\", or \"today 18:00\" / \"tomorrow 18:00\"", s)
+
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t.Format(time.RFC3339), nil
+	}
+
+	if rest, ok := strings.CutPrefix(s, "+"); ok {
+		d, err := time.ParseDuration(rest)
+		if err != nil || d <= 0 {
+			return "", invalid
+		}
+		return now.Add(d).Truncate(time.Second).Format(time.RFC3339), nil
+	}
+
+	day, clock, ok := strings.Cut(strings.ToLower(s), " ")
+	if !ok {
+		return "", invalid
+	}
+	var offset int
+	switch day {
+	case "today":
+	case "tomorrow":
+		offset = 1
+	default:
+		return "", invalid
+	}
+	tod, err := time.Parse("15:04", strings.TrimSpace(clock))
+	if err != nil {
+		return "", invalid
+	}
+	y, m, d := now.Date()
+	t := time.Date(y, m, d+offset, tod.Hour(), tod.Minute(), 0, 0, now.Location())
+	return t.Format(time.RFC3339), nil
+}
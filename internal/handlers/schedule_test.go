@@ -0,0 +1,44 @@
+package handlers
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseScheduledStart(t *testing.T) {
+	now := time.Date(2026, 10, 17, 14, 25, 30, 0, time.FixedZone("CDT", -5*60*60))
+
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: "2026-10-23T19:00:00Z", want: "2026-10-23T19:00:00Z"},
+		{in: "2026-10-23T19:00:00-05:00", want: "2026-10-23T19:00:00-05:00"},
+		{in: "+2h", want: "2026-10-17T16:25:30-05:00"},
+		{in: "+1h30m", want: "2026-10-17T15:55:30-05:00"},
+		{in: "today 18:00", want: "2026-10-17T18:00:00-05:00"},
+		{in: "Tomorrow 09:30", want: "2026-10-18T09:30:00-05:00"},
+		{in: "2026-10-23 19:00", wantErr: true},
+		{in: "Friday 19:00", wantErr: true},
+		{in: "tomorrow 25:00", wantErr: true},
+		{in: "+-2h", wantErr: true},
+		{in: "+soon", wantErr: true},
+		{in: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := parseScheduledStart(tt.in, now)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("parseScheduledStart(%q) = %q, want error", tt.in, got)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("parseScheduledStart(%q) = %q, %v; want %q", tt.in, got, err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// Self-test check outcomes
+const (
+	CheckPass = "pass"
+	CheckFail = "fail"
+	CheckSkip = "skip"
+)
+
+// SelfTestCheck is the outcome of a single self-test step
+type SelfTestCheck struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Detail string `json:"detail,omitempty"`
+}
+
+// SelfTestReport collects the results of a self-test run
+type SelfTestReport struct {
+	Checks []SelfTestCheck `json:"checks"`
+	Passed bool            `json:"passed"`
+}
+
+// RunSelfTest runs read-only checks against the platform and the registered
+// MCP resources. Skipped checks do not count as failures.
+func RunSelfTest(ctx context.Context, s *server.MCPServer, c *videoplatform.Client) SelfTestReport {
+	report := SelfTestReport{
+		Checks: []SelfTestCheck{
+			checkHealth(ctx, c),
+			checkListSessions(ctx, c),
+			checkListChannels(ctx, c),
+			checkFetchClip(ctx, c),
+			checkResources(ctx, s),
+		},
+		Passed: true,
+	}
+
+	for _, check := range report.Checks {
+		if check.Status == CheckFail {
+			report.Passed = false
+		}
+	}
+	return report
+}
+
+// Table renders the report as a fixed-width pass/fail table
+func (r SelfTestReport) Table() string {
+	var buf bytes.Buffer
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "CHECK\tRESULT\tDETAIL")
+	for _, check := range r.Checks {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", check.Name, check.Status, check.Detail)
+	}
+	w.Flush()
+
+	if r.Passed {
+		buf.WriteString("\nSelf-test passed\n")
+	} else {
+		buf.WriteString("\nSelf-test FAILED\n")
+	}
+	return buf.String()
+}
+
+func checkHealth(ctx context.Context, c *videoplatform.Client) SelfTestCheck {
+	if err := c.Health(ctx); err != nil {
+		return SelfTestCheck{Name: "health", Status: CheckFail, Detail: err.Error()}
+	}
+	return SelfTestCheck{Name: "health", Status: CheckPass, Detail: "platform is healthy"}
+}
+
+func checkListSessions(ctx context.Context, c *videoplatform.Client) SelfTestCheck {
+	resp, err := c.ListSessions(ctx, videoplatform.ListSessionsParams{Limit: 1})
+	if err != nil {
+		return SelfTestCheck{Name: "list_sessions", Status: CheckFail, Detail: err.Error()}
+	}
+	return SelfTestCheck{Name: "list_sessions", Status: CheckPass, Detail: fmt.Sprintf("%d sessions available", resp.Total)}
+}
+
+func checkListChannels(ctx context.Context, c *videoplatform.Client) SelfTestCheck {
+	resp, err := c.ListChannels(ctx)
+	if err != nil {
+		return SelfTestCheck{Name: "list_channels", Status: CheckFail, Detail: err.Error()}
+	}
+
+	active := 0
+	for _, channel := range resp.Data {
+		if channel.Status == videoplatform.ChannelActive {
+			active++
+		}
+	}
+	return SelfTestCheck{Name: "list_channels", Status: CheckPass, Detail: fmt.Sprintf("%d channels (%d active)", len(resp.Data), active)}
+}
+
+func checkFetchClip(ctx context.Context, c *videoplatform.Client) SelfTestCheck {
+	resp, err := c.ListClips(ctx, videoplatform.ListClipsParams{Limit: 1})
+	if err != nil {
+		return SelfTestCheck{Name: "get_clip", Status: CheckFail, Detail: err.Error()}
+	}
+	if len(resp.Data) == 0 {
+		return SelfTestCheck{Name: "get_clip", Status: CheckSkip, Detail: "no clips to fetch"}
+	}
+
+	clip, err := c.GetClip(ctx, resp.Data[0].ID)
+	if err != nil {
+		return SelfTestCheck{Name: "get_clip", Status: CheckFail, Detail: err.Error()}
+	}
+	return SelfTestCheck{Name: "get_clip", Status: CheckPass, Detail: fmt.Sprintf("fetched clip %s", clip.ID)}
+}
+
+// checkResources reads a registered resource through the MCP server's own
+// router, which exercises URI resolution as well as the handler.
+func checkResources(ctx context.Context, s *server.MCPServer) SelfTestCheck {
+	if s == nil {
+		return SelfTestCheck{Name: "resources", Status: CheckSkip, Detail: "no MCP server available"}
+	}
+
+	// A plain resource and a paged template
+	uris := []string{"video://sessions", "video://sessions?offset=0&limit=1"}
+	for _, uri := range uris {
+		msg := json.RawMessage(fmt.Sprintf(`{"jsonrpc":"2.0","id":1,"method":"resources/read","params":{"uri":%q}}`, uri))
+		switch resp := s.HandleMessage(ctx, msg).(type) {
+		case mcp.JSONRPCError:
+			return SelfTestCheck{Name: "resources", Status: CheckFail, Detail: fmt.Sprintf("%s: %s", uri, resp.Error.Message)}
+		case mcp.JSONRPCResponse:
+		default:
+			return SelfTestCheck{Name: "resources", Status: CheckFail, Detail: fmt.Sprintf("%s: unexpected response %T", uri, resp)}
+		}
+	}
+	return SelfTestCheck{Name: "resources", Status: CheckPass, Detail: strings.Join(uris, ", ") + " resolved"}
+}
+
+func makeRunSelfTest(s *server.MCPServer, c *videoplatform.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		report := RunSelfTest(ctx, s, c)
+
+		data, _ := json.MarshalIndent(report, "", "  ")
+		text := fmt.Sprintf("%s\n%s", report.Table(), string(data))
+		if !report.Passed {
+			return mcp.NewToolResultError(text), nil
+		}
+		return mcp.NewToolResultText(text), nil
+	}
+}
@@ -0,0 +1,132 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Prodro21/video-mcp/internal/config"
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// mockPlatform serves the endpoints the self-test touches; channelsOK=false
+// makes the channels endpoint fail.
+func mockPlatform(t *testing.T, clips []videoplatform.Clip, channelsOK bool) *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":"ok"}`))
+	})
+	mux.HandleFunc("/api/v1/sessions", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Session]{
+			Data:  []videoplatform.Session{{ID: "session-1", Name: "Game 1", Status: "active"}},
+			Total: 12,
+		})
+	})
+	mux.HandleFunc("/api/v1/channels", func(w http.ResponseWriter, r *http.Request) {
+		if !channelsOK {
+			w.WriteHeader(http.StatusBadGateway)
+			w.Write([]byte(`{"error": "encoder offline"}`))
+			return
+		}
+		json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Channel]{
+			Data: []videoplatform.Channel{
+				{ID: "camera-1", Name: "Main Camera", Status: "active"},
+				{ID: "camera-2", Name: "Endzone", Status: "inactive"},
+			},
+			Total: 2,
+		})
+	})
+	mux.HandleFunc("/api/v1/clips", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Clip]{Data: clips, Total: len(clips)})
+	})
+	mux.HandleFunc("/api/v1/clips/", func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimPrefix(r.URL.Path, "/api/v1/clips/")
+		json.NewEncoder(w).Encode(videoplatform.Clip{ID: id, Status: "ready"})
+	})
+	return mux
+}
+
+func newTestMCPServer(c *videoplatform.Client) *server.MCPServer {
+	s := server.NewMCPServer(
+		"video-platform",
+		"test",
+		server.WithResourceCapabilities(true, false),
+		server.WithPromptCapabilities(true),
+	)
+	RegisterResources(s, c, &config.Config{}, &Registry{digests: newDigestStore()})
+	return s
+}
+
+func checkStatus(t *testing.T, report SelfTestReport, name, want string) {
+	t.Helper()
+	for _, check := range report.Checks {
+		if check.Name == name {
+			if check.Status != want {
+				t.Errorf("check %s = %s (%s), want %s", name, check.Status, check.Detail, want)
+			}
+			return
+		}
+	}
+	t.Errorf("check %s missing from report", name)
+}
+
+func TestRunSelfTest(t *testing.T) {
+	t.Run("all checks pass", func(t *testing.T) {
+		server := mockServer(t, mockPlatform(t, []videoplatform.Clip{{ID: "clip-1"}}, true).ServeHTTP)
+		defer server.Close()
+
+		c := videoplatform.New(server.URL)
+		report := RunSelfTest(context.Background(), newTestMCPServer(c), c)
+
+		if !report.Passed {
+			t.Errorf("Expected self-test to pass:\n%s", report.Table())
+		}
+		for _, name := range []string{"health", "list_sessions", "list_channels", "get_clip", "resources"} {
+			checkStatus(t, report, name, CheckPass)
+		}
+		if !strings.Contains(report.Table(), "Self-test passed") {
+			t.Errorf("Expected passing summary, got:\n%s", report.Table())
+		}
+	})
+
+	t.Run("partial failure", func(t *testing.T) {
+		server := mockServer(t, mockPlatform(t, nil, false).ServeHTTP)
+		defer server.Close()
+
+		c := videoplatform.New(server.URL)
+		report := RunSelfTest(context.Background(), newTestMCPServer(c), c)
+
+		if report.Passed {
+			t.Error("Expected self-test to fail")
+		}
+		checkStatus(t, report, "health", CheckPass)
+		checkStatus(t, report, "list_sessions", CheckPass)
+		checkStatus(t, report, "list_channels", CheckFail)
+		checkStatus(t, report, "get_clip", CheckSkip)
+		checkStatus(t, report, "resources", CheckPass)
+		if !strings.Contains(report.Table(), "encoder offline") {
+			t.Errorf("Expected failure detail in table, got:\n%s", report.Table())
+		}
+	})
+
+	t.Run("tool reports failure as error result", func(t *testing.T) {
+		server := mockServer(t, mockPlatform(t, nil, false).ServeHTTP)
+		defer server.Close()
+
+		c := videoplatform.New(server.URL)
+		handler := makeRunSelfTest(newTestMCPServer(c), c)
+
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{}
+
+		result, err := handler(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		verifyError(t, result, "list_channels")
+	})
+}
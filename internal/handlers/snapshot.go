@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// snapshotDir is where large snapshots are written under the export
+// directory
+const snapshotDir = "snapshots"
+
+// snapshotFileName names a snapshot {channel}_{time}.{ext}, the extension
+// following the image type
+func snapshotFileName(channelID, mediaType string, now time.Time) string {
+	ext := strings.TrimPrefix(mediaType, "image/")
+	if ext == "jpeg" {
+		ext = "jpg"
+	}
+	name := strings.Trim(unsafeFileChars.ReplaceAllString(channelID, "_"), "_")
+	return fmt.Sprintf("%s_%s.%s", name, now.UTC().Format("20060102-150405"), unsafeFileChars.ReplaceAllString(ext, "_"))
+}
+
+func makeChannelSnapshot(c *videoplatform.Client, exportRoot string, inlineLimit int) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		channelID, _ := req.Params.Arguments["channel_id"].(string)
+		if channelID == "" {
+			return mcp.NewToolResultError("channel_id is required"), nil
+		}
+
+		snap, err := c.GetChannelSnapshot(ctx, channelID)
+		if err != nil {
+			if apiStatus(err) == http.StatusNotFound {
+				return mcp.NewToolResultError(fmt.Sprintf("Channel %s not found, or it has no frame yet", channelID)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get snapshot: %v", err)), nil
+		}
+		mediaType, _, err := mime.ParseMediaType(snap.ContentType)
+		if err != nil || !strings.HasPrefix(mediaType, "image/") {
+			sent := snap.ContentType
+			if sent == "" {
+				sent = "no content type"
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("The platform sent %s instead of an image for channel %s; check the channel with test_channel", sent, channelID)), nil
+		}
+
+		caption := fmt.Sprintf("Snapshot of channel %s (%s, %d KiB)", channelID, mediaType, (len(snap.Data)+1023)>>10)
+		if len(snap.Data) <= inlineLimit {
+			return &mcp.CallToolResult{Content: []interface{}{
+				mcp.NewTextContent(caption),
+				mcp.NewImageContent(base64.StdEncoding.EncodeToString(snap.Data), mediaType),
+			}}, nil
+		}
+
+		if exportRoot == "" {
+			return mcp.NewToolResultError(fmt.Sprintf(
+				"Snapshot is %d bytes, over the %d byte inline limit; start the server with -export-dir to write large snapshots to disk",
+				len(snap.Data), inlineLimit)), nil
+		}
+		dir := filepath.Join(exportRoot, snapshotDir)
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to write snapshot: %v", err)), nil
+		}
+		path := filepath.Join(dir, snapshotFileName(channelID, mediaType, time.Now()))
+		if err := os.WriteFile(path, snap.Data, 0o644); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to write snapshot: %v", err)), nil
+		}
+		return mcp.NewToolResultText(fmt.Sprintf("%s written to %s", caption, path)), nil
+	}
+}
@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestChannelSnapshot(t *testing.T) {
+	frame := append([]byte("\xff\xd8\xff\xe0"), bytes.Repeat([]byte{0x42}, 2048)...)
+	srv := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/channels/camera-1/snapshot":
+			w.Header().Set("Content-Type", "image/jpeg")
+			w.Write(frame)
+		case "/api/v1/channels/camera-2/snapshot":
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			w.Write([]byte("<html>login</html>"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer srv.Close()
+	c := videoplatform.New(srv.URL)
+	snapshot := func(exportRoot string, inlineLimit int, channelID string) *mcp.CallToolResult {
+		t.Helper()
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"channel_id": channelID}
+		result, err := makeChannelSnapshot(c, exportRoot, inlineLimit)(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return result
+	}
+
+	t.Run("inline image", func(t *testing.T) {
+		result := snapshot("", 1<<20, "camera-1")
+		if result.IsError || len(result.Content) != 2 {
+			t.Fatalf("Expected a caption and an image, got %v", result.Content)
+		}
+		image, ok := result.Content[1].(mcp.ImageContent)
+		if !ok || image.MIMEType != "image/jpeg" {
+			t.Fatalf("Content[1] = %#v, want a JPEG image", result.Content[1])
+		}
+		if data, _ := base64.StdEncoding.DecodeString(image.Data); !bytes.Equal(data, frame) {
+			t.Error("image data does not match the frame")
+		}
+	})
+
+	t.Run("large image written to the export directory", func(t *testing.T) {
+		root := t.TempDir()
+		result := snapshot(root, 1024, "camera-1")
+		if result.IsError {
+			t.Fatalf("Unexpected tool error: %v", result.Content)
+		}
+		text := result.Content[0].(mcp.TextContent).Text
+		path := text[strings.LastIndex(text, " ")+1:]
+		if filepath.Dir(path) != filepath.Join(root, snapshotDir) || !strings.HasSuffix(path, ".jpg") {
+			t.Errorf("path = %s, want a .jpg under %s", path, filepath.Join(root, snapshotDir))
+		}
+		if data, err := os.ReadFile(path); err != nil || !bytes.Equal(data, frame) {
+			t.Errorf("written snapshot does not match the frame: %v", err)
+		}
+	})
+
+	t.Run("large image without export directory", func(t *testing.T) {
+		verifyError(t, snapshot("", 1024, "camera-1"), "start the server with -export-dir")
+	})
+
+	t.Run("not an image", func(t *testing.T) {
+		verifyError(t, snapshot("", 1<<20, "camera-2"), "The platform sent text/html; charset=utf-8 instead of an image for channel camera-2")
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		verifyError(t, snapshot("", 1<<20, "camera-9"), "Channel camera-9 not found")
+	})
+}
+
+func TestSnapshotFileName(t *testing.T) {
+	now := time.Date(2026, 10, 17, 18, 45, 0, 0, time.UTC)
+	if got, want := snapshotFileName("end zone/2", "image/jpeg", now), "end_zone_2_20261017-184500.jpg"; got != want {
+		t.Errorf("snapshotFileName() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// statusLevel classifies an item's health for at-a-glance summaries
+type statusLevel int
+
+const (
+	levelOK statusLevel = iota
+	levelWarn
+	levelFail
+)
+
+// glyphSet maps status levels to the prefix shown before each line
+type glyphSet [3]string
+
+var (
+	emojiGlyphs = glyphSet{"✅", "⚠️", "❌"}
+	plainGlyphs = glyphSet{"[OK]", "[WARN]", "[FAIL]"}
+)
+
+// presenter renders channels and sessions as one status line each
+type presenter struct {
+	glyphs glyphSet
+}
+
+func newPresenter(noEmoji bool) presenter {
+	if noEmoji {
+		return presenter{glyphs: plainGlyphs}
+	}
+	return presenter{glyphs: emojiGlyphs}
+}
+
+// classifyStatus picks a level from a status string and optional error
+// message. Any error message is a failure regardless of status.
+func classifyStatus[S ~string](status S, errorMessage *string) statusLevel {
+	if errorMessage != nil && strings.TrimSpace(*errorMessage) != "" {
+		return levelFail
+	}
+
+	switch string(status) {
+	case "active", "ready", "scheduled", "completed", "archived":
+		return levelOK
+	case "error", "failed":
+		return levelFail
+	default:
+		return levelWarn
+	}
+}
+
+func (p presenter) glyph(status string, errorMessage *string) string {
+	return p.glyphs[classifyStatus(status, errorMessage)]
+}
+
+func (p presenter) channelLine(ch videoplatform.Channel) string {
+	return p.channelLineAt(classifyStatus(ch.Status, ch.ErrorMessage), ch)
+}
+
+// channelLineAt is channelLine with the glyph of level, for channels
+// whose health isn't told by their status alone, e.g. stale ones
+func (p presenter) channelLineAt(level statusLevel, ch videoplatform.Channel) string {
+	line := fmt.Sprintf("%s %s (%s): %s", p.glyphs[level], ch.Name, ch.ID, ch.Status)
+	if ch.ErrorMessage != nil && *ch.ErrorMessage != "" {
+		line += " - " + *ch.ErrorMessage
+	}
+	return line
+}
+
+func (p presenter) sessionLine(s videoplatform.Session) string {
+	return fmt.Sprintf("%s %s (%s): %s", p.glyph(string(s.Status), nil), s.Name, s.ID, s.Status)
+}
+
+func makeStatusResource(c *videoplatform.Client, p presenter) server.ResourceHandlerFunc {
+	return func(ctx context.Context, req mcp.ReadResourceRequest) ([]interface{}, error) {
+		channels, err := c.ListAllChannels(ctx, videoplatform.ListChannelsParams{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch channels: %w", err)
+		}
+
+		var live []videoplatform.Session
+		for _, status := range []videoplatform.SessionStatus{videoplatform.SessionActive, videoplatform.SessionPaused} {
+			sessions, err := c.ListAllSessions(ctx, videoplatform.ListSessionsParams{Status: status})
+			if err != nil {
+				return nil, fmt.Errorf("failed to fetch %s sessions: %w", status, err)
+			}
+			live = append(live, sessions...)
+		}
+
+		var b strings.Builder
+		b.WriteString("Channels:\n")
+		if len(channels) == 0 {
+			b.WriteString("  (none)\n")
+		}
+		for _, ch := range channels {
+			b.WriteString("  " + p.channelLine(ch) + "\n")
+		}
+
+		b.WriteString("\nLive sessions:\n")
+		if len(live) == 0 {
+			b.WriteString("  (none)\n")
+		}
+		for _, s := range live {
+			b.WriteString("  " + p.sessionLine(s) + "\n")
+		}
+
+		return []interface{}{
+			mcp.TextResourceContents{
+				ResourceContents: mcp.ResourceContents{
+					URI:      req.Params.URI,
+					MIMEType: "text/plain",
+				},
+				Text: b.String(),
+			},
+		}, nil
+	}
+}
@@ -0,0 +1,125 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestClassifyStatus(t *testing.T) {
+	errMsg := "no signal"
+	blank := "  "
+
+	tests := []struct {
+		name         string
+		status       string
+		errorMessage *string
+		want         statusLevel
+	}{
+		{"active channel", "active", nil, levelOK},
+		{"ready clip", "ready", nil, levelOK},
+		{"scheduled session", "scheduled", nil, levelOK},
+		{"completed session", "completed", nil, levelOK},
+		{"inactive channel", "inactive", nil, levelWarn},
+		{"paused session", "paused", nil, levelWarn},
+		{"unknown status", "warming_up", nil, levelWarn},
+		{"error status", "error", nil, levelFail},
+		{"failed clip", "failed", nil, levelFail},
+		{"error message overrides active", "active", &errMsg, levelFail},
+		{"blank error message ignored", "active", &blank, levelOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := classifyStatus(tt.status, tt.errorMessage); got != tt.want {
+				t.Errorf("classifyStatus(%q) = %v, want %v", tt.status, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPresenterGlyphs(t *testing.T) {
+	errMsg := "no signal"
+
+	t.Run("emoji by default", func(t *testing.T) {
+		p := newPresenter(false)
+		if got := p.glyph("active", nil); got != "✅" {
+			t.Errorf("glyph(active) = %q, want ✅", got)
+		}
+		if got := p.glyph("paused", nil); got != "⚠️" {
+			t.Errorf("glyph(paused) = %q, want ⚠️", got)
+		}
+		if got := p.glyph("active", &errMsg); got != "❌" {
+			t.Errorf("glyph(active with error) = %q, want ❌", got)
+		}
+	})
+
+	t.Run("no-emoji fallback", func(t *testing.T) {
+		p := newPresenter(true)
+		if got := p.glyph("active", nil); got != "[OK]" {
+			t.Errorf("glyph(active) = %q, want [OK]", got)
+		}
+		if got := p.glyph("inactive", nil); got != "[WARN]" {
+			t.Errorf("glyph(inactive) = %q, want [WARN]", got)
+		}
+		if got := p.glyph("error", nil); got != "[FAIL]" {
+			t.Errorf("glyph(error) = %q, want [FAIL]", got)
+		}
+	})
+
+	t.Run("channel line includes error message", func(t *testing.T) {
+		p := newPresenter(true)
+		line := p.channelLine(videoplatform.Channel{ID: "camera-2", Name: "Endzone", Status: "error", ErrorMessage: &errMsg})
+		if line != "[FAIL] Endzone (camera-2): error - no signal" {
+			t.Errorf("channelLine() = %q", line)
+		}
+	})
+}
+
+func TestStatusResource(t *testing.T) {
+	errMsg := "no signal"
+	server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/channels":
+			json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Channel]{
+				Data: []videoplatform.Channel{
+					{ID: "camera-1", Name: "Main Camera", Status: "active"},
+					{ID: "camera-2", Name: "Endzone", Status: "error", ErrorMessage: &errMsg},
+				},
+			})
+		case "/api/v1/sessions":
+			var data []videoplatform.Session
+			if r.URL.Query().Get("status") == "active" {
+				data = []videoplatform.Session{{ID: "session-1", Name: "Game 1", Status: "active"}}
+			}
+			json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Session]{Data: data})
+		}
+	})
+	defer server.Close()
+
+	handler := makeStatusResource(videoplatform.New(server.URL), newPresenter(true))
+
+	req := mcp.ReadResourceRequest{}
+	req.Params.URI = "video://status"
+
+	contents, err := handler(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	text := contents[0].(mcp.TextResourceContents).Text
+	for _, want := range []string{
+		"[OK] Main Camera (camera-1): active",
+		"[FAIL] Endzone (camera-2): error - no signal",
+		"[OK] Game 1 (session-1): active",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("Expected %q in status output:\n%s", want, text)
+		}
+	}
+}
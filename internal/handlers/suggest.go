@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/Prodro21/video-mcp/internal/stats"
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// defaultSuggestions is how many labels suggest_labels returns by default
+const defaultSuggestions = 5
+
+// withoutClockLabels drops game clock labels, which are per-play values
+// rather than descriptions worth suggesting
+func withoutClockLabels(tags []videoplatform.Tag) []videoplatform.Tag {
+	out := make([]videoplatform.Tag, len(tags))
+	for i, tag := range tags {
+		labels := make([]string, 0, len(tag.Labels))
+		for _, label := range tag.Labels {
+			if !strings.HasPrefix(label, clockLabelPrefix) {
+				labels = append(labels, label)
+			}
+		}
+		tag.Labels = labels
+		out[i] = tag
+	}
+	return out
+}
+
+func makeSuggestLabels(c *videoplatform.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var q stats.LabelQuery
+		q.PlayType, _ = req.Params.Arguments["play_type"].(string)
+		q.Formation, _ = req.Params.Arguments["formation"].(string)
+		if labels, ok := req.Params.Arguments["labels"].([]interface{}); ok {
+			for _, label := range labels {
+				if s, ok := label.(string); ok && s != "" {
+					q.Labels = append(q.Labels, s)
+				}
+			}
+		}
+		if q.PlayType == "" && q.Formation == "" && len(q.Labels) == 0 {
+			return mcp.NewToolResultError("play_type, formation or labels is required"), nil
+		}
+
+		n := defaultSuggestions
+		if limit, ok := req.Params.Arguments["limit"].(float64); ok && limit > 0 {
+			n = int(limit)
+		}
+
+		params := videoplatform.ListTagsParams{PlayType: q.PlayType}
+		params.SessionID, _ = req.Params.Arguments["session_id"].(string)
+		tags, err := c.ListAllTags(ctx, params)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list tags: %v", err)), nil
+		}
+
+		result := struct {
+			Scope string `json:"scope"`
+			stats.LabelSuggestions
+		}{
+			Scope:            "season",
+			LabelSuggestions: stats.SuggestLabels(withoutClockLabels(tags), q, n),
+		}
+		if params.SessionID != "" {
+			result.Scope = "session " + params.SessionID
+		}
+
+		data, _ := json.MarshalIndent(result, "", "  ")
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
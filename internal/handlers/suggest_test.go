@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/Prodro21/video-mcp/internal/stats"
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestSuggestLabels(t *testing.T) {
+	pass := "pass"
+	tags := []videoplatform.Tag{
+		{ID: "t-1", PlayType: &pass, Labels: []string{"boot", "play-action", "clock:04:12"}},
+		{ID: "t-2", PlayType: &pass, Labels: []string{"boot", "play-action"}},
+		{ID: "t-3", PlayType: &pass, Labels: []string{"boot", "screen"}},
+	}
+
+	tests := []struct {
+		name      string
+		args      map[string]interface{}
+		wantScope string
+		wantQuery map[string]string
+	}{
+		{
+			name:      "season scope",
+			args:      map[string]interface{}{"play_type": "pass", "labels": []interface{}{"boot"}},
+			wantScope: "season",
+			wantQuery: map[string]string{"play_type": "pass", "session_id": ""},
+		},
+		{
+			name:      "session scope",
+			args:      map[string]interface{}{"play_type": "pass", "labels": []interface{}{"boot"}, "session_id": "s-1"},
+			wantScope: "session s-1",
+			wantQuery: map[string]string{"play_type": "pass", "session_id": "s-1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+				for key, want := range tt.wantQuery {
+					if got := r.URL.Query().Get(key); got != want {
+						t.Errorf("Expected %s=%q, got %q", key, want, got)
+					}
+				}
+				json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Tag]{Data: tags, Total: len(tags)})
+			})
+			defer server.Close()
+
+			req := mcp.CallToolRequest{}
+			req.Params.Arguments = tt.args
+			result, err := makeSuggestLabels(videoplatform.New(server.URL))(context.Background(), req)
+			if err != nil || result.IsError {
+				t.Fatalf("Unexpected error: %v %v", err, result.Content)
+			}
+
+			var out struct {
+				Scope string `json:"scope"`
+				stats.LabelSuggestions
+			}
+			if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &out); err != nil {
+				t.Fatalf("Failed to parse result: %v", err)
+			}
+			if out.Scope != tt.wantScope || out.Matched != 3 {
+				t.Errorf("Scope, Matched = %q, %d; want %q, 3", out.Scope, out.Matched, tt.wantScope)
+			}
+			if len(out.Suggestions) != 2 || out.Suggestions[0].Label != "play-action" || out.Suggestions[0].Count != 2 {
+				t.Errorf("Unexpected suggestions (clock labels should be ignored): %+v", out.Suggestions)
+			}
+		})
+	}
+
+	t.Run("requires a partial tag", func(t *testing.T) {
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"session_id": "s-1"}
+		result, _ := makeSuggestLabels(videoplatform.New("http://localhost"))(context.Background(), req)
+		verifyError(t, result, "play_type, formation or labels is required")
+	})
+}
@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// sessionSummary aggregates a session's clips and tags. It is the result of
+// session_summary and the session.json member of an export bundle.
+type sessionSummary struct {
+	Session        videoplatform.Session `json:"session"`
+	GeneratedAt    string                `json:"generated_at"`
+	Clips          int                   `json:"clips"`
+	ClipSeconds    float64               `json:"clip_seconds"`
+	ClipsByStatus  map[string]int        `json:"clips_by_status"`
+	FavoriteClips  int                   `json:"favorite_clips"`
+	Tags           int                   `json:"tags"`
+	ImportantTags  int                   `json:"important_tags"`
+	UnreviewedTags int                   `json:"unreviewed_tags"`
+	PlayTypes      map[string]int        `json:"play_types"`
+	YardsGained    int                   `json:"yards_gained"`
+}
+
+func summarizeSession(d *sessionData, now time.Time) sessionSummary {
+	summary := sessionSummary{
+		Session:       d.Session,
+		GeneratedAt:   now.UTC().Format(time.RFC3339),
+		Clips:         len(d.Clips),
+		ClipsByStatus: make(map[string]int),
+		Tags:          len(d.Tags),
+		PlayTypes:     make(map[string]int),
+	}
+	for _, clip := range d.Clips {
+		summary.ClipSeconds += clip.DurationSeconds
+		summary.ClipsByStatus[string(clip.Status)]++
+		if clip.IsFavorite {
+			summary.FavoriteClips++
+		}
+	}
+	for _, tag := range d.Tags {
+		if tag.IsImportant {
+			summary.ImportantTags++
+		}
+		if !tag.IsReviewed {
+			summary.UnreviewedTags++
+		}
+		if tag.PlayType != nil && *tag.PlayType != "" {
+			summary.PlayTypes[*tag.PlayType]++
+		}
+		if tag.YardsGained != nil {
+			summary.YardsGained += *tag.YardsGained
+		}
+	}
+	return summary
+}
+
+func makeSessionSummary(c *videoplatform.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		sessionID, _ := req.Params.Arguments["session_id"].(string)
+		if sessionID == "" {
+			return mcp.NewToolResultError("session_id is required"), nil
+		}
+
+		d, err := fetchSessionData(ctx, c, sessionID)
+		if err != nil {
+			if apiStatus(err) == http.StatusNotFound {
+				return mcp.NewToolResultError(fmt.Sprintf("Session %s not found", sessionID)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get session: %v", err)), nil
+		}
+		// Partial counts would read as real ones, so a failed listing fails
+		// the summary
+		if d.ClipsErr != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list clips: %v", d.ClipsErr)), nil
+		}
+		if d.TagsErr != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list tags: %v", d.TagsErr)), nil
+		}
+
+		data, _ := json.MarshalIndent(summarizeSession(d, time.Now()), "", "  ")
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
@@ -0,0 +1,106 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// page returns the slice of items requested by the limit and offset query
+func page[T any](r *http.Request, items []T) videoplatform.PaginatedResponse[T] {
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+	offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+	end := min(offset+limit, len(items))
+	offset = min(offset, end)
+	return videoplatform.PaginatedResponse[T]{Data: items[offset:end], Total: len(items), Limit: limit, Offset: offset}
+}
+
+func TestSessionSummary(t *testing.T) {
+	var clips []videoplatform.Clip
+	for i := 0; i < 150; i++ {
+		clip := videoplatform.Clip{ID: fmt.Sprintf("c-%d", i), Status: videoplatform.ClipReady, DurationSeconds: 10}
+		if i%50 == 0 {
+			clip.Status = videoplatform.ClipProcessing
+			clip.IsFavorite = true
+		}
+		clips = append(clips, clip)
+	}
+	pass, run, gain, loss := "pass", "run", 12, -3
+	notes := "second-string offense, backup QB"
+	tags := []videoplatform.Tag{
+		{ID: "t-1", PlayType: &pass, YardsGained: &gain, IsImportant: true},
+		{ID: "t-2", PlayType: &run, YardsGained: &loss, IsReviewed: true},
+		{ID: "t-3", PlayType: &pass, IsImportant: true, IsReviewed: true},
+		{ID: "t-4"},
+	}
+
+	server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/sessions/s-1":
+			json.NewEncoder(w).Encode(videoplatform.Session{ID: "s-1", Name: "Week 3 vs Eagles", Status: videoplatform.SessionCompleted, Notes: &notes})
+		case "/api/v1/clips":
+			if r.URL.Query().Get("session_id") == "" {
+				t.Error("Expected clips filtered by session_id")
+			}
+			json.NewEncoder(w).Encode(page(r, clips))
+		case "/api/v1/tags":
+			json.NewEncoder(w).Encode(page(r, tags))
+		case "/api/v1/channels":
+			t.Error("The summary doesn't need the channels")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer server.Close()
+	handler := makeSessionSummary(videoplatform.New(server.URL))
+
+	t.Run("aggregates every page", func(t *testing.T) {
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"session_id": "s-1"}
+		result, err := handler(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result.IsError {
+			t.Fatalf("Unexpected tool error: %v", result.Content)
+		}
+
+		var got sessionSummary
+		if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &got); err != nil {
+			t.Fatalf("result is not JSON: %v", err)
+		}
+		if got.Session.ID != "s-1" || got.Clips != 150 || got.ClipSeconds != 1500 {
+			t.Errorf("session %q, %d clips, %v seconds; want s-1, 150, 1500", got.Session.ID, got.Clips, got.ClipSeconds)
+		}
+		if got.Session.Notes == nil || *got.Session.Notes != notes {
+			t.Errorf("session notes = %v, want %q", got.Session.Notes, notes)
+		}
+		if got.ClipsByStatus["ready"] != 147 || got.ClipsByStatus["processing"] != 3 || got.FavoriteClips != 3 {
+			t.Errorf("clips by status %v, %d favorites", got.ClipsByStatus, got.FavoriteClips)
+		}
+		if got.Tags != 4 || got.PlayTypes["pass"] != 2 || got.PlayTypes["run"] != 1 || len(got.PlayTypes) != 2 {
+			t.Errorf("%d tags, play types %v", got.Tags, got.PlayTypes)
+		}
+		if got.YardsGained != 9 || got.ImportantTags != 2 || got.UnreviewedTags != 2 {
+			t.Errorf("yards %d, important %d, unreviewed %d; want 9, 2, 2", got.YardsGained, got.ImportantTags, got.UnreviewedTags)
+		}
+	})
+
+	t.Run("session not found", func(t *testing.T) {
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"session_id": "missing"}
+		result, _ := handler(context.Background(), req)
+		verifyError(t, result, "Session missing not found")
+	})
+
+	t.Run("missing session_id", func(t *testing.T) {
+		result, _ := handler(context.Background(), mcp.CallToolRequest{})
+		verifyError(t, result, "session_id is required")
+	})
+}
@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"runtime"
+	"runtime/debug"
+	"time"
+
+	"github.com/Prodro21/video-mcp/internal/config"
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// BuildInfo identifies the running binary
+type BuildInfo struct {
+	Version   string `json:"version"`
+	GoVersion string `json:"go_version"`
+	Revision  string `json:"revision,omitempty"`
+	BuildTime string `json:"build_time,omitempty"`
+	Modified  bool   `json:"modified,omitempty"`
+}
+
+// PlatformHealth is the result of a health check against the platform
+type PlatformHealth struct {
+	OK    bool   `json:"ok"`
+	Error string `json:"error,omitempty"`
+}
+
+// SupportBundle is a point-in-time snapshot of the server's runtime state
+// that users can attach to bug reports
+type SupportBundle struct {
+	GeneratedAt   string                         `json:"generated_at"`
+	UptimeSeconds int64                          `json:"uptime_seconds"`
+	Build         BuildInfo                      `json:"build"`
+	Config        config.Config                  `json:"config"`
+	Tools         []string                       `json:"tools"`
+	Metrics       map[string]ToolMetrics         `json:"metrics"`
+	Concurrency   ConcurrencyMetrics             `json:"concurrency"`
+	Audit         []AuditEntry                   `json:"audit"`
+	Requests      []videoplatform.RequestSummary `json:"requests"`
+	Health        PlatformHealth                 `json:"health"`
+}
+
+// BuildSupportBundle collects the bundle. Secrets in the config are redacted.
+func BuildSupportBundle(ctx context.Context, r *Registry, c *videoplatform.Client, cfg *config.Config) SupportBundle {
+	health := PlatformHealth{OK: true}
+	if err := c.Health(ctx); err != nil {
+		health = PlatformHealth{Error: err.Error()}
+	}
+
+	return SupportBundle{
+		GeneratedAt:   time.Now().UTC().Format(time.RFC3339),
+		UptimeSeconds: int64(time.Since(r.started).Seconds()),
+		Build:         currentBuildInfo(),
+		Config:        cfg.Redacted(),
+		Tools:         r.Tools(),
+		Metrics:       r.Metrics().Snapshot(),
+		Concurrency:   r.Metrics().Concurrency(),
+		Audit:         r.AuditEntries(),
+		Requests:      c.RecentRequests(),
+		Health:        health,
+	}
+}
+
+func currentBuildInfo() BuildInfo {
+	info := BuildInfo{Version: config.Version, GoVersion: runtime.Version()}
+
+	bi, ok := debug.ReadBuildInfo()
+	if !ok {
+		return info
+	}
+	for _, setting := range bi.Settings {
+		switch setting.Key {
+		case "vcs.revision":
+			info.Revision = setting.Value
+		case "vcs.time":
+			info.BuildTime = setting.Value
+		case "vcs.modified":
+			info.Modified = setting.Value == "true"
+		}
+	}
+	return info
+}
+
+func makeGenerateSupportBundle(r *Registry, c *videoplatform.Client, cfg *config.Config) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		bundle := BuildSupportBundle(ctx, r, c, cfg)
+
+		data, _ := json.MarshalIndent(bundle, "", "  ")
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Prodro21/video-mcp/internal/fanout"
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// maxBulkTagCreates bounds how many tags bulk_create_tags creates at once
+const maxBulkTagCreates = 4
+
+// maxBulkTags caps how many tags bulk_create_tags takes per call
+const maxBulkTags = 50
+
+// bulkTagFailure records a tag bulk_create_tags could not create. Index is
+// the tag's position in the tags argument.
+type bulkTagFailure struct {
+	Index  int    `json:"index"`
+	ClipID string `json:"clip_id"`
+	Error  string `json:"error"`
+}
+
+// bulkTagReport is the result of bulk_create_tags. TagIDs are the created
+// tags in input order; failed tags are left out of it.
+type bulkTagReport struct {
+	Requested int              `json:"requested"`
+	Created   int              `json:"created"`
+	TagIDs    []string         `json:"tag_ids"`
+	Failed    []bulkTagFailure `json:"failed"`
+}
+
+// Summary renders the report as a single sentence for tool output
+func (r bulkTagReport) Summary() string {
+	text := fmt.Sprintf("Created %d of %d tags", r.Created, r.Requested)
+	if len(r.Failed) == 0 {
+		return text + "."
+	}
+
+	failures := describeEach(r.Failed, func(f bulkTagFailure) string {
+		return fmt.Sprintf("tags[%d] on clip %s (%s)", f.Index, f.ClipID, f.Error)
+	})
+	return fmt.Sprintf("%s; %d failed and were not created: %s. The created tags were kept; retry only the failed ones.",
+		text, len(r.Failed), failures)
+}
+
+// bulkTagRequests builds a create request for every entry in tags, which
+// take the same fields as create_tag. Every invalid entry is reported by
+// index so the whole batch can be fixed in one go.
+func bulkTagRequests(args map[string]interface{}, overtime time.Duration) ([]videoplatform.CreateTagRequest, error) {
+	items, ok := args["tags"].([]interface{})
+	if !ok || len(items) == 0 {
+		return nil, fmt.Errorf("tags is required: an array of tags with the same fields as create_tag")
+	}
+	if len(items) > maxBulkTags {
+		return nil, fmt.Errorf("tags lists %d tags; create at most %d per call", len(items), maxBulkTags)
+	}
+
+	reqs := make([]videoplatform.CreateTagRequest, len(items))
+	var problems []string
+	for i, item := range items {
+		fields, ok := item.(map[string]interface{})
+		if !ok {
+			problems = append(problems, fmt.Sprintf("tags[%d]: must be an object", i))
+			continue
+		}
+		req, err := createTagRequest(fields, overtime)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("tags[%d]: %v", i, err))
+			continue
+		}
+		reqs[i] = req
+	}
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("No tags created; fix these entries: %s", strings.Join(problems, "; "))
+	}
+	return reqs, nil
+}
+
+// createTags creates each tag concurrently, at most maxBulkTagCreates at a
+// time. A failure doesn't stop the rest or undo the tags already created.
+func createTags(ctx context.Context, c *videoplatform.Client, reqs []videoplatform.CreateTagRequest) bulkTagReport {
+	report := bulkTagReport{Requested: len(reqs), TagIDs: []string{}, Failed: []bulkTagFailure{}}
+
+	tags := make([]*videoplatform.Tag, len(reqs))
+	errs := fanout.Each(ctx, len(reqs), maxBulkTagCreates, func(i int) (err error) {
+		tags[i], err = c.CreateTag(ctx, reqs[i])
+		return err
+	})
+
+	for i, req := range reqs {
+		if errs[i] != nil {
+			report.Failed = append(report.Failed, bulkTagFailure{Index: i, ClipID: req.ClipID, Error: errs[i].Error()})
+			continue
+		}
+		report.Created++
+		report.TagIDs = append(report.TagIDs, tags[i].ID)
+	}
+	return report
+}
+
+func makeBulkCreateTags(c *videoplatform.Client, overtime time.Duration) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		reqs, err := bulkTagRequests(req.Params.Arguments, overtime)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		report := createTags(ctx, c, reqs)
+		data, _ := json.MarshalIndent(report, "", "  ")
+		text := fmt.Sprintf("%s\n%s", report.Summary(), data)
+		if report.Created == 0 {
+			return mcp.NewToolResultError(text), nil
+		}
+		return mcp.NewToolResultText(text), nil
+	}
+}
@@ -0,0 +1,102 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/Prodro21/video-mcp/internal/config"
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestBulkCreateTags(t *testing.T) {
+	var posts atomic.Int32
+	srv := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		posts.Add(1)
+		var req videoplatform.CreateTagRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		if req.ClipID == "clip-gone" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":"clip not found"}`))
+			return
+		}
+		json.NewEncoder(w).Encode(videoplatform.Tag{ID: "tag-" + strings.TrimPrefix(req.ClipID, "clip-"), ClipID: req.ClipID})
+	})
+	defer srv.Close()
+
+	handler := makeBulkCreateTags(videoplatform.New(srv.URL), config.DefaultOvertimeLength)
+	call := func(tags ...interface{}) *mcp.CallToolResult {
+		t.Helper()
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"tags": tags}
+		result, err := handler(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return result
+	}
+	tag := func(clipID string) map[string]interface{} {
+		return map[string]interface{}{"clip_id": clipID, "session_id": "session-1", "play_type": "Run"}
+	}
+
+	t.Run("partial failure", func(t *testing.T) {
+		posts.Store(0)
+		result := call(tag("clip-1"), tag("clip-gone"), tag("clip-3"), tag("clip-4"), tag("clip-5"))
+		if result.IsError {
+			t.Fatalf("Expected success, got %v", result.Content)
+		}
+		text := result.Content[0].(mcp.TextContent).Text
+		if !strings.HasPrefix(text, "Created 4 of 5 tags; 1 failed and were not created: tags[1] on clip clip-gone (") {
+			t.Errorf("summary = %s", text)
+		}
+		var report bulkTagReport
+		if err := json.Unmarshal([]byte(text[strings.Index(text, "\n")+1:]), &report); err != nil {
+			t.Fatalf("Failed to parse result: %v", err)
+		}
+		if want := []string{"tag-1", "tag-3", "tag-4", "tag-5"}; !reflect.DeepEqual(report.TagIDs, want) {
+			t.Errorf("TagIDs = %v, want %v", report.TagIDs, want)
+		}
+		if len(report.Failed) != 1 || report.Failed[0].Index != 1 || !strings.Contains(report.Failed[0].Error, "clip not found") {
+			t.Errorf("Failed = %+v", report.Failed)
+		}
+		if posts.Load() != 5 {
+			t.Errorf("Expected 5 creates, got %d", posts.Load())
+		}
+	})
+
+	t.Run("invalid entries", func(t *testing.T) {
+		posts.Store(0)
+		bad := tag("clip-2")
+		bad["down"] = float64(7)
+		result := call(tag("clip-1"), bad, "clip-3", map[string]interface{}{"clip_id": "clip-4"})
+		verifyError(t, result, "No tags created; fix these entries: tags[1]: invalid play fields: down must be a whole number from 1 to 4, got 7; tags[2]: must be an object; tags[3]: clip_id and session_id are required")
+		if posts.Load() != 0 {
+			t.Errorf("Expected no creates when an entry is invalid, got %d", posts.Load())
+		}
+	})
+
+	t.Run("all failed", func(t *testing.T) {
+		verifyError(t, call(tag("clip-gone")), "Created 0 of 1 tags")
+	})
+
+	t.Run("missing tags", func(t *testing.T) {
+		verifyError(t, call(), "tags is required")
+	})
+
+	t.Run("too many tags", func(t *testing.T) {
+		posts.Store(0)
+		tooMany := make([]interface{}, maxBulkTags+1)
+		for i := range tooMany {
+			tooMany[i] = tag("clip-1")
+		}
+		verifyError(t, call(tooMany...), "tags lists 51 tags; create at most 50 per call")
+		if posts.Load() != 0 {
+			t.Errorf("Expected no creates over the cap, got %d", posts.Load())
+		}
+	})
+}
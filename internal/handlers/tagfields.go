@@ -0,0 +1,44 @@
+package handlers
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// playFieldRange is the accepted range of a numeric play field
+type playFieldRange struct {
+	key      string
+	min, max int
+	note     string
+}
+
+// playFieldRanges bounds the numeric play fields create_tag and update_tag
+// accept, in the order problems are reported
+var playFieldRanges = []playFieldRange{
+	{key: "quarter", min: 1, max: lastQuarter, note: fmt.Sprintf(" (%d is OT, %d is OT2 and so on)", overtimeQuarter, overtimeQuarter+1)},
+	{key: "down", min: 1, max: 4},
+	{key: "distance", min: 0, max: 99},
+	{key: "yards_gained", min: -99, max: 99},
+}
+
+// checkPlayFields validates the numeric play fields present in args, so a
+// bad down or distance is caught here rather than by the platform. Every
+// invalid field is reported at once.
+func checkPlayFields(args map[string]interface{}) error {
+	var problems []string
+	for _, r := range playFieldRanges {
+		v, present := args[r.key]
+		if !present || v == nil {
+			continue
+		}
+		if n, ok := v.(float64); ok && n == math.Trunc(n) && n >= float64(r.min) && n <= float64(r.max) {
+			continue
+		}
+		problems = append(problems, fmt.Sprintf("%s must be a whole number from %d to %d%s, got %#v", r.key, r.min, r.max, r.note, v))
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+	return fmt.Errorf("invalid play fields: %s", strings.Join(problems, "; "))
+}
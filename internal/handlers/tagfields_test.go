@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Prodro21/video-mcp/internal/config"
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestCheckPlayFields(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    map[string]interface{}
+		wantErr string
+	}{
+		{name: "no play fields", args: map[string]interface{}{"notes": "x"}},
+		{name: "in range", args: map[string]interface{}{"quarter": float64(5), "down": float64(4), "distance": float64(0), "yards_gained": float64(-99)}},
+		{name: "bad down", args: map[string]interface{}{"down": float64(7)}, wantErr: "invalid play fields: down must be a whole number from 1 to 4, got 7"},
+		{name: "negative distance", args: map[string]interface{}{"distance": float64(-3)}, wantErr: "distance must be a whole number from 0 to 99, got -3"},
+		{name: "fractional yards", args: map[string]interface{}{"yards_gained": 2.5}, wantErr: "yards_gained must be a whole number from -99 to 99, got 2.5"},
+		{name: "second overtime", args: map[string]interface{}{"quarter": float64(6)}},
+		{name: "quarter past last overtime", args: map[string]interface{}{"quarter": float64(15)}, wantErr: "quarter must be a whole number from 1 to 14 (5 is OT, 6 is OT2 and so on), got 15"},
+		{name: "not a number", args: map[string]interface{}{"down": "3"}, wantErr: `down must be a whole number from 1 to 4, got "3"`},
+		{
+			name:    "every problem at once",
+			args:    map[string]interface{}{"down": float64(0), "distance": float64(100), "quarter": float64(0), "yards_gained": float64(120)},
+			wantErr: "invalid play fields: quarter must be a whole number from 1 to 14 (5 is OT, 6 is OT2 and so on), got 0; down must be a whole number from 1 to 4, got 0; distance must be a whole number from 0 to 99, got 100; yards_gained must be a whole number from -99 to 99, got 120",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkPlayFields(tt.args)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("checkPlayFields() unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("checkPlayFields() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// Every quarter a game clock can store must pass the quarter check, since
+// templates and update_tag pass stored quarters back in
+func TestCheckPlayFields_GameClockQuarters(t *testing.T) {
+	for _, clock := range []string{"Q1 15:00", "Q4 00:01", "OT 1:00", "OT2 3:30", "OT10 0:00"} {
+		gc, err := parseGameClock(clock, 0)
+		if err != nil {
+			t.Fatalf("parseGameClock(%q) unexpected error: %v", clock, err)
+		}
+		if err := checkPlayFields(map[string]interface{}{"quarter": float64(gc.Quarter)}); err != nil {
+			t.Errorf("quarter %d from %q rejected: %v", gc.Quarter, clock, err)
+		}
+	}
+}
+
+func TestCreateAndUpdateTag_InvalidPlayFields(t *testing.T) {
+	var requests int
+	srv := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusBadRequest)
+	})
+	defer srv.Close()
+
+	c := videoplatform.New(srv.URL)
+	handlers := map[string]struct {
+		handler func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error)
+		args    map[string]interface{}
+	}{
+		"create_tag": {makeCreateTag(c, config.DefaultOvertimeLength, nil), map[string]interface{}{"clip_id": "clip-1", "session_id": "session-1"}},
+		"update_tag": {makeUpdateTag(c, config.DefaultOvertimeLength), map[string]interface{}{"tag_id": "tag-1"}},
+	}
+	for name, h := range handlers {
+		t.Run(name, func(t *testing.T) {
+			req := mcp.CallToolRequest{}
+			req.Params.Arguments = h.args
+			req.Params.Arguments["down"] = float64(7)
+			req.Params.Arguments["distance"] = float64(-3)
+			result, err := h.handler(context.Background(), req)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			verifyError(t, result, "down must be a whole number from 1 to 4, got 7; distance must be a whole number from 0 to 99, got -3")
+		})
+	}
+	if requests != 0 {
+		t.Errorf("Expected no API calls for invalid play fields, got %d", requests)
+	}
+}
@@ -0,0 +1,140 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+)
+
+// tagSource is a clip whose tags should move to a surviving clip. Shift is
+// added to each tag's offset_seconds so the tag keeps pointing at the same
+// moment in the footage. Length is the surviving clip's length in seconds,
+// or 0 when unknown.
+type tagSource struct {
+	ClipID string
+	Shift  float64
+	Length float64
+}
+
+// tagMigrationFailure records a tag that could not be re-homed
+type tagMigrationFailure struct {
+	TagID  string `json:"tag_id,omitempty"`
+	ClipID string `json:"clip_id"`
+	Error  string `json:"error"`
+}
+
+// tagOutOfRange records a tag whose moment is no longer in the surviving
+// clip. OffsetSeconds is where the shift would have put it.
+type tagOutOfRange struct {
+	TagID         string  `json:"tag_id"`
+	ClipID        string  `json:"clip_id"`
+	OffsetSeconds float64 `json:"offset_seconds"`
+}
+
+// tagMigrationReport summarizes a tag migration. Tags in OutOfRange were
+// left unchanged rather than pointed at the wrong play.
+type tagMigrationReport struct {
+	TargetClipID string                `json:"target_clip_id"`
+	Migrated     int                   `json:"migrated"`
+	OutOfRange   []tagOutOfRange       `json:"out_of_range,omitempty"`
+	Failed       []tagMigrationFailure `json:"failed,omitempty"`
+}
+
+// Summary renders the report as a single sentence for tool output
+func (r tagMigrationReport) Summary() string {
+	text := fmt.Sprintf("Migrated %d tags to clip %s", r.Migrated, r.TargetClipID)
+	if len(r.OutOfRange) > 0 {
+		skipped := describeEach(r.OutOfRange, func(o tagOutOfRange) string {
+			return fmt.Sprintf("%s (would be at %s)", o.TagID, formatSeconds(o.OffsetSeconds))
+		})
+		text += fmt.Sprintf("; %d left unchanged because their moment is no longer in the clip: %s", len(r.OutOfRange), skipped)
+	}
+	return bulkSummary(text, r.Failed, func(f tagMigrationFailure) string {
+		id := f.TagID
+		if id == "" {
+			id = "tags of clip " + f.ClipID
+		}
+		return fmt.Sprintf("%s (%s)", id, f.Error)
+	})
+}
+
+// migrateTags re-homes the tags of each source clip onto the target clip,
+// adjusting offsets where the tag has one. A tag whose shifted offset falls
+// before the clip's start or past its end is left alone and reported, since
+// the moment it marked was cut. Failures are collected rather than aborting
+// so one bad tag doesn't strand the rest.
+func migrateTags(ctx context.Context, c *videoplatform.Client, targetClipID string, sources []tagSource) tagMigrationReport {
+	report := tagMigrationReport{TargetClipID: targetClipID}
+
+	for _, src := range sources {
+		tags, err := c.ListAllTags(ctx, videoplatform.ListTagsParams{ClipID: src.ClipID})
+		if err != nil {
+			report.Failed = append(report.Failed, tagMigrationFailure{ClipID: src.ClipID, Error: err.Error()})
+			continue
+		}
+
+		for _, tag := range tags {
+			// A tag is checked against the clip's length even when its offset
+			// doesn't move, since the end may have been cut
+			if tag.OffsetSeconds != nil {
+				offset := *tag.OffsetSeconds + src.Shift
+				if offset < 0 || src.Length > 0 && offset > src.Length {
+					report.OutOfRange = append(report.OutOfRange, tagOutOfRange{TagID: tag.ID, ClipID: src.ClipID, OffsetSeconds: offset})
+					continue
+				}
+			}
+			if tag.ClipID == targetClipID && (src.Shift == 0 || tag.OffsetSeconds == nil) {
+				continue
+			}
+
+			update := videoplatform.UpdateTagRequest{ClipID: &targetClipID}
+			if tag.OffsetSeconds != nil && src.Shift != 0 {
+				offset := *tag.OffsetSeconds + src.Shift
+				update.OffsetSeconds = &offset
+			}
+
+			if _, err := c.UpdateTag(ctx, tag.ID, update); err != nil {
+				report.Failed = append(report.Failed, tagMigrationFailure{TagID: tag.ID, ClipID: src.ClipID, Error: err.Error()})
+				continue
+			}
+			report.Migrated++
+		}
+	}
+
+	return report
+}
+
+// mergeTagSources computes, for each clip folded into a merged clip, how far
+// its start sits from the merged clip's start
+func mergeTagSources(sources []videoplatform.Clip, merged videoplatform.Clip) ([]tagSource, error) {
+	mergedStart, err := time.Parse(time.RFC3339, merged.StartTime)
+	if err != nil {
+		return nil, fmt.Errorf("invalid start_time on merged clip %s: %w", merged.ID, err)
+	}
+
+	out := make([]tagSource, 0, len(sources))
+	for _, clip := range sources {
+		start, err := time.Parse(time.RFC3339, clip.StartTime)
+		if err != nil {
+			return nil, fmt.Errorf("invalid start_time on clip %s: %w", clip.ID, err)
+		}
+		out = append(out, tagSource{ClipID: clip.ID, Shift: start.Sub(mergedStart).Seconds(), Length: clipDuration(merged)})
+	}
+	return out, nil
+}
+
+// trimTagSource computes the offset shift for a trimmed clip: moving the
+// start later by N seconds moves every tag N seconds earlier
+func trimTagSource(before, after videoplatform.Clip) (tagSource, error) {
+	oldStart, err := time.Parse(time.RFC3339, before.StartTime)
+	if err != nil {
+		return tagSource{}, fmt.Errorf("invalid start_time on clip %s: %w", before.ID, err)
+	}
+	newStart, err := time.Parse(time.RFC3339, after.StartTime)
+	if err != nil {
+		return tagSource{}, fmt.Errorf("invalid start_time on clip %s: %w", after.ID, err)
+	}
+	return tagSource{ClipID: before.ID, Shift: oldStart.Sub(newStart).Seconds(), Length: clipDuration(after)}, nil
+}
@@ -0,0 +1,213 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+)
+
+func floatPtr(f float64) *float64 { return &f }
+
+// tagPlatform serves tags by clip and records PATCH bodies by tag ID.
+// PATCHes to tags listed in failTags return 500.
+type tagPlatform struct {
+	mu       sync.Mutex
+	tags     map[string][]videoplatform.Tag
+	failTags map[string]bool
+	updates  map[string]videoplatform.UpdateTagRequest
+}
+
+func (p *tagPlatform) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	switch {
+	case r.Method == "GET" && r.URL.Path == "/api/v1/tags":
+		tags := p.tags[r.URL.Query().Get("clip_id")]
+		json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Tag]{Data: tags, Total: len(tags)})
+	case r.Method == "PATCH" && strings.HasPrefix(r.URL.Path, "/api/v1/tags/"):
+		id := strings.TrimPrefix(r.URL.Path, "/api/v1/tags/")
+		if p.failTags[id] {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"error": "db locked"}`))
+			return
+		}
+		var req videoplatform.UpdateTagRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		p.updates[id] = req
+		json.NewEncoder(w).Encode(videoplatform.Tag{ID: id, ClipID: *req.ClipID})
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func TestMigrateTags_Merge(t *testing.T) {
+	platform := &tagPlatform{
+		tags: map[string][]videoplatform.Tag{
+			"clip-a": {{ID: "tag-1", ClipID: "clip-a", OffsetSeconds: floatPtr(3)}},
+			"clip-b": {{ID: "tag-2", ClipID: "clip-b", OffsetSeconds: floatPtr(4)}, {ID: "tag-3", ClipID: "clip-b"}},
+			"clip-c": {{ID: "tag-4", ClipID: "clip-c", OffsetSeconds: floatPtr(1.5)}},
+		},
+		updates: map[string]videoplatform.UpdateTagRequest{},
+	}
+	server := mockServer(t, platform.ServeHTTP)
+	defer server.Close()
+
+	clips := []videoplatform.Clip{
+		{ID: "clip-a", StartTime: "2026-10-09T19:00:00Z"},
+		{ID: "clip-b", StartTime: "2026-10-09T19:00:20Z"},
+		{ID: "clip-c", StartTime: "2026-10-09T19:00:45Z"},
+	}
+	merged := videoplatform.Clip{ID: "clip-m", StartTime: "2026-10-09T19:00:00Z"}
+
+	sources, err := mergeTagSources(clips, merged)
+	if err != nil {
+		t.Fatalf("mergeTagSources() unexpected error: %v", err)
+	}
+
+	report := migrateTags(context.Background(), videoplatform.New(server.URL), "clip-m", sources)
+	if report.Migrated != 4 {
+		t.Errorf("Migrated = %d, want 4", report.Migrated)
+	}
+	if len(report.Failed) != 0 {
+		t.Errorf("Expected no failures, got %+v", report.Failed)
+	}
+
+	wantOffsets := map[string]float64{"tag-1": 3, "tag-2": 24, "tag-4": 46.5}
+	for id, want := range wantOffsets {
+		update, ok := platform.updates[id]
+		if !ok {
+			t.Errorf("Expected %s to be updated", id)
+			continue
+		}
+		if *update.ClipID != "clip-m" {
+			t.Errorf("%s clip_id = %s, want clip-m", id, *update.ClipID)
+		}
+		if id == "tag-1" {
+			if update.OffsetSeconds != nil {
+				t.Errorf("%s offset should be unchanged for the first clip, got %v", id, *update.OffsetSeconds)
+			}
+			continue
+		}
+		if update.OffsetSeconds == nil || *update.OffsetSeconds != want {
+			t.Errorf("%s offset_seconds = %v, want %v", id, update.OffsetSeconds, want)
+		}
+	}
+	if platform.updates["tag-3"].OffsetSeconds != nil {
+		t.Error("Tag without an offset should not gain one")
+	}
+}
+
+func TestMigrateTags_TrimOffset(t *testing.T) {
+	platform := &tagPlatform{
+		tags: map[string][]videoplatform.Tag{
+			"clip-1": {
+				{ID: "tag-1", ClipID: "clip-1", OffsetSeconds: floatPtr(14)},
+				{ID: "tag-2", ClipID: "clip-1", OffsetSeconds: floatPtr(4)},
+			},
+		},
+		updates: map[string]videoplatform.UpdateTagRequest{},
+	}
+	server := mockServer(t, platform.ServeHTTP)
+	defer server.Close()
+
+	before := videoplatform.Clip{ID: "clip-1", StartTime: "2026-10-09T19:00:00Z"}
+	after := videoplatform.Clip{ID: "clip-1", StartTime: "2026-10-09T19:00:10Z"}
+
+	src, err := trimTagSource(before, after)
+	if err != nil {
+		t.Fatalf("trimTagSource() unexpected error: %v", err)
+	}
+	if src.Shift != -10 {
+		t.Errorf("Shift = %v, want -10", src.Shift)
+	}
+
+	report := migrateTags(context.Background(), videoplatform.New(server.URL), "clip-1", []tagSource{src})
+	if report.Migrated != 1 {
+		t.Errorf("Migrated = %d, want 1", report.Migrated)
+	}
+	if got := *platform.updates["tag-1"].OffsetSeconds; got != 4 {
+		t.Errorf("tag-1 offset_seconds = %v, want 4", got)
+	}
+	// Tags in the trimmed-away head are left alone and reported
+	if _, ok := platform.updates["tag-2"]; ok {
+		t.Error("tag-2 should not be updated")
+	}
+	if len(report.OutOfRange) != 1 || report.OutOfRange[0].TagID != "tag-2" || report.OutOfRange[0].OffsetSeconds != -6 {
+		t.Fatalf("OutOfRange = %+v, want tag-2 at -6", report.OutOfRange)
+	}
+	summary := report.Summary()
+	if !strings.Contains(summary, "1 left unchanged because their moment is no longer in the clip: tag-2 (would be at -6s)") {
+		t.Errorf("Summary() = %q", summary)
+	}
+}
+
+func TestMigrateTags_PastClipEnd(t *testing.T) {
+	platform := &tagPlatform{
+		tags: map[string][]videoplatform.Tag{
+			"clip-1": {
+				{ID: "tag-1", ClipID: "clip-1", OffsetSeconds: floatPtr(14)},
+				{ID: "tag-2", ClipID: "clip-1", OffsetSeconds: floatPtr(28)},
+			},
+		},
+		updates: map[string]videoplatform.UpdateTagRequest{},
+	}
+	server := mockServer(t, platform.ServeHTTP)
+	defer server.Close()
+
+	before := videoplatform.Clip{ID: "clip-1", StartTime: "2026-10-09T19:00:00Z", EndTime: "2026-10-09T19:00:30Z"}
+	after := videoplatform.Clip{ID: "clip-1", StartTime: "2026-10-09T19:00:10Z", EndTime: "2026-10-09T19:00:25Z"}
+
+	src, err := trimTagSource(before, after)
+	if err != nil {
+		t.Fatalf("trimTagSource() unexpected error: %v", err)
+	}
+	if src.Length != 15 {
+		t.Errorf("Length = %v, want 15", src.Length)
+	}
+
+	report := migrateTags(context.Background(), videoplatform.New(server.URL), "clip-1", []tagSource{src})
+	if report.Migrated != 1 {
+		t.Errorf("Migrated = %d, want 1", report.Migrated)
+	}
+	if _, ok := platform.updates["tag-2"]; ok {
+		t.Error("tag-2 should not be updated")
+	}
+	if len(report.OutOfRange) != 1 || report.OutOfRange[0].TagID != "tag-2" {
+		t.Fatalf("OutOfRange = %+v, want tag-2", report.OutOfRange)
+	}
+}
+
+func TestMigrateTags_PartialFailure(t *testing.T) {
+	platform := &tagPlatform{
+		tags: map[string][]videoplatform.Tag{
+			"clip-a": {{ID: "tag-1", ClipID: "clip-a"}, {ID: "tag-2", ClipID: "clip-a"}},
+			"clip-b": {{ID: "tag-3", ClipID: "clip-b"}},
+		},
+		failTags: map[string]bool{"tag-2": true},
+		updates:  map[string]videoplatform.UpdateTagRequest{},
+	}
+	server := mockServer(t, platform.ServeHTTP)
+	defer server.Close()
+
+	report := migrateTags(context.Background(), videoplatform.New(server.URL), "clip-m", []tagSource{
+		{ClipID: "clip-a"},
+		{ClipID: "clip-b"},
+	})
+
+	if report.Migrated != 2 {
+		t.Errorf("Migrated = %d, want 2", report.Migrated)
+	}
+	if len(report.Failed) != 1 || report.Failed[0].TagID != "tag-2" {
+		t.Fatalf("Expected tag-2 to fail, got %+v", report.Failed)
+	}
+	summary := report.Summary()
+	if !strings.Contains(summary, "Migrated 2 tags to clip clip-m; 1 failed: tag-2") {
+		t.Errorf("Summary() = %q", summary)
+	}
+}
@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/Prodro21/video-mcp/internal/fanout"
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// maxTagReviewUpdates bounds how many tags mark_tags_reviewed updates at
+// once
+const maxTagReviewUpdates = 4
+
+// tagReviewFailure records a tag whose review flag could not be set
+type tagReviewFailure struct {
+	TagID string `json:"tag_id"`
+	Error string `json:"error"`
+}
+
+// tagReviewReport is the result of mark_tags_reviewed
+type tagReviewReport struct {
+	Reviewed bool               `json:"reviewed"`
+	Changed  []string           `json:"changed"`
+	Skipped  []string           `json:"skipped"`
+	Failed   []tagReviewFailure `json:"failed"`
+}
+
+// Summary renders the report as a single sentence for tool output
+func (r tagReviewReport) Summary() string {
+	state := "reviewed"
+	if !r.Reviewed {
+		state = "unreviewed"
+	}
+	total := len(r.Changed) + len(r.Skipped) + len(r.Failed)
+	text := fmt.Sprintf("Marked %d tags %s: %d changed, %d already %s", total, state, len(r.Changed), len(r.Skipped), state)
+	return bulkSummary(text, r.Failed, func(f tagReviewFailure) string {
+		return fmt.Sprintf("%s (%s)", f.TagID, f.Error)
+	})
+}
+
+// setTagsReviewed sets the review flag of each tag concurrently, at most
+// maxTagReviewUpdates at a time. Tags already flagged are skipped, and
+// failures are collected so one bad tag doesn't stop the rest; results
+// keep the order of ids.
+func setTagsReviewed(ctx context.Context, c *videoplatform.Client, ids []string, reviewed bool) tagReviewReport {
+	report := tagReviewReport{Reviewed: reviewed, Changed: []string{}, Skipped: []string{}, Failed: []tagReviewFailure{}}
+
+	changed := make([]bool, len(ids))
+	errs := fanout.Each(ctx, len(ids), maxTagReviewUpdates, func(i int) error {
+		tag, err := c.GetTag(ctx, ids[i])
+		if err != nil {
+			return err
+		}
+		if tag.IsReviewed == reviewed {
+			return nil
+		}
+		if _, err := c.SetTagReviewed(ctx, ids[i], reviewed); err != nil {
+			return err
+		}
+		changed[i] = true
+		return nil
+	})
+
+	for i, id := range ids {
+		switch err := errs[i]; {
+		case err != nil && apiStatus(err) == http.StatusNotFound:
+			report.Failed = append(report.Failed, tagReviewFailure{TagID: id, Error: "tag not found"})
+		case err != nil:
+			report.Failed = append(report.Failed, tagReviewFailure{TagID: id, Error: err.Error()})
+		case changed[i]:
+			report.Changed = append(report.Changed, id)
+		default:
+			report.Skipped = append(report.Skipped, id)
+		}
+	}
+	return report
+}
+
+func makeMarkTagsReviewed(c *videoplatform.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		tagID, _ := req.Params.Arguments["tag_id"].(string)
+		tagIDs, err := idsArg(req.Params.Arguments, "tag_ids", "tag")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		switch {
+		case tagID != "" && len(tagIDs) > 0:
+			return mcp.NewToolResultError("Pass tag_id or tag_ids, not both"), nil
+		case tagID != "":
+			tagIDs = []string{tagID}
+		case len(tagIDs) == 0:
+			return mcp.NewToolResultError("tag_id or tag_ids is required"), nil
+		}
+		reviewed := true
+		if r, ok := req.Params.Arguments["reviewed"].(bool); ok {
+			reviewed = r
+		}
+
+		seen := make(map[string]bool, len(tagIDs))
+		ids := make([]string, 0, len(tagIDs))
+		for _, id := range tagIDs {
+			if !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+
+		report := setTagsReviewed(ctx, c, ids, reviewed)
+		data, _ := json.MarshalIndent(report, "", "  ")
+		return mcp.NewToolResultText(fmt.Sprintf("%s\n%s", report.Summary(), data)), nil
+	}
+}
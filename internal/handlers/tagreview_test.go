@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestMarkTagsReviewed(t *testing.T) {
+	var mu sync.Mutex
+	var posted []string
+	reviewed := map[string]bool{"tag-1": false, "tag-2": true, "tag-3": false}
+	srv := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		id, action, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/api/v1/tags/"), "/")
+		state, ok := reviewed[id]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if action == "reviewed" {
+			if id == "tag-3" {
+				w.WriteHeader(http.StatusInternalServerError)
+				w.Write([]byte(`{"error":"tag is locked"}`))
+				return
+			}
+			var body struct{ Reviewed bool }
+			json.NewDecoder(r.Body).Decode(&body)
+			mu.Lock()
+			posted = append(posted, id)
+			mu.Unlock()
+			state = body.Reviewed
+		}
+		json.NewEncoder(w).Encode(videoplatform.Tag{ID: id, IsReviewed: state})
+	})
+	defer srv.Close()
+
+	handler := makeMarkTagsReviewed(videoplatform.New(srv.URL))
+	call := func(args map[string]interface{}) (*mcp.CallToolResult, tagReviewReport) {
+		t.Helper()
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = args
+		result, err := handler(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		var report tagReviewReport
+		if !result.IsError {
+			text := result.Content[0].(mcp.TextContent).Text
+			if err := json.Unmarshal([]byte(text[strings.Index(text, "\n")+1:]), &report); err != nil {
+				t.Fatalf("Failed to parse result: %v", err)
+			}
+		}
+		return result, report
+	}
+
+	t.Run("tag_ids", func(t *testing.T) {
+		result, report := call(map[string]interface{}{"tag_ids": []interface{}{"tag-1", "tag-2", "tag-3", "tag-9", "tag-1"}})
+		if !reflect.DeepEqual(report.Changed, []string{"tag-1"}) {
+			t.Errorf("changed = %v", report.Changed)
+		}
+		if !reflect.DeepEqual(report.Skipped, []string{"tag-2"}) {
+			t.Errorf("skipped = %v", report.Skipped)
+		}
+		want := []tagReviewFailure{{TagID: "tag-3", Error: "tag is locked"}, {TagID: "tag-9", Error: "tag not found"}}
+		if len(report.Failed) != 2 || report.Failed[0].TagID != want[0].TagID || !strings.Contains(report.Failed[0].Error, want[0].Error) || report.Failed[1] != want[1] {
+			t.Errorf("failed = %+v", report.Failed)
+		}
+		if text := result.Content[0].(mcp.TextContent).Text; !strings.HasPrefix(text, "Marked 4 tags reviewed: 1 changed, 1 already reviewed; 2 failed: tag-3") {
+			t.Errorf("summary = %s", text)
+		}
+		if len(posted) != 1 {
+			t.Errorf("Expected only tag-1 to be updated, got %v", posted)
+		}
+	})
+
+	t.Run("tag_id unreviewed", func(t *testing.T) {
+		result, report := call(map[string]interface{}{"tag_id": "tag-2", "reviewed": false})
+		if !reflect.DeepEqual(report.Changed, []string{"tag-2"}) {
+			t.Errorf("changed = %v", report.Changed)
+		}
+		if text := result.Content[0].(mcp.TextContent).Text; !strings.HasPrefix(text, "Marked 1 tags unreviewed: 1 changed, 0 already unreviewed.") {
+			t.Errorf("summary = %s", text)
+		}
+	})
+
+	t.Run("invalid arguments", func(t *testing.T) {
+		result, _ := call(map[string]interface{}{})
+		verifyError(t, result, "tag_id or tag_ids is required")
+		result, _ = call(map[string]interface{}{"tag_id": "tag-1", "tag_ids": []interface{}{"tag-2"}})
+		verifyError(t, result, "Pass tag_id or tag_ids, not both")
+	})
+}
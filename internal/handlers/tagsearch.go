@@ -0,0 +1,178 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// snippetContext is how many bytes of notes a snippet shows either side of
+// the match
+const snippetContext = 40
+
+// tagSearchMatch is a tag search_tags found, with where the query matched
+// and the matching text, the match wrapped in ** for the text output
+type tagSearchMatch struct {
+	videoplatform.Tag
+	MatchedIn string `json:"matched_in"`
+	Snippet   string `json:"snippet"`
+}
+
+// matchTag finds query in a tag's notes, then its labels, ignoring case.
+// ok is false when neither contains it.
+func matchTag(tag videoplatform.Tag, query *regexp.Regexp) (match tagSearchMatch, ok bool) {
+	if tag.Notes != nil {
+		if loc := query.FindStringIndex(*tag.Notes); loc != nil {
+			return tagSearchMatch{Tag: tag, MatchedIn: "notes", Snippet: snippet(*tag.Notes, loc[0], loc[1])}, true
+		}
+	}
+	for _, label := range tag.Labels {
+		if loc := query.FindStringIndex(label); loc != nil {
+			return tagSearchMatch{Tag: tag, MatchedIn: "label", Snippet: snippet(label, loc[0], loc[1])}, true
+		}
+	}
+	return tagSearchMatch{}, false
+}
+
+// snippet is the text around s[start:end] with the match highlighted,
+// whitespace collapsed to single spaces and "…" marking text cut from
+// either end, at a word break where possible
+func snippet(s string, start, end int) string {
+	from, to := max(start-snippetContext, 0), min(end+snippetContext, len(s))
+	for from > 0 && !utf8.RuneStart(s[from]) {
+		from--
+	}
+	for to < len(s) && !utf8.RuneStart(s[to]) {
+		to++
+	}
+	// Cut at whitespace rather than mid-word where there is some
+	if i := strings.IndexAny(s[from:start], " \t\n"); from > 0 && i >= 0 {
+		from += i + 1
+	}
+	if i := strings.LastIndexAny(s[end:to], " \t\n"); to < len(s) && i >= 0 {
+		to = end + i
+	}
+
+	text := strings.Join(strings.Fields(s[from:start]+"**"+s[start:end]+"**"+s[end:to]), " ")
+	if from > 0 {
+		text = "…" + text
+	}
+	if to < len(s) {
+		text += "…"
+	}
+	return text
+}
+
+// searchTagsLocally reads the session's tags, or the most recent
+// maxDeepSearchTags without a session, and matches them here. searched
+// and total say how many tags were read out of how many exist.
+func searchTagsLocally(ctx context.Context, c *videoplatform.Client, sessionID string, query *regexp.Regexp) (matches []tagSearchMatch, searched, total int, err error) {
+	var tags []videoplatform.Tag
+	if sessionID != "" {
+		if tags, err = c.ListAllTags(ctx, videoplatform.ListTagsParams{SessionID: sessionID}); err != nil {
+			return nil, 0, 0, err
+		}
+		total = len(tags)
+	} else {
+		resp, err := c.ListTags(ctx, videoplatform.ListTagsParams{Limit: maxDeepSearchTags})
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		tags, total = resp.Data, resp.Total
+	}
+
+	for _, tag := range tags {
+		if match, ok := matchTag(tag, query); ok {
+			matches = append(matches, match)
+		}
+	}
+	return matches, len(tags), total, nil
+}
+
+// tagSearchLine renders a match for the text output, e.g.
+// "- tag-1 (clip clip-1, Run) notes: …saw the **blitz** coming…"
+func tagSearchLine(m tagSearchMatch) string {
+	where := "clip " + m.ClipID
+	if m.PlayType != nil && *m.PlayType != "" {
+		where += ", " + *m.PlayType
+	}
+	return fmt.Sprintf("- %s (%s) %s: %s", m.ID, where, m.MatchedIn, m.Snippet)
+}
+
+func makeSearchTags(c *videoplatform.Client, defaultLimit int) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		q, _ := req.Params.Arguments["query"].(string)
+		if q = strings.TrimSpace(q); q == "" {
+			return mcp.NewToolResultError("query is required"), nil
+		}
+		sessionID, _ := req.Params.Arguments["session_id"].(string)
+		limit := defaultLimit
+		if n, ok := req.Params.Arguments["limit"].(float64); ok && n > 0 {
+			limit = int(n)
+		}
+		query := regexp.MustCompile("(?i)" + regexp.QuoteMeta(q))
+
+		// A platform without tag search rejects the parameter or ignores
+		// it, returning tags that don't match; either way the tags are
+		// searched here instead
+		var matches []tagSearchMatch
+		var notice string
+		total := 0
+		resp, err := c.ListTags(ctx, videoplatform.ListTagsParams{SessionID: sessionID, Search: q, Limit: limit})
+		searchedHere := err != nil && filterRejected(err)
+		if err != nil && !searchedHere {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to search tags: %v", err)), nil
+		}
+		if !searchedHere {
+			for _, tag := range resp.Data {
+				match, ok := matchTag(tag, query)
+				if !ok {
+					searchedHere = true
+					break
+				}
+				matches = append(matches, match)
+			}
+			total = resp.Total
+		}
+		if searchedHere {
+			var searched, scanned int
+			matches, searched, scanned, err = searchTagsLocally(ctx, c, sessionID, query)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to search tags: %v", err)), nil
+			}
+			total = len(matches)
+			notice = "The platform doesn't search tags, so their notes and labels were searched here."
+			if searched < scanned {
+				notice += fmt.Sprintf(" Only the most recent %d of %d tags were searched; pass session_id to search all of a session's tags.", searched, scanned)
+			}
+		}
+
+		if len(matches) == 0 {
+			return mcp.NewToolResultText(strings.TrimSpace(fmt.Sprintf("No tags match %q. %s", q, notice))), nil
+		}
+		if len(matches) > limit {
+			matches = matches[:limit]
+		}
+
+		lines := make([]string, len(matches))
+		for i, m := range matches {
+			lines[i] = tagSearchLine(m)
+		}
+		text := fmt.Sprintf("Found %d tags matching %q:\n%s", total, q, strings.Join(lines, "\n"))
+		if total > len(matches) {
+			text += fmt.Sprintf("\nShowing the first %d; increase limit to see more.", len(matches))
+		}
+		if notice != "" {
+			text += "\n" + notice
+		}
+		data, _ := json.MarshalIndent(matches, "", "  ")
+		return mcp.NewToolResultText(fmt.Sprintf("%s\n%s", text, data)), nil
+	}
+}
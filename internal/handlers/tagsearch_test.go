@@ -0,0 +1,104 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestSnippet(t *testing.T) {
+	long := "Corner bails at the snap and the safety rotates late, so the Blitz from the boundary side gets home untouched again"
+	tests := []struct {
+		s    string
+		find string
+		want string
+	}{
+		{"missed blitz pickup", "blitz", "missed **blitz** pickup"},
+		{"blitz-pickup", "blitz", "**blitz**-pickup"},
+		{"line one\nBLITZ\nline three", "blitz", "line one **BLITZ** line three"},
+		{long, "blitz", "…and the safety rotates late, so the **Blitz** from the boundary side gets home…"},
+		{"café blitz", "café", "**café** blitz"},
+	}
+	for _, tt := range tests {
+		start := strings.Index(strings.ToLower(tt.s), tt.find)
+		if got := snippet(tt.s, start, start+len(tt.find)); got != tt.want {
+			t.Errorf("snippet(%q, %q) = %q, want %q", tt.s, tt.find, got, tt.want)
+		}
+	}
+}
+
+func TestSearchTags(t *testing.T) {
+	strPtr := func(s string) *string { return &s }
+	tags := []videoplatform.Tag{
+		{ID: "tag-1", ClipID: "clip-1", PlayType: strPtr("Run"), Notes: strPtr("RT missed the Blitz pickup")},
+		{ID: "tag-2", ClipID: "clip-2", Notes: strPtr("Good seal block")},
+		{ID: "tag-3", ClipID: "clip-3", Labels: []string{"redzone", "blitz-pickup"}},
+	}
+	call := func(t *testing.T, handler http.HandlerFunc, args map[string]interface{}) string {
+		t.Helper()
+		server := mockServer(t, handler)
+		defer server.Close()
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = args
+		result, err := makeSearchTags(videoplatform.New(server.URL), 7)(context.Background(), req)
+		if err != nil || result.IsError {
+			t.Fatalf("Expected success, got %v %v", result, err)
+		}
+		return result.Content[0].(mcp.TextContent).Text
+	}
+	wantLines := "- tag-1 (clip clip-1, Run) notes: RT missed the **Blitz** pickup\n- tag-3 (clip clip-3) label: **blitz**-pickup"
+
+	t.Run("platform searches", func(t *testing.T) {
+		text := call(t, func(w http.ResponseWriter, r *http.Request) {
+			if q := r.URL.Query(); q.Get("search") != "blitz" || q.Get("session_id") != "session-1" || q.Get("limit") != "7" {
+				t.Errorf("Expected search, session_id and the configured limit in query, got %q", r.URL.RawQuery)
+			}
+			json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Tag]{Data: []videoplatform.Tag{tags[0], tags[2]}, Total: 2})
+		}, map[string]interface{}{"query": "blitz", "session_id": "session-1"})
+		if !strings.HasPrefix(text, "Found 2 tags matching \"blitz\":\n"+wantLines+"\n[") {
+			t.Errorf("Unexpected output:\n%s", text)
+		}
+		if strings.Contains(text, "searched here") {
+			t.Error("Expected no fallback notice when the platform searches")
+		}
+	})
+
+	t.Run("platform ignores search", func(t *testing.T) {
+		text := call(t, func(w http.ResponseWriter, r *http.Request) {
+			json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Tag]{Data: tags, Total: 3})
+		}, map[string]interface{}{"query": "BLITZ", "session_id": "session-1"})
+		if !strings.HasPrefix(text, "Found 2 tags matching \"BLITZ\":\n"+wantLines) || !strings.Contains(text, "notes and labels were searched here") {
+			t.Errorf("Unexpected output:\n%s", text)
+		}
+	})
+
+	t.Run("platform rejects search", func(t *testing.T) {
+		text := call(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Has("search") {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Tag]{Data: tags, Total: 1500})
+		}, map[string]interface{}{"query": "seal", "limit": float64(5)})
+		if !strings.HasPrefix(text, "Found 1 tags matching \"seal\":\n- tag-2 (clip clip-2) notes: Good **seal** block") {
+			t.Errorf("Unexpected output:\n%s", text)
+		}
+		if !strings.Contains(text, "Only the most recent 3 of 1500 tags were searched") {
+			t.Errorf("Expected a notice about the capped search, got:\n%s", text)
+		}
+	})
+
+	t.Run("no matches", func(t *testing.T) {
+		text := call(t, func(w http.ResponseWriter, r *http.Request) {
+			json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Tag]{})
+		}, map[string]interface{}{"query": "trick play"})
+		if text != `No tags match "trick play".` {
+			t.Errorf("Unexpected output: %s", text)
+		}
+	})
+}
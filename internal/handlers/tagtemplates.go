@@ -0,0 +1,240 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// tagTemplatesFile is the name of the template file inside the data
+// directory
+const tagTemplatesFile = "tag_templates.json"
+
+// tagTemplateFields are the create_tag arguments a template can hold. The
+// clip, session and game clock belong to a single play, so they're always
+// passed to create_tag directly.
+var tagTemplateFields = []string{"play_type", "formation", "result", "down", "distance", "yards_gained", "quarter", "labels", "notes"}
+
+// errNoDataDir is returned by template tools when the server has nowhere to
+// keep templates
+var errNoDataDir = errors.New("tag templates are stored under -data-dir; restart the server with -data-dir set to use them")
+
+// tagTemplate is a named set of create_tag fields. Fields hold argument
+// values as they arrive from the client, numbers as float64.
+type tagTemplate struct {
+	Name      string                 `json:"name"`
+	Fields    map[string]interface{} `json:"fields"`
+	UpdatedAt time.Time              `json:"updated_at"`
+}
+
+// tagTemplateStore keeps tag templates in a JSON file. The mutex covers
+// each read-modify-write of the file, so concurrent saves don't drop each
+// other's templates.
+type tagTemplateStore struct {
+	path string
+	now  func() time.Time
+
+	mu sync.Mutex
+}
+
+// openTagTemplates returns the template store under dir, or nil when dir
+// is empty and templates are unavailable
+func openTagTemplates(dir string) *tagTemplateStore {
+	if dir == "" {
+		return nil
+	}
+	return &tagTemplateStore{path: filepath.Join(dir, tagTemplatesFile), now: time.Now}
+}
+
+// List returns every template, by name
+func (s *tagTemplateStore) List() ([]tagTemplate, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.load()
+}
+
+// Get returns the template with the given name, ignoring case
+func (s *tagTemplateStore) Get(name string) (tagTemplate, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	templates, err := s.load()
+	if err != nil {
+		return tagTemplate{}, false, err
+	}
+	for _, t := range templates {
+		if strings.EqualFold(t.Name, name) {
+			return t, true, nil
+		}
+	}
+	return tagTemplate{}, false, nil
+}
+
+// Save stores a template, replacing any with the same name ignoring case.
+// replaced says whether one was.
+func (s *tagTemplateStore) Save(name string, fields map[string]interface{}) (saved tagTemplate, replaced bool, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	templates, err := s.load()
+	if err != nil {
+		return tagTemplate{}, false, err
+	}
+
+	saved = tagTemplate{Name: name, Fields: fields, UpdatedAt: s.now().UTC()}
+	kept := templates[:0]
+	for _, t := range templates {
+		if strings.EqualFold(t.Name, name) {
+			replaced = true
+			continue
+		}
+		kept = append(kept, t)
+	}
+	templates = append(kept, saved)
+	sort.Slice(templates, func(i, j int) bool {
+		return strings.ToLower(templates[i].Name) < strings.ToLower(templates[j].Name)
+	})
+	return saved, replaced, s.save(templates)
+}
+
+// load reads the template file; a missing file holds no templates. Unlike
+// the index, templates can't be rebuilt, so an unreadable file is an error
+// rather than silently emptied.
+func (s *tagTemplateStore) load() ([]tagTemplate, error) {
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var templates []tagTemplate
+	if err := json.Unmarshal(data, &templates); err != nil {
+		return nil, fmt.Errorf("read %s: %w", s.path, err)
+	}
+	return templates, nil
+}
+
+// save writes the templates atomically so a crash never leaves a torn file
+func (s *tagTemplateStore) save(templates []tagTemplate) error {
+	dir := filepath.Dir(s.path)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(templates, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(dir, tagTemplatesFile+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.path)
+}
+
+// withTemplate lays args over a template's fields, so anything passed to
+// create_tag directly wins. An explicit game_clock sets the quarter, so it
+// also displaces the template's quarter.
+func withTemplate(t tagTemplate, args map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(t.Fields)+len(args))
+	for k, v := range t.Fields {
+		merged[k] = v
+	}
+	if _, ok := args["game_clock"]; ok {
+		delete(merged, "quarter")
+	}
+	for k, v := range args {
+		if k != "template" {
+			merged[k] = v
+		}
+	}
+	return merged
+}
+
+// templateArgs picks the template fields out of save_tag_template's
+// arguments and checks them as create_tag would
+func templateArgs(args map[string]interface{}) (map[string]interface{}, error) {
+	fields := make(map[string]interface{})
+	for _, key := range tagTemplateFields {
+		if v, ok := args[key]; ok && v != nil {
+			fields[key] = v
+		}
+	}
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("Nothing to save: pass at least one of %s", strings.Join(tagTemplateFields, ", "))
+	}
+	if err := checkPlayFields(fields); err != nil {
+		return nil, err
+	}
+	if labels, ok, err := labelsArg(fields); err != nil {
+		return nil, err
+	} else if ok {
+		fields["labels"] = labels
+	}
+	return fields, nil
+}
+
+func makeSaveTagTemplate(store *tagTemplateStore) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if store == nil {
+			return mcp.NewToolResultError(errNoDataDir.Error()), nil
+		}
+		name, _ := req.Params.Arguments["name"].(string)
+		if name = strings.TrimSpace(name); name == "" {
+			return mcp.NewToolResultError("name is required"), nil
+		}
+		fields, err := templateArgs(req.Params.Arguments)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		saved, replaced, err := store.Save(name, fields)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to save tag template: %v", err)), nil
+		}
+
+		verb := "saved"
+		if replaced {
+			verb = "replaced"
+		}
+		data, _ := json.MarshalIndent(saved, "", "  ")
+		return mcp.NewToolResultText(fmt.Sprintf("Tag template %q %s; pass template: %q to create_tag to use it:\n%s", saved.Name, verb, saved.Name, data)), nil
+	}
+}
+
+func makeListTagTemplates(store *tagTemplateStore) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if store == nil {
+			return mcp.NewToolResultError(errNoDataDir.Error()), nil
+		}
+		templates, err := store.List()
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list tag templates: %v", err)), nil
+		}
+		if len(templates) == 0 {
+			return mcp.NewToolResultText("No tag templates yet; save one with save_tag_template"), nil
+		}
+
+		data, _ := json.MarshalIndent(templates, "", "  ")
+		return mcp.NewToolResultText(fmt.Sprintf("%d tag templates:\n%s", len(templates), data)), nil
+	}
+}
@@ -0,0 +1,200 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/Prodro21/video-mcp/internal/config"
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestTagTemplateStore(t *testing.T) {
+	dir := t.TempDir()
+	store := openTagTemplates(dir)
+
+	if _, replaced, err := store.Save("Punt Fair Catch", map[string]interface{}{"play_type": "Punt"}); err != nil || replaced {
+		t.Fatalf("Save() = replaced %v, err %v", replaced, err)
+	}
+	if _, replaced, err := store.Save("punt fair catch", map[string]interface{}{"play_type": "Punt", "result": "fair catch"}); err != nil || !replaced {
+		t.Fatalf("Save() of an existing name = replaced %v, err %v", replaced, err)
+	}
+	if _, _, err := store.Save("Inside Zone", map[string]interface{}{"play_type": "Run"}); err != nil {
+		t.Fatalf("Save() unexpected error: %v", err)
+	}
+
+	// A fresh store reads what the first one wrote
+	templates, err := openTagTemplates(dir).List()
+	if err != nil {
+		t.Fatalf("List() unexpected error: %v", err)
+	}
+	var names []string
+	for _, tmpl := range templates {
+		names = append(names, tmpl.Name)
+	}
+	if want := []string{"Inside Zone", "punt fair catch"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("List() names = %v, want %v", names, want)
+	}
+
+	tmpl, ok, err := store.Get("PUNT FAIR CATCH")
+	if err != nil || !ok || tmpl.Fields["result"] != "fair catch" {
+		t.Errorf("Get() = %+v, %v, %v", tmpl, ok, err)
+	}
+	if _, ok, err := store.Get("onside kick"); ok || err != nil {
+		t.Errorf("Get() of an unknown name = %v, %v", ok, err)
+	}
+
+	if openTagTemplates("") != nil {
+		t.Error("Expected no store without a data dir")
+	}
+}
+
+func TestTagTemplateStore_ConcurrentSaves(t *testing.T) {
+	store := openTagTemplates(t.TempDir())
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if _, _, err := store.Save(fmt.Sprintf("template-%d", i), map[string]interface{}{"down": float64(1)}); err != nil {
+				t.Errorf("Save() unexpected error: %v", err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	templates, err := store.List()
+	if err != nil || len(templates) != 10 {
+		t.Errorf("Expected all 10 templates kept, got %d (%v)", len(templates), err)
+	}
+}
+
+func TestTagTemplateStore_Corrupt(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, tagTemplatesFile), []byte("{not json"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	store := openTagTemplates(dir)
+	if _, err := store.List(); err == nil {
+		t.Error("Expected an error for an unreadable template file")
+	}
+	if _, _, err := store.Save("Punt", map[string]interface{}{"play_type": "Punt"}); err == nil {
+		t.Error("Expected Save() not to overwrite an unreadable template file")
+	}
+}
+
+func TestSaveTagTemplate(t *testing.T) {
+	store := openTagTemplates(t.TempDir())
+	call := func(store *tagTemplateStore, args map[string]interface{}) *mcp.CallToolResult {
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = args
+		result, err := makeSaveTagTemplate(store)(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return result
+	}
+
+	result := call(store, map[string]interface{}{
+		"name":       " Punt Fair Catch ",
+		"play_type":  "Punt",
+		"formation":  "Spread Punt",
+		"result":     "fair catch",
+		"labels":     []interface{}{"special teams"},
+		"clip_id":    "clip-1",
+		"game_clock": "Q2 3:00",
+	})
+	if text := result.Content[0].(mcp.TextContent).Text; result.IsError || !strings.HasPrefix(text, `Tag template "Punt Fair Catch" saved`) {
+		t.Fatalf("Expected the template saved, got %s", text)
+	}
+	saved, _, _ := store.Get("punt fair catch")
+	if _, ok := saved.Fields["clip_id"]; ok || len(saved.Fields) != 4 {
+		t.Errorf("Expected only the play fields saved, got %v", saved.Fields)
+	}
+
+	verifyError(t, call(store, map[string]interface{}{"play_type": "Punt"}), "name is required")
+	verifyError(t, call(store, map[string]interface{}{"name": "Empty", "clip_id": "clip-1"}), "Nothing to save")
+	verifyError(t, call(store, map[string]interface{}{"name": "Bad", "down": float64(5)}), "down must be a whole number from 1 to 4")
+	verifyError(t, call(nil, map[string]interface{}{"name": "Punt", "play_type": "Punt"}), "-data-dir")
+}
+
+func TestCreateTag_Template(t *testing.T) {
+	store := openTagTemplates(t.TempDir())
+	if _, _, err := store.Save("Punt Fair Catch", map[string]interface{}{
+		"play_type": "Punt",
+		"formation": "Spread Punt",
+		"result":    "fair catch",
+		"quarter":   float64(2),
+		"labels":    []string{"special teams"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	var body videoplatform.CreateTagRequest
+	srv := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		body = videoplatform.CreateTagRequest{}
+		json.NewDecoder(r.Body).Decode(&body)
+		json.NewEncoder(w).Encode(videoplatform.Tag{ID: "tag-1"})
+	})
+	defer srv.Close()
+
+	handler := makeCreateTag(videoplatform.New(srv.URL), config.DefaultOvertimeLength, store)
+	call := func(args map[string]interface{}) *mcp.CallToolResult {
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = args
+		result, err := handler(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return result
+	}
+	base := func(extra map[string]interface{}) map[string]interface{} {
+		args := map[string]interface{}{"clip_id": "clip-1", "session_id": "session-1", "template": "punt fair catch"}
+		for k, v := range extra {
+			args[k] = v
+		}
+		return args
+	}
+
+	t.Run("template fields", func(t *testing.T) {
+		if result := call(base(nil)); result.IsError {
+			t.Fatalf("Expected success, got %v", result.Content)
+		}
+		if *body.PlayType != "Punt" || *body.Formation != "Spread Punt" || *body.Result != "fair catch" || *body.Quarter != 2 ||
+			!reflect.DeepEqual(body.Labels, []string{"special teams"}) {
+			t.Errorf("Expected the template's fields, got %+v", body)
+		}
+	})
+
+	t.Run("explicit arguments override", func(t *testing.T) {
+		result := call(base(map[string]interface{}{"result": "muffed", "labels": []interface{}{"turnover"}, "game_clock": "Q4 1:30"}))
+		if result.IsError {
+			t.Fatalf("Expected success, got %v", result.Content)
+		}
+		if *body.PlayType != "Punt" || *body.Result != "muffed" || *body.Quarter != 4 {
+			t.Errorf("Expected explicit result and clock over the template, got %+v", body)
+		}
+		if !reflect.DeepEqual(body.Labels, []string{"turnover", "clock:01:30"}) {
+			t.Errorf("Expected explicit labels over the template's, got %v", body.Labels)
+		}
+	})
+
+	t.Run("unknown template", func(t *testing.T) {
+		verifyError(t, call(base(map[string]interface{}{"template": "onside kick"})), `Tag template "onside kick" not found`)
+	})
+
+	t.Run("no data dir", func(t *testing.T) {
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = base(nil)
+		result, _ := makeCreateTag(videoplatform.New(srv.URL), config.DefaultOvertimeLength, nil)(context.Background(), req)
+		verifyError(t, result, "-data-dir")
+	})
+}
@@ -0,0 +1,151 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// timelineTag is the play detail of one tag on a timeline entry
+type timelineTag struct {
+	ID        string  `json:"id"`
+	GameClock string  `json:"game_clock,omitempty"`
+	Down      *int    `json:"down,omitempty"`
+	Distance  *int    `json:"distance,omitempty"`
+	PlayType  *string `json:"play_type,omitempty"`
+	Result    *string `json:"result,omitempty"`
+}
+
+// timelineEntry is one clip of a session timeline
+type timelineEntry struct {
+	ClipID          string        `json:"clip_id"`
+	Title           *string       `json:"title,omitempty"`
+	StartTime       string        `json:"start_time"`
+	EndTime         string        `json:"end_time"`
+	DurationSeconds float64       `json:"duration_seconds"`
+	Untagged        bool          `json:"untagged,omitempty"`
+	Tags            []timelineTag `json:"tags,omitempty"`
+}
+
+// sessionTimeline is the result of session_timeline. UnmatchedTags counts
+// tags whose clip isn't among the session's clips.
+type sessionTimeline struct {
+	SessionID     string          `json:"session_id"`
+	Clips         int             `json:"clips"`
+	Untagged      int             `json:"untagged"`
+	UnmatchedTags int             `json:"unmatched_tags,omitempty"`
+	Entries       []timelineEntry `json:"entries"`
+}
+
+// tagGameClock renders a tag's quarter and clock as "Q3 04:12", or just
+// the quarter when the tag has no clock
+func tagGameClock(tag videoplatform.Tag) string {
+	if tag.Quarter == nil {
+		return ""
+	}
+	if clock, ok := tagClock(tag); ok {
+		return quarterName(*tag.Quarter) + " " + formatClock(clock)
+	}
+	return quarterName(*tag.Quarter)
+}
+
+// sortByStartTime orders clips by start time. Clips whose start time can't
+// be parsed keep their relative order after the ones that can.
+func sortByStartTime(clips []videoplatform.Clip) {
+	start := make(map[string]time.Time, len(clips))
+	for _, clip := range clips {
+		if t, err := time.Parse(time.RFC3339, clip.StartTime); err == nil {
+			start[clip.ID] = t
+		}
+	}
+	sort.SliceStable(clips, func(i, j int) bool {
+		ti, iok := start[clips[i].ID]
+		tj, jok := start[clips[j].ID]
+		if !iok || !jok {
+			return iok && !jok
+		}
+		return ti.Before(tj)
+	})
+}
+
+// buildTimeline joins tags to their clips and orders the clips by start
+// time; tags on the same clip are ordered by game clock
+func buildTimeline(sessionID string, clips []videoplatform.Clip, tags []videoplatform.Tag, onlyTagged bool) sessionTimeline {
+	byClip := make(map[string][]videoplatform.Tag)
+	for _, tag := range tags {
+		byClip[tag.ClipID] = append(byClip[tag.ClipID], tag)
+	}
+
+	clips = append([]videoplatform.Clip(nil), clips...)
+	sortByStartTime(clips)
+
+	timeline := sessionTimeline{SessionID: sessionID, Clips: len(clips), Entries: []timelineEntry{}}
+	matched := 0
+	for _, clip := range clips {
+		clipTags := byClip[clip.ID]
+		matched += len(clipTags)
+		if len(clipTags) == 0 {
+			timeline.Untagged++
+			if onlyTagged {
+				continue
+			}
+		}
+
+		entry := timelineEntry{
+			ClipID:          clip.ID,
+			Title:           clip.Title,
+			StartTime:       clip.StartTime,
+			EndTime:         clip.EndTime,
+			DurationSeconds: clip.DurationSeconds,
+			Untagged:        len(clipTags) == 0,
+		}
+		sortByGameClock(clipTags)
+		for _, tag := range clipTags {
+			entry.Tags = append(entry.Tags, timelineTag{
+				ID:        tag.ID,
+				GameClock: tagGameClock(tag),
+				Down:      tag.Down,
+				Distance:  tag.Distance,
+				PlayType:  tag.PlayType,
+				Result:    tag.Result,
+			})
+		}
+		timeline.Entries = append(timeline.Entries, entry)
+	}
+	timeline.UnmatchedTags = len(tags) - matched
+	return timeline
+}
+
+func makeSessionTimeline(c *videoplatform.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		sessionID, _ := req.Params.Arguments["session_id"].(string)
+		if sessionID == "" {
+			return mcp.NewToolResultError("session_id is required"), nil
+		}
+		onlyTagged, _ := req.Params.Arguments["only_tagged"].(bool)
+
+		d, err := fetchSessionData(ctx, c, sessionID)
+		if err != nil {
+			if apiStatus(err) == http.StatusNotFound {
+				return mcp.NewToolResultError(fmt.Sprintf("Session %s not found", sessionID)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get session: %v", err)), nil
+		}
+		if d.ClipsErr != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list clips: %v", d.ClipsErr)), nil
+		}
+		if d.TagsErr != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list tags: %v", d.TagsErr)), nil
+		}
+
+		data, _ := json.MarshalIndent(buildTimeline(sessionID, d.Clips, d.Tags, onlyTagged), "", "  ")
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
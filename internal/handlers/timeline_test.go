@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestBuildTimeline(t *testing.T) {
+	q1, q2 := 1, 2
+	down, distance := 3, 7
+	pass, complete := "pass", "complete"
+	clips := []videoplatform.Clip{
+		{ID: "c-3", StartTime: "2026-10-17T19:20:00Z"},
+		{ID: "c-bad", StartTime: "unknown"},
+		{ID: "c-1", StartTime: "2026-10-17T19:00:00Z", DurationSeconds: 8},
+		{ID: "c-2", StartTime: "2026-10-17T15:10:00-04:00"},
+	}
+	tags := []videoplatform.Tag{
+		{ID: "t-late", ClipID: "c-1", Quarter: &q1, Labels: []string{"clock:02:00"}},
+		{ID: "t-early", ClipID: "c-1", Quarter: &q1, Labels: []string{"clock:14:00"}, Down: &down, Distance: &distance, PlayType: &pass, Result: &complete},
+		{ID: "t-3", ClipID: "c-3", Quarter: &q2},
+		{ID: "t-orphan", ClipID: "c-gone"},
+	}
+
+	timeline := buildTimeline("s-1", clips, tags, false)
+
+	var order []string
+	for _, e := range timeline.Entries {
+		order = append(order, e.ClipID)
+	}
+	if want := []string{"c-1", "c-2", "c-3", "c-bad"}; !reflect.DeepEqual(order, want) {
+		t.Errorf("clip order = %v, want %v", order, want)
+	}
+	if timeline.Clips != 4 || timeline.Untagged != 2 || timeline.UnmatchedTags != 1 {
+		t.Errorf("clips %d, untagged %d, unmatched %d; want 4, 2, 1", timeline.Clips, timeline.Untagged, timeline.UnmatchedTags)
+	}
+
+	first := timeline.Entries[0]
+	if first.Untagged || len(first.Tags) != 2 || first.Tags[0].ID != "t-early" || first.Tags[1].ID != "t-late" {
+		t.Fatalf("first entry = %+v, want t-early then t-late", first)
+	}
+	if tag := first.Tags[0]; tag.GameClock != "Q1 14:00" || *tag.Down != 3 || *tag.Distance != 7 || *tag.PlayType != "pass" || *tag.Result != "complete" {
+		t.Errorf("first tag = %+v", tag)
+	}
+	if first.DurationSeconds != 8 {
+		t.Errorf("DurationSeconds = %v, want 8", first.DurationSeconds)
+	}
+	if !timeline.Entries[1].Untagged || timeline.Entries[2].Tags[0].GameClock != "Q2" {
+		t.Errorf("entries = %+v", timeline.Entries[1:3])
+	}
+
+	tagged := buildTimeline("s-1", clips, tags, true)
+	if len(tagged.Entries) != 2 || tagged.Entries[0].ClipID != "c-1" || tagged.Entries[1].ClipID != "c-3" {
+		t.Errorf("only_tagged entries = %+v, want c-1 and c-3", tagged.Entries)
+	}
+	if tagged.Untagged != 2 {
+		t.Errorf("only_tagged Untagged = %d, want 2", tagged.Untagged)
+	}
+}
+
+func TestSessionTimeline(t *testing.T) {
+	clips := []videoplatform.Clip{{ID: "c-2", StartTime: "2026-10-17T19:05:00Z"}, {ID: "c-1", StartTime: "2026-10-17T19:00:00Z"}}
+	tags := []videoplatform.Tag{{ID: "t-1", ClipID: "c-1"}}
+	c := bundlePlatform(t, clips, tags)
+	handler := makeSessionTimeline(c)
+
+	t.Run("timeline", func(t *testing.T) {
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"session_id": "s-1"}
+		result, err := handler(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result.IsError {
+			t.Fatalf("Unexpected tool error: %v", result.Content)
+		}
+		var got sessionTimeline
+		if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &got); err != nil {
+			t.Fatalf("result is not JSON: %v", err)
+		}
+		if len(got.Entries) != 2 || got.Entries[0].ClipID != "c-1" || !got.Entries[1].Untagged {
+			t.Errorf("entries = %+v", got.Entries)
+		}
+	})
+
+	t.Run("session not found", func(t *testing.T) {
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"session_id": "missing"}
+		result, _ := handler(context.Background(), req)
+		verifyError(t, result, "Session missing not found")
+	})
+
+	t.Run("tags fail", func(t *testing.T) {
+		server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/api/v1/sessions/s-1":
+				json.NewEncoder(w).Encode(videoplatform.Session{ID: "s-1"})
+			case "/api/v1/tags":
+				w.WriteHeader(http.StatusInternalServerError)
+			default:
+				json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Clip]{})
+			}
+		})
+		defer server.Close()
+
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"session_id": "s-1"}
+		result, _ := makeSessionTimeline(videoplatform.New(server.URL))(context.Background(), req)
+		verifyError(t, result, "Failed to list tags")
+	})
+}
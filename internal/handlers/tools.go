@@ -3,41 +3,135 @@ package handlers
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
 
-	"github.com/Prodro21/video-mcp/internal/client"
+	"github.com/Prodro21/video-mcp/internal/config"
+	"github.com/Prodro21/video-mcp/internal/index"
+	"github.com/Prodro21/video-mcp/internal/stats"
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 )
 
-// RegisterTools adds all tool handlers to the server
-func RegisterTools(s *server.MCPServer, c *client.Client) {
+// RegisterTools adds all tool handlers to the server and returns the
+// registry that wraps them
+func RegisterTools(s *server.MCPServer, c *videoplatform.Client, cfg *config.Config) *Registry {
+	r := newRegistry(s)
+	r.setConcurrencyLimit(cfg.MaxConcurrentTools)
+	if cfg.ToolCacheTTL > 0 {
+		r.cache = newToolCache(cfg.ToolCacheTTL)
+	}
+	r.digests = newDigestStore()
+	if cfg.Debug {
+		r.logger = log.Default()
+	}
+	locks := newSessionLocks(c)
+	p := newPresenter(cfg.NoEmoji)
+	idx := index.Open(c, cfg.DataDir, cfg.IndexMaxAge)
+	templates := openTagTemplates(cfg.DataDir)
+
 	// Session tools
-	s.AddTool(mcp.Tool{
+	r.addReadOnlyTool(mcp.Tool{
 		Name:        "list_sessions",
-		Description: "List recording sessions with optional filters",
+		Description: "List recording sessions with optional filters. search matches session name and opponent.",
 		InputSchema: mcp.ToolInputSchema{
 			Type: "object",
 			Properties: map[string]interface{}{
 				"status": map[string]interface{}{
 					"type":        "string",
 					"description": "Filter by status",
-					"enum":        []string{"scheduled", "active", "paused", "completed", "archived"},
+					"enum":        enumValues(videoplatform.SessionStatuses),
+				},
+				"session_type": sessionTypeSchema("Filter by session type", cfg.AllowedSessionTypes),
+				"limit": map[string]interface{}{
+					"type":        "integer",
+					"description": fmt.Sprintf("Maximum results (default %d)", cfg.ToolLimit("list_sessions")),
+				},
+				"offset": map[string]interface{}{
+					"type":        "integer",
+					"description": "Number of sessions to skip, for paging (default 0)",
 				},
-				"session_type": map[string]interface{}{
+				"search": map[string]interface{}{
 					"type":        "string",
-					"description": "Filter by session type",
-					"enum":        []string{"game", "practice", "scrimmage", "training", "other"},
+					"description": "Search sessions by name or opponent",
 				},
-				"limit": map[string]interface{}{
+				"opponent": map[string]interface{}{
+					"type":        "string",
+					"description": "Only sessions against this opponent",
+				},
+				"sort": map[string]interface{}{
+					"type":        "string",
+					"description": "Order of results; prefix - for descending (e.g. -created_at for most recent first)",
+					"enum":        sessionSortKeys,
+				},
+			},
+		},
+	}, makeListSessions(c, cfg.ToolLimit("list_sessions"), cfg.AllowedSessionTypes, p))
+
+	r.addReadOnlyTool(mcp.Tool{
+		Name:        "find_session",
+		Description: "Find sessions whose name or opponent matches a search term",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"query": map[string]interface{}{
+					"type":        "string",
+					"description": "Text to match against session name or opponent (case-insensitive)",
+				},
+			},
+			Required: []string{"query"},
+		},
+	}, makeFindSession(c, idx))
+
+	r.addReadOnlyTool(mcp.Tool{
+		Name:        "get_active_session",
+		Description: "Get the session currently recording. If several sessions are active, lists them so the user can pick one.",
+		InputSchema: mcp.ToolInputSchema{
+			Type:       "object",
+			Properties: map[string]interface{}{},
+		},
+	}, makeGetActiveSession(c))
+
+	r.addReadOnlyTool(mcp.Tool{
+		Name:        "recent_sessions",
+		Description: "List sessions in a relative time window, newest first, without computing dates. today and yesterday are calendar days in the server's time zone.",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"window": map[string]interface{}{
+					"type":        "string",
+					"description": "Time window",
+					"enum":        recentWindows,
+				},
+				"session_type": sessionTypeSchema("Filter by session type", cfg.AllowedSessionTypes),
+			},
+			Required: []string{"window"},
+		},
+	}, makeRecentSessions(c, cfg.AllowedSessionTypes))
+
+	r.addReadOnlyTool(mcp.Tool{
+		Name:        "upcoming_sessions",
+		Description: "List scheduled sessions split into overdue (scheduled_start has passed), upcoming within the next N days, and those with no scheduled_start",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"days": map[string]interface{}{
 					"type":        "integer",
-					"description": "Maximum results (default 20)",
+					"description": "How many days ahead to list (default 7, max 365)",
 				},
 			},
 		},
-	}, makeListSessions(c))
+	}, makeUpcomingSessions(c))
 
-	s.AddTool(mcp.Tool{
+	r.addTool(mcp.Tool{
 		Name:        "create_session",
 		Description: "Create a new recording session",
 		InputSchema: mcp.ToolInputSchema{
@@ -47,27 +141,114 @@ func RegisterTools(s *server.MCPServer, c *client.Client) {
 					"type":        "string",
 					"description": "Session name",
 				},
-				"session_type": map[string]interface{}{
+				"session_type": sessionTypeSchema("Type of session", cfg.AllowedSessionTypes),
+				"opponent":     opponentSchema,
+				"location": map[string]interface{}{
+					"type":        "string",
+					"description": "Location of the session",
+				},
+				"scheduled_start": scheduledStartSchema,
+				"notes": map[string]interface{}{
 					"type":        "string",
-					"description": "Type of session",
-					"enum":        []string{"game", "practice", "scrimmage", "training", "other"},
+					"description": "Free-text notes, e.g. \"second-string offense, backup QB\"",
 				},
-				"opponent": map[string]interface{}{
+			},
+			Required: []string{"name", "session_type"},
+		},
+	}, makeCreateSession(c, cfg.AllowedSessionTypes, idx))
+
+	r.addTool(mcp.Tool{
+		Name:        "clone_session",
+		Description: "Create a new session from an existing one, copying its session type, opponent and location unless overridden. The schedule isn't copied; pass scheduled_start to set one.",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"source_session_id": map[string]interface{}{
+					"type":        "string",
+					"description": "ID of the session to copy",
+				},
+				"name": map[string]interface{}{
+					"type":        "string",
+					"description": "Name of the new session (default: the source's name)",
+				},
+				"opponent": opponentSchema,
+				"location": map[string]interface{}{
+					"type":        "string",
+					"description": "Location of the new session",
+				},
+				"scheduled_start": scheduledStartSchema,
+			},
+			Required: []string{"source_session_id"},
+		},
+	}, makeCloneSession(c, idx))
+
+	r.addTool(mcp.Tool{
+		Name:        "update_session",
+		Description: "Edit a session's metadata; only the fields provided are changed",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"session_id": map[string]interface{}{
+					"type":        "string",
+					"description": "ID of the session to update",
+				},
+				"name": map[string]interface{}{
+					"type":        "string",
+					"description": "Session name",
+				},
+				"session_type": sessionTypeSchema("Type of session", cfg.AllowedSessionTypes),
+				"opponent":     opponentSchema,
+				"location": map[string]interface{}{
+					"type":        "string",
+					"description": "Location of the session",
+				},
+				"scheduled_start": scheduledStartSchema,
+				"notes": map[string]interface{}{
+					"type":        "string",
+					"description": "Free-text notes; replaces the existing notes, and an empty string clears them",
+				},
+				"override_lock": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Proceed even if the session is locked",
+				},
+			},
+			Required: []string{"session_id"},
+		},
+	}, makeUpdateSession(c, cfg.AllowedSessionTypes, locks, idx))
+
+	r.addTool(mcp.Tool{
+		Name:        "quick_start_session",
+		Description: "Create a session, activate its channels and start recording in one step, reporting each step",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"name": map[string]interface{}{
 					"type":        "string",
-					"description": "Opponent name (for games)",
+					"description": "Session name",
 				},
+				"session_type": sessionTypeSchema("Type of session", cfg.AllowedSessionTypes),
+				"opponent":     opponentSchema,
 				"location": map[string]interface{}{
 					"type":        "string",
 					"description": "Location of the session",
 				},
+				"channel_ids": map[string]interface{}{
+					"type":        "array",
+					"description": "Channels to activate before starting",
+					"items":       map[string]interface{}{"type": "string"},
+				},
+				"activate_all_channels": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Activate every currently inactive channel instead of channel_ids",
+				},
 			},
 			Required: []string{"name", "session_type"},
 		},
-	}, makeCreateSession(c))
+	}, makeQuickStartSession(c, cfg.AllowedSessionTypes, idx))
 
-	s.AddTool(mcp.Tool{
+	r.addTool(mcp.Tool{
 		Name:        "start_session",
-		Description: "Start a scheduled session to begin recording",
+		Description: "Start a scheduled session to begin recording. Without channel_ids it records from whichever channels are active.",
 		InputSchema: mcp.ToolInputSchema{
 			Type: "object",
 			Properties: map[string]interface{}{
@@ -75,12 +256,21 @@ func RegisterTools(s *server.MCPServer, c *client.Client) {
 					"type":        "string",
 					"description": "ID of the session to start",
 				},
+				"channel_ids": map[string]interface{}{
+					"type":        "array",
+					"items":       map[string]interface{}{"type": "string"},
+					"description": "Channels to record from; they are activated first, and the session isn't started if any fails to activate",
+				},
+				"exclusive": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Also deactivate every channel not in channel_ids",
+				},
 			},
 			Required: []string{"session_id"},
 		},
 	}, makeStartSession(c))
 
-	s.AddTool(mcp.Tool{
+	r.addTool(mcp.Tool{
 		Name:        "pause_session",
 		Description: "Pause an active recording session",
 		InputSchema: mcp.ToolInputSchema{
@@ -90,14 +280,37 @@ func RegisterTools(s *server.MCPServer, c *client.Client) {
 					"type":        "string",
 					"description": "ID of the session to pause",
 				},
+				"override_lock": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Proceed even if the session is locked",
+				},
+			},
+			Required: []string{"session_id"},
+		},
+	}, makePauseSession(c, locks))
+
+	r.addTool(mcp.Tool{
+		Name:        "resume_session",
+		Description: "Resume recording on a paused session",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"session_id": map[string]interface{}{
+					"type":        "string",
+					"description": "ID of the session to resume",
+				},
+				"override_lock": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Proceed even if the session is locked",
+				},
 			},
 			Required: []string{"session_id"},
 		},
-	}, makePauseSession(c))
+	}, makeResumeSession(c, locks))
 
-	s.AddTool(mcp.Tool{
+	r.addTool(mcp.Tool{
 		Name:        "complete_session",
-		Description: "Complete and finalize a recording session",
+		Description: "Complete and finalize a recording session, optionally recording the final score",
 		InputSchema: mcp.ToolInputSchema{
 			Type: "object",
 			Properties: map[string]interface{}{
@@ -105,350 +318,3127 @@ func RegisterTools(s *server.MCPServer, c *client.Client) {
 					"type":        "string",
 					"description": "ID of the session to complete",
 				},
+				"our_score": map[string]interface{}{
+					"type":        "integer",
+					"description": "Final score of our team",
+				},
+				"their_score": map[string]interface{}{
+					"type":        "integer",
+					"description": "Final score of the opponent",
+				},
+				"result": map[string]interface{}{
+					"type":        "string",
+					"description": "Outcome of the game (e.g. win, loss, tie)",
+				},
+				"notes": map[string]interface{}{
+					"type":        "string",
+					"description": "Notes on the game",
+				},
+				"override_lock": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Proceed even if the session is locked",
+				},
 			},
 			Required: []string{"session_id"},
 		},
-	}, makeCompleteSession(c))
+	}, makeCompleteSession(c, locks))
 
-	// Clip tools
-	s.AddTool(mcp.Tool{
-		Name:        "list_clips",
-		Description: "List video clips with optional filters",
+	r.addTool(mcp.Tool{
+		Name:        "cancel_session",
+		Description: "Cancel a scheduled session that will not take place (e.g. a rained-out game)",
 		InputSchema: mcp.ToolInputSchema{
 			Type: "object",
 			Properties: map[string]interface{}{
 				"session_id": map[string]interface{}{
 					"type":        "string",
-					"description": "Filter by session ID",
+					"description": "ID of the scheduled session to cancel",
 				},
-				"status": map[string]interface{}{
+				"reason": map[string]interface{}{
 					"type":        "string",
-					"description": "Filter by clip status",
-					"enum":        []string{"pending", "processing", "ready", "failed"},
+					"description": "Why the session was cancelled",
 				},
-				"favorite": map[string]interface{}{
+				"override_lock": map[string]interface{}{
 					"type":        "boolean",
-					"description": "Filter by favorite status",
+					"description": "Proceed even if the session is locked",
 				},
-				"search": map[string]interface{}{
+			},
+			Required: []string{"session_id"},
+		},
+	}, makeCancelSession(c, locks))
+
+	r.addTool(mcp.Tool{
+		Name:        "archive_session",
+		Description: "Archive a completed or cancelled session to move it out of the main session list",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"session_id": map[string]interface{}{
 					"type":        "string",
-					"description": "Search clips by title",
+					"description": "ID of the session to archive",
 				},
-				"limit": map[string]interface{}{
-					"type":        "integer",
-					"description": "Maximum results (default 20)",
+				"override_lock": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Proceed even if the session is locked",
 				},
 			},
+			Required: []string{"session_id"},
 		},
-	}, makeListClips(c))
+	}, makeArchiveSession(c, locks))
 
-	s.AddTool(mcp.Tool{
-		Name:        "favorite_clip",
-		Description: "Toggle favorite status on a clip",
+	r.addUncachedPollingTool(mcp.Tool{
+		Name:        "wait_for_session_status",
+		Description: "Wait until a session reaches a status, e.g. active after start_session while channels spin up. Polls every couple of seconds and fails with the last observed status on timeout.",
 		InputSchema: mcp.ToolInputSchema{
 			Type: "object",
 			Properties: map[string]interface{}{
-				"clip_id": map[string]interface{}{
+				"session_id": map[string]interface{}{
 					"type":        "string",
-					"description": "ID of the clip",
+					"description": "ID of the session to wait for",
+				},
+				"target_status": map[string]interface{}{
+					"type":        "string",
+					"description": "Status to wait for",
+					"enum":        enumValues(videoplatform.SessionStatuses),
+				},
+				"timeout_seconds": map[string]interface{}{
+					"type":        "integer",
+					"description": fmt.Sprintf("How long to wait (default %d, at most %d)", int(defaultWaitTimeout/time.Second), int(maxWaitTimeout/time.Second)),
 				},
 			},
-			Required: []string{"clip_id"},
+			Required: []string{"session_id", "target_status"},
 		},
-	}, makeFavoriteClip(c))
+	}, makeWaitForSessionStatus(c, waitPollInterval))
 
-	// Channel tools
-	s.AddTool(mcp.Tool{
-		Name:        "list_channels",
-		Description: "List all video input channels and their status",
+	r.addTool(mcp.Tool{
+		Name:        "lock_session",
+		Description: "Lock a session so pause, complete and other mutating tools refuse to change it (e.g. during a live game)",
 		InputSchema: mcp.ToolInputSchema{
-			Type:       "object",
-			Properties: map[string]interface{}{},
+			Type: "object",
+			Properties: map[string]interface{}{
+				"session_id": map[string]interface{}{
+					"type":        "string",
+					"description": "ID of the session to lock",
+				},
+				"note": map[string]interface{}{
+					"type":        "string",
+					"description": "Why the session is locked; shown when a change is refused",
+				},
+				"locked_by": map[string]interface{}{
+					"type":        "string",
+					"description": "Who holds the lock (default mcp-assistant)",
+				},
+			},
+			Required: []string{"session_id"},
 		},
-	}, makeListChannels(c))
+	}, makeLockSession(locks))
 
-	s.AddTool(mcp.Tool{
-		Name:        "activate_channel",
-		Description: "Activate a video input channel",
+	r.addTool(mcp.Tool{
+		Name:        "unlock_session",
+		Description: "Release a session lock",
 		InputSchema: mcp.ToolInputSchema{
 			Type: "object",
 			Properties: map[string]interface{}{
-				"channel_id": map[string]interface{}{
+				"session_id": map[string]interface{}{
 					"type":        "string",
-					"description": "ID of the channel to activate",
+					"description": "ID of the session to unlock",
 				},
 			},
-			Required: []string{"channel_id"},
+			Required: []string{"session_id"},
 		},
-	}, makeActivateChannel(c))
+	}, makeUnlockSession(locks))
 
-	s.AddTool(mcp.Tool{
-		Name:        "deactivate_channel",
-		Description: "Deactivate a video input channel",
+	r.addTool(mcp.Tool{
+		Name:        "delete_session",
+		Description: "Delete a session created by mistake. Without confirm: true, only shows what would be deleted.",
 		InputSchema: mcp.ToolInputSchema{
 			Type: "object",
 			Properties: map[string]interface{}{
-				"channel_id": map[string]interface{}{
+				"session_id": map[string]interface{}{
 					"type":        "string",
-					"description": "ID of the channel to deactivate",
+					"description": "ID of the session to delete",
+				},
+				"confirm": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Set to true to actually delete the session",
+				},
+				"override_lock": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Proceed even if the session is locked",
 				},
 			},
-			Required: []string{"channel_id"},
+			Required: []string{"session_id", "confirm"},
 		},
-	}, makeDeactivateChannel(c))
+	}, makeDeleteSession(c, locks, idx))
 
-	// Tag tools
-	s.AddTool(mcp.Tool{
-		Name:        "list_tags",
-		Description: "List clip tags/annotations with filters",
+	r.addReadOnlyTool(mcp.Tool{
+		Name:        "session_summary",
+		Description: "Session metadata with clip counts by status, favorite clips, tag counts by play type, total yards gained, and important and unreviewed tag counts, over every clip and tag of the session",
 		InputSchema: mcp.ToolInputSchema{
 			Type: "object",
 			Properties: map[string]interface{}{
 				"session_id": map[string]interface{}{
 					"type":        "string",
-					"description": "Filter by session ID",
+					"description": "ID of the session",
 				},
-				"clip_id": map[string]interface{}{
+			},
+			Required: []string{"session_id"},
+		},
+	}, makeSessionSummary(c))
+
+	r.addUncachedTool(mcp.Tool{
+		Name:        "session_progress",
+		Description: "How long a session has been recording, with its status and clip and tag counts. Answers \"how long have we been recording?\"",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"session_id": map[string]interface{}{
 					"type":        "string",
-					"description": "Filter by clip ID",
+					"description": "ID of the session",
 				},
-				"play_type": map[string]interface{}{
+			},
+			Required: []string{"session_id"},
+		},
+	}, makeSessionProgress(c))
+
+	r.addReadOnlyTool(mcp.Tool{
+		Name:        "session_timeline",
+		Description: "Play-by-play of a session: every clip in start-time order with its tags' game clock, down, distance, play type and result. Clips without tags are flagged untagged.",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"session_id": map[string]interface{}{
 					"type":        "string",
-					"description": "Filter by play type",
-				},
-				"is_important": map[string]interface{}{
-					"type":        "boolean",
-					"description": "Filter important tags only",
+					"description": "ID of the session",
 				},
-				"is_reviewed": map[string]interface{}{
+				"only_tagged": map[string]interface{}{
 					"type":        "boolean",
-					"description": "Filter by review status",
-				},
-				"limit": map[string]interface{}{
-					"type":        "integer",
-					"description": "Maximum results (default 50)",
+					"description": "Leave out clips without tags (default false)",
 				},
 			},
+			Required: []string{"session_id"},
 		},
-	}, makeListTags(c))
+	}, makeSessionTimeline(c))
 
-	s.AddTool(mcp.Tool{
-		Name:        "create_tag",
-		Description: "Create a new tag/annotation for a clip",
+	r.addReadOnlyTool(mcp.Tool{
+		Name:        "session_handoff",
+		Description: "Markdown briefing for a tagging crew change: status and elapsed time, clips and tag coverage, the last five tags, channels in error, and open items (stuck clips, unreviewed important tags)",
 		InputSchema: mcp.ToolInputSchema{
 			Type: "object",
 			Properties: map[string]interface{}{
-				"clip_id": map[string]interface{}{
+				"session_id": map[string]interface{}{
 					"type":        "string",
-					"description": "ID of the clip to tag",
+					"description": "ID of the session",
 				},
+			},
+			Required: []string{"session_id"},
+		},
+	}, makeSessionHandoff(c, p))
+
+	r.addTool(mcp.Tool{
+		Name:        "export_session_bundle",
+		Description: fmt.Sprintf("Export a session as one ZIP for film exchange: tags.csv, clips.csv, session.json and optionally report.md. Bundles up to %d KiB are returned base64; larger ones are written under -data-dir and the path is returned.", exportInlineLimit>>10),
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
 				"session_id": map[string]interface{}{
 					"type":        "string",
 					"description": "ID of the session",
 				},
-				"play_type": map[string]interface{}{
+				"include_report": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Add report.md, the session_handoff briefing (default false)",
+				},
+			},
+			Required: []string{"session_id"},
+		},
+	}, makeExportSessionBundle(c, p, cfg.DataDir, exportInlineLimit))
+
+	r.addReadOnlyTool(mcp.Tool{
+		Name:        "export_session",
+		Description: "Export a session's metadata, every clip and every tag as one JSON document, or as CSV with one row per tag and its clip's start and end time. The result starts with the clip and tag counts so truncation can be detected.",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"session_id": map[string]interface{}{
 					"type":        "string",
-					"description": "Type of play (Run, Pass, Punt, etc.)",
+					"description": "ID of the session",
 				},
-				"formation": map[string]interface{}{
+				"format": map[string]interface{}{
 					"type":        "string",
-					"description": "Formation used",
+					"description": "Bundle format (default json)",
+					"enum":        []string{exportFormatJSON, exportFormatCSV},
 				},
-				"result": map[string]interface{}{
+			},
+			Required: []string{"session_id"},
+		},
+	}, makeExportSession(c))
+
+	r.addTool(mcp.Tool{
+		Name:        "cleanup_empty_sessions",
+		Description: "Find scheduled or completed sessions with no clips and no tags, then cancel (scheduled) or trash (completed) them. Dry run unless confirm is true; active and paused sessions are never touched.",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"older_than_days": map[string]interface{}{
+					"type":        "number",
+					"description": "Only consider sessions at least this many days old (default 7)",
+				},
+				"confirm": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Actually cancel/trash the sessions (default false: only list them)",
+				},
+				"override_lock": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Also clean up sessions that are locked",
+				},
+			},
+		},
+	}, makeCleanupEmptySessions(c, locks))
+
+	// Clip tools
+	r.addReadOnlyTool(mcp.Tool{
+		Name:        "list_clips",
+		Description: "List video clips with optional filters",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"session_id": map[string]interface{}{
 					"type":        "string",
-					"description": "Result of the play",
+					"description": "Filter by session ID",
 				},
-				"down": map[string]interface{}{
+				"status": map[string]interface{}{
+					"type":        "string",
+					"description": "Filter by clip status",
+					"enum":        enumValues(videoplatform.ClipStatuses),
+				},
+				"favorite": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Filter by favorite status",
+				},
+				"search": map[string]interface{}{
+					"type":        "string",
+					"description": "Search clips by title",
+				},
+				"deep_search": map[string]interface{}{
+					"type":        "boolean",
+					"description": fmt.Sprintf("Also match search against tag notes and labels, e.g. \"missed block\"; clips found that way are added to the first page with matched_via \"tag\". Without session_id only the first %d tags are searched.", maxDeepSearchTags),
+				},
+				"limit": map[string]interface{}{
 					"type":        "integer",
-					"description": "Down number (1-4)",
+					"description": fmt.Sprintf("Maximum results (default %d)", cfg.ToolLimit("list_clips")),
 				},
-				"distance": map[string]interface{}{
+				"offset": map[string]interface{}{
 					"type":        "integer",
-					"description": "Yards to go",
+					"description": "Number of clips to skip, for paging (default 0)",
 				},
-				"yards_gained": map[string]interface{}{
+				"min_duration_seconds": map[string]interface{}{
+					"type":        "number",
+					"description": "Only clips at least this many seconds long, e.g. 3 to hide dead-ball clips",
+				},
+				"max_duration_seconds": map[string]interface{}{
+					"type":        "number",
+					"description": "Only clips at most this many seconds long, e.g. 600 to hide accidental recordings",
+				},
+				"min_rating": map[string]interface{}{
 					"type":        "integer",
-					"description": "Yards gained on the play",
+					"description": fmt.Sprintf("Only clips rated at least this (%d-%d); unrated clips are left out", minClipRating, maxClipRating),
 				},
-				"notes": map[string]interface{}{
+				"sort": map[string]interface{}{
 					"type":        "string",
-					"description": "Additional notes",
+					"description": "Order of results; prefix - for descending (e.g. -duration for longest first)",
+					"enum":        clipSortKeys,
+				},
+				"include_tag_counts": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Annotate each clip with its tag_count (requires session_id)",
 				},
 			},
-			Required: []string{"clip_id", "session_id"},
 		},
-	}, makeCreateTag(c))
-}
+	}, makeListClips(c, cfg.ToolLimit("list_clips"), p))
 
-// Tool handler factories
+	r.addReadOnlyToolUnless("record_view", mcp.Tool{
+		Name:        "get_clip",
+		Description: "Get one clip by ID, e.g. to check its status or duration",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"clip_id": map[string]interface{}{
+					"type":        "string",
+					"description": "ID of the clip",
+				},
+				"include_tags": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Also return the clip's tags",
+				},
+				"record_view": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Count this fetch as a view of the clip, e.g. when reviewing it",
+				},
+			},
+			Required: []string{"clip_id"},
+		},
+	}, makeGetClip(c))
+
+	r.addReadOnlyTool(mcp.Tool{
+		Name:        "get_clips_batch",
+		Description: fmt.Sprintf("Get up to %d clips by ID in one call, e.g. the clips of a playlist. Clips come back in the order asked for; IDs that couldn't be fetched are listed under failed with the reason.", maxBatchClips),
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"clip_ids": map[string]interface{}{
+					"type":        "array",
+					"items":       map[string]interface{}{"type": "string"},
+					"description": "IDs of the clips",
+					"minItems":    1,
+					"maxItems":    maxBatchClips,
+				},
+			},
+			Required: []string{"clip_ids"},
+		},
+	}, makeGetClipsBatch(c))
+
+	r.addReadOnlyTool(mcp.Tool{
+		Name:        "list_untagged_clips",
+		Description: "Clips of a session that no tag points at yet, ordered by start time, with how many of the session's clips are untagged. Use it to find what still needs tagging after a game.",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"session_id": map[string]interface{}{
+					"type":        "string",
+					"description": "ID of the session",
+				},
+				"limit": map[string]interface{}{
+					"type":        "number",
+					"description": "Return at most this many clips; the untagged count still covers them all (default no limit)",
+				},
+			},
+			Required: []string{"session_id"},
+		},
+	}, makeListUntaggedClips(c))
+
+	r.addReadOnlyTool(mcp.Tool{
+		Name:        "clips_by_play_type",
+		Description: "Clips of a session with a tag of a play type, e.g. all the screen passes from Friday, in start-time order. Each clip carries its matching tags' down, distance and result.",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"session_id": map[string]interface{}{
+					"type":        "string",
+					"description": "ID of the session",
+				},
+				"play_type": map[string]interface{}{
+					"type":        "string",
+					"description": "Play type to match, ignoring case, e.g. screen",
+				},
+			},
+			Required: []string{"session_id", "play_type"},
+		},
+	}, makeClipsByPlayType(c))
+
+	r.addReadOnlyTool(mcp.Tool{
+		Name:        "multi_angle_clips",
+		Description: "Group a session's clips of the same play from different cameras, in start-time order. Plays only one camera caught are marked single_angle.",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"session_id": map[string]interface{}{
+					"type":        "string",
+					"description": "ID of the session",
+				},
+				"tolerance_seconds": map[string]interface{}{
+					"type":        "number",
+					"description": fmt.Sprintf("How far apart two cameras' clips of a play may start or end (default %d)", defaultAngleTolerance),
+				},
+			},
+			Required: []string{"session_id"},
+		},
+	}, makeMultiAngleClips(c))
+
+	r.addReadOnlyTool(mcp.Tool{
+		Name:        "get_clip_url",
+		Description: "Get a link to watch or download a clip, for when the user asks for the link",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"clip_id": map[string]interface{}{
+					"type":        "string",
+					"description": "ID of the clip",
+				},
+				"url_type": map[string]interface{}{
+					"type":        "string",
+					"description": "stream to play in a browser, download for the file (default stream)",
+					"enum":        enumValues(videoplatform.ClipURLTypes),
+				},
+			},
+			Required: []string{"clip_id"},
+		},
+	}, makeGetClipURL(c))
+
+	r.addTool(mcp.Tool{
+		Name:        "record_view",
+		Description: "Count a view of a clip, so reviews done here show up in the platform's most-watched stats",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"clip_id": map[string]interface{}{
+					"type":        "string",
+					"description": "ID of the clip",
+				},
+			},
+			Required: []string{"clip_id"},
+		},
+	}, makeRecordView(c))
+
+	r.addTool(mcp.Tool{
+		Name:        "favorite_clip",
+		Description: "Toggle favorite status on a clip. Removing a favorite clears its note.",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"clip_id": map[string]interface{}{
+					"type":        "string",
+					"description": "ID of the clip",
+				},
+				"note": map[string]interface{}{
+					"type":        "string",
+					"description": fmt.Sprintf("Why the clip is a favorite, reused as its highlight caption (up to %d characters)", maxFavoriteNoteLength),
+				},
+			},
+			Required: []string{"clip_id"},
+		},
+	}, makeFavoriteClip(c))
+
+	r.addTool(mcp.Tool{
+		Name:        "set_clip_favorite",
+		Description: "Make a clip a favorite or not. Unlike favorite_clip this sets the state asked for rather than toggling, so it's safe when the current state is unknown. Passing a note with favorite: true saves it even if the clip is already a favorite; removing a favorite clears its note.",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"clip_id": map[string]interface{}{
+					"type":        "string",
+					"description": "ID of the clip",
+				},
+				"favorite": map[string]interface{}{
+					"type":        "boolean",
+					"description": "true to make the clip a favorite, false to remove it from favorites",
+				},
+				"note": map[string]interface{}{
+					"type":        "string",
+					"description": fmt.Sprintf("Why the clip is a favorite, reused as its highlight caption (up to %d characters); only with favorite: true", maxFavoriteNoteLength),
+				},
+			},
+			Required: []string{"clip_id", "favorite"},
+		},
+	}, makeSetClipFavorite(c))
+
+	r.addTool(mcp.Tool{
+		Name:        "rate_clip",
+		Description: fmt.Sprintf("Grade a clip from %d to %d, e.g. to score every rep of a drill. Filter by grade with list_clips min_rating.", minClipRating, maxClipRating),
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"clip_id": map[string]interface{}{
+					"type":        "string",
+					"description": "ID of the clip",
+				},
+				"rating": map[string]interface{}{
+					"type":        "integer",
+					"description": fmt.Sprintf("Grade from %d (poor) to %d (excellent)", minClipRating, maxClipRating),
+				},
+			},
+			Required: []string{"clip_id", "rating"},
+		},
+	}, makeRateClip(c))
+
+	r.addTool(mcp.Tool{
+		Name:        "create_clip",
+		Description: "Cut a clip out of a session's recording by time range, e.g. for a play the auto-clipper missed",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"session_id": map[string]interface{}{
+					"type":        "string",
+					"description": "ID of the session",
+				},
+				"channel_id": map[string]interface{}{
+					"type":        "string",
+					"description": "ID of the channel whose recording to cut from",
+				},
+				"start_time": map[string]interface{}{
+					"type":        "string",
+					"description": "Start of the clip (RFC 3339, e.g. 2026-10-17T19:04:10Z)",
+				},
+				"end_time": map[string]interface{}{
+					"type":        "string",
+					"description": "End of the clip (RFC 3339), after start_time",
+				},
+				"title": map[string]interface{}{
+					"type":        "string",
+					"description": "Clip title",
+				},
+			},
+			Required: []string{"session_id", "channel_id", "start_time", "end_time"},
+		},
+	}, makeCreateClip(c))
+
+	r.addTool(mcp.Tool{
+		Name:        "update_clip",
+		Description: "Name or describe a clip, e.g. title \"Johnson 45yd TD\"; only the fields provided are changed",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"clip_id": map[string]interface{}{
+					"type":        "string",
+					"description": "ID of the clip to update",
+				},
+				"title": map[string]interface{}{
+					"type":        "string",
+					"description": "Clip title",
+				},
+				"description": map[string]interface{}{
+					"type":        "string",
+					"description": "Longer description of the play",
+				},
+			},
+			Required: []string{"clip_id"},
+		},
+	}, makeUpdateClip(c))
+
+	r.addTool(mcp.Tool{
+		Name:        "trim_clip",
+		Description: "Adjust a clip's boundaries, e.g. to cut the huddle before the snap. Pass new start_time/end_time, or trim_start_seconds/trim_end_seconds to cut seconds off either end. Tag offsets are shifted to keep pointing at the same moments unless skip_tag_migration is set.",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"clip_id": map[string]interface{}{
+					"type":        "string",
+					"description": "ID of the clip to trim",
+				},
+				"start_time": map[string]interface{}{
+					"type":        "string",
+					"description": "New start of the clip (RFC 3339)",
+				},
+				"end_time": map[string]interface{}{
+					"type":        "string",
+					"description": "New end of the clip (RFC 3339)",
+				},
+				"trim_start_seconds": map[string]interface{}{
+					"type":        "number",
+					"description": "Seconds to cut from the start",
+				},
+				"trim_end_seconds": map[string]interface{}{
+					"type":        "number",
+					"description": "Seconds to cut from the end",
+				},
+				"skip_tag_migration": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Leave tag offsets unchanged",
+				},
+			},
+			Required: []string{"clip_id"},
+		},
+	}, makeTrimClip(c))
+
+	r.addTool(mcp.Tool{
+		Name:        "merge_clips",
+		Description: "Join clips from one session, such as a drive split across several clips, into a new clip. Their tags move to the new clip unless skip_tag_migration is set.",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"clip_ids": map[string]interface{}{
+					"type":        "array",
+					"description": "IDs of the clips to merge, in order",
+					"items":       map[string]interface{}{"type": "string"},
+					"minItems":    2,
+				},
+				"title": map[string]interface{}{
+					"type":        "string",
+					"description": "Title of the merged clip",
+				},
+				"skip_tag_migration": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Leave the tags on the original clips instead of moving them",
+				},
+			},
+			Required: []string{"clip_ids"},
+		},
+	}, makeMergeClips(c))
+
+	r.addTool(mcp.Tool{
+		Name:        "export_clips",
+		Description: "Download clip media files for film exchange into a directory under the server's -export-dir. Files are named {session}_{start_time}_{title}_{clip_id}.mp4; files that already exist are skipped. Returns a per-clip report of bytes written.",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"clip_ids": map[string]interface{}{
+					"type":        "array",
+					"items":       map[string]interface{}{"type": "string"},
+					"description": "IDs of the clips to download",
+				},
+				"session_id": map[string]interface{}{
+					"type":        "string",
+					"description": "Download the clips of this session instead of clip_ids",
+				},
+				"favorites_only": map[string]interface{}{
+					"type":        "boolean",
+					"description": "With session_id, only the session's favorite clips",
+				},
+				"directory": map[string]interface{}{
+					"type":        "string",
+					"description": "Directory to write to, relative to the export directory; created if needed",
+				},
+			},
+			Required: []string{"directory"},
+		},
+	}, makeExportClips(c, cfg.ExportDir))
+
+	// Playlist tools
+	r.addTool(mcp.Tool{
+		Name:        "create_playlist",
+		Description: "Create an empty playlist to group clips into, e.g. a teaching reel of \"all busted coverages\"",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"name": map[string]interface{}{
+					"type":        "string",
+					"description": "Playlist name",
+				},
+				"description": map[string]interface{}{
+					"type":        "string",
+					"description": "What the playlist is for",
+				},
+			},
+			Required: []string{"name"},
+		},
+	}, makeCreatePlaylist(c))
+
+	r.addTool(mcp.Tool{
+		Name:        "add_clips_to_playlist",
+		Description: "Append clips to the end of a playlist in the order given. Clips already in the playlist are skipped.",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"playlist_id": map[string]interface{}{
+					"type":        "string",
+					"description": "ID of the playlist",
+				},
+				"clip_ids": map[string]interface{}{
+					"type":        "array",
+					"items":       map[string]interface{}{"type": "string"},
+					"description": "IDs of the clips to add",
+					"minItems":    1,
+				},
+			},
+			Required: []string{"playlist_id", "clip_ids"},
+		},
+	}, makeAddClipsToPlaylist(c))
+
+	r.addTool(mcp.Tool{
+		Name:        "generate_highlight_reel",
+		Description: "Turn a session's favorite clips into a playlist named \"{session name} highlights\", in chronological order. Returns the playlist ID and the included clips with their favorite notes as captions.",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"session_id": map[string]interface{}{
+					"type":        "string",
+					"description": "ID of the session",
+				},
+				"play_type": map[string]interface{}{
+					"type":        "string",
+					"description": "Only favorites tagged with this play type, e.g. pass",
+				},
+				"max_clips": map[string]interface{}{
+					"type":        "number",
+					"description": "Include at most this many clips, the earliest first",
+				},
+			},
+			Required: []string{"session_id"},
+		},
+	}, makeGenerateHighlightReel(c))
+
+	r.addReadOnlyTool(mcp.Tool{
+		Name:        "list_playlists",
+		Description: "List playlists with their clip IDs",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"search": map[string]interface{}{
+					"type":        "string",
+					"description": "Search playlists by name",
+				},
+			},
+		},
+	}, makeListPlaylists(c, p))
+
+	// Channel tools
+	r.addReadOnlyTool(mcp.Tool{
+		Name:        "list_channels",
+		Description: "List video input channels and their status; with no arguments, lists every channel",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"status": map[string]interface{}{
+					"type":        "string",
+					"description": "Filter by status, e.g. error to find failing cameras",
+					"enum":        enumValues(videoplatform.ChannelStatuses),
+				},
+				"input_type": map[string]interface{}{
+					"type":        "string",
+					"description": "Filter by input type",
+					"enum":        enumValues(videoplatform.ChannelInputTypes),
+				},
+				"search": map[string]interface{}{
+					"type":        "string",
+					"description": "Search channels by name or description",
+				},
+				"group": map[string]interface{}{
+					"type":        "string",
+					"description": "Only channels with this group label, e.g. North Field",
+				},
+				"limit": map[string]interface{}{
+					"type":        "integer",
+					"description": "Maximum results (default: the platform's page size)",
+				},
+				"offset": map[string]interface{}{
+					"type":        "integer",
+					"description": "Number of channels to skip, for paging (default 0)",
+				},
+				"include_usage": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Annotate each channel with clip_count and most_recent_clip_at",
+				},
+			},
+		},
+	}, makeListChannels(c, p))
+
+	r.addReadOnlyTool(mcp.Tool{
+		Name:        "channel_health",
+		Description: "Summarize channel health: counts by status, every channel in error with its error_message, and active channels not seen for stale_minutes",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"stale_minutes": map[string]interface{}{
+					"type":        "integer",
+					"description": fmt.Sprintf("Minutes an active channel may go unseen before it counts as stale (default %d)", defaultStaleMinutes),
+				},
+			},
+		},
+	}, makeChannelHealth(c, p))
+
+	r.addReadOnlyTool(mcp.Tool{
+		Name:        "get_channel",
+		Description: "Get one channel by ID with its input URL, resolution, framerate, last_seen_at and error_message, e.g. to see why a camera is in error",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"channel_id": map[string]interface{}{
+					"type":        "string",
+					"description": "ID of the channel",
+				},
+			},
+			Required: []string{"channel_id"},
+		},
+	}, makeGetChannel(c))
+
+	r.addTool(mcp.Tool{
+		Name:        "create_channel",
+		Description: "Register a new camera input, e.g. when setting up at an away field. rtsp and ndi inputs need an input_url.",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"name": map[string]interface{}{
+					"type":        "string",
+					"description": "Name of the channel, e.g. End Zone",
+				},
+				"description": map[string]interface{}{
+					"type":        "string",
+					"description": "Optional description, e.g. where the camera stands",
+				},
+				"group": map[string]interface{}{
+					"type":        "string",
+					"description": "Optional group label, e.g. the field the camera is at (North Field)",
+				},
+				"input_type": map[string]interface{}{
+					"type":        "string",
+					"description": "Kind of input",
+					"enum":        enumValues(videoplatform.ChannelInputTypes),
+				},
+				"input_url": map[string]interface{}{
+					"type":        "string",
+					"description": "Stream URL for rtsp and ndi inputs, e.g. rtsp://10.0.0.12:554/stream1; device or file path otherwise",
+				},
+				"resolution": map[string]interface{}{
+					"type":        "string",
+					"description": "Capture resolution, e.g. 1920x1080",
+				},
+				"framerate": map[string]interface{}{
+					"type":        "integer",
+					"description": "Frames per second, e.g. 60",
+				},
+			},
+			Required: []string{"name", "input_type"},
+		},
+	}, makeCreateChannel(c))
+
+	r.addTool(mcp.Tool{
+		Name:        "update_channel",
+		Description: "Change a channel's name, description, input URL, resolution or framerate, e.g. when a camera's IP changes on a new network. Only the values given are changed. Changing the input URL of an active channel an active session is recording from is refused unless force is set.",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"channel_id": map[string]interface{}{
+					"type":        "string",
+					"description": "ID of the channel",
+				},
+				"name": map[string]interface{}{
+					"type":        "string",
+					"description": "New name",
+				},
+				"description": map[string]interface{}{
+					"type":        "string",
+					"description": "New description",
+				},
+				"group": map[string]interface{}{
+					"type":        "string",
+					"description": "New group label, e.g. North Field; an empty string removes it",
+				},
+				"input_url": map[string]interface{}{
+					"type":        "string",
+					"description": "New input URL, e.g. rtsp://192.168.1.40:554/stream1",
+				},
+				"resolution": map[string]interface{}{
+					"type":        "string",
+					"description": "New capture resolution, e.g. 1280x720",
+				},
+				"framerate": map[string]interface{}{
+					"type":        "integer",
+					"description": "New frames per second",
+				},
+				"force": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Change input_url even if an active session is recording from the channel",
+				},
+			},
+			Required: []string{"channel_id"},
+		},
+	}, makeUpdateChannel(c))
+
+	r.addTool(mcp.Tool{
+		Name:        "activate_channel",
+		Description: "Activate a video input channel",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"channel_id": map[string]interface{}{
+					"type":        "string",
+					"description": "ID of the channel to activate",
+				},
+			},
+			Required: []string{"channel_id"},
+		},
+	}, makeActivateChannel(c))
+
+	r.addTool(mcp.Tool{
+		Name:        "deactivate_channel",
+		Description: "Deactivate a video input channel. Refused while an active session is recording from it unless force is set.",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"channel_id": map[string]interface{}{
+					"type":        "string",
+					"description": "ID of the channel to deactivate",
+				},
+				"force": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Deactivate even if an active session is recording from the channel",
+				},
+			},
+			Required: []string{"channel_id"},
+		},
+	}, makeDeactivateChannel(c))
+
+	r.addTool(mcp.Tool{
+		Name:        "bulk_set_channels",
+		Description: "Activate or deactivate several channels at once, e.g. every camera at one field before a session. Select them with channel_ids, group or all. Channels already in the state are skipped; one failure doesn't stop the rest. Deactivating a channel an active session is recording from is refused unless force is set.",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"channel_ids": map[string]interface{}{
+					"type":        "array",
+					"items":       map[string]interface{}{"type": "string"},
+					"description": "IDs of the channels to change",
+				},
+				"all": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Change every channel instead of channel_ids",
+				},
+				"group": map[string]interface{}{
+					"type":        "string",
+					"description": "Change every channel with this group label instead of channel_ids, e.g. North Field",
+				},
+				"state": map[string]interface{}{
+					"type":        "string",
+					"description": "State to set",
+					"enum":        bulkChannelStates,
+				},
+				"force": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Deactivate even channels an active session is recording from",
+				},
+			},
+			Required: []string{"state"},
+		},
+	}, makeBulkSetChannels(c))
+
+	r.addTool(mcp.Tool{
+		Name:        "clear_channel_error",
+		Description: "Clear a channel's error state and error_message once the fault is fixed, then report whether it recovered or errored again. Does nothing if the channel has no error.",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"channel_id": map[string]interface{}{
+					"type":        "string",
+					"description": "ID of the channel",
+				},
+			},
+			Required: []string{"channel_id"},
+		},
+	}, makeClearChannelError(c))
+
+	r.addTool(mcp.Tool{
+		Name:        "delete_channel",
+		Description: "Delete a decommissioned channel. Active channels must be deactivated first. Without confirm: true, only shows what would be deleted.",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"channel_id": map[string]interface{}{
+					"type":        "string",
+					"description": "ID of the channel to delete",
+				},
+				"confirm": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Set to true to actually delete the channel",
+				},
+			},
+			Required: []string{"channel_id", "confirm"},
+		},
+	}, makeDeleteChannel(c))
+
+	r.addTool(mcp.Tool{
+		Name:        "test_channel",
+		Description: "Probe a channel for a video signal, e.g. to check a camera is actually sending video before kickoff. Reports whether it's reachable, the detected resolution and framerate, and latency.",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"channel_id": map[string]interface{}{
+					"type":        "string",
+					"description": "ID of the channel to probe",
+				},
+			},
+			Required: []string{"channel_id"},
+		},
+	}, makeTestChannel(c))
+
+	r.addPollingTool(mcp.Tool{
+		Name:        "restart_channel",
+		Description: fmt.Sprintf("Restart a channel's input, e.g. when it is stuck in error. By default waits up to %d seconds for it to come back active or report an error.", int(restartWaitTimeout/time.Second)),
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"channel_id": map[string]interface{}{
+					"type":        "string",
+					"description": "ID of the channel to restart",
+				},
+				"wait": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Wait for the channel to come back (default true)",
+				},
+			},
+			Required: []string{"channel_id"},
+		},
+	}, makeRestartChannel(c, waitPollInterval))
+
+	r.addUncachedTool(mcp.Tool{
+		Name:        "channel_stats",
+		Description: "Get a channel's bitrate, actual framerate, dropped frames, uptime and last error, e.g. to debug choppy recordings. With all: true, shows a table for every active channel.",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"channel_id": map[string]interface{}{
+					"type":        "string",
+					"description": "ID of the channel",
+				},
+				"all": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Show every active channel instead of channel_id",
+				},
+			},
+		},
+	}, makeChannelStats(c))
+
+	r.addTool(mcp.Tool{
+		Name:        "channel_snapshot",
+		Description: "Get the current frame of a channel as an image, e.g. to check a camera's framing before kickoff. Large frames are written under -export-dir and the path is returned.",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"channel_id": map[string]interface{}{
+					"type":        "string",
+					"description": "ID of the channel",
+				},
+			},
+			Required: []string{"channel_id"},
+		},
+	}, makeChannelSnapshot(c, cfg.ExportDir, cfg.SnapshotInlineLimit))
+
+	// Tag tools
+	r.addReadOnlyTool(mcp.Tool{
+		Name:        "list_tags",
+		Description: "List clip tags/annotations with filters",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"session_id": map[string]interface{}{
+					"type":        "string",
+					"description": "Filter by session ID",
+				},
+				"clip_id": map[string]interface{}{
+					"type":        "string",
+					"description": "Filter by clip ID",
+				},
+				"play_type": map[string]interface{}{
+					"type":        "string",
+					"description": "Filter by play type",
+				},
+				"formation": map[string]interface{}{
+					"type":        "string",
+					"description": "Filter by formation, e.g. Trips",
+				},
+				"result": map[string]interface{}{
+					"type":        "string",
+					"description": "Filter by play result",
+				},
+				"down": map[string]interface{}{
+					"type":        "integer",
+					"description": "Filter by down (1-4)",
+				},
+				"quarter": map[string]interface{}{
+					"type":        "integer",
+					"description": "Filter by quarter (1-4; 5 is OT, 6 is OT2 and so on)",
+				},
+				"label": map[string]interface{}{
+					"type":        "string",
+					"description": "Filter to tags carrying this label, e.g. turnover",
+				},
+				"is_important": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Filter important tags only",
+				},
+				"is_reviewed": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Filter by review status",
+				},
+				"limit": map[string]interface{}{
+					"type":        "integer",
+					"description": fmt.Sprintf("Maximum results (default %d)", cfg.ToolLimit("list_tags")),
+				},
+				"offset": map[string]interface{}{
+					"type":        "integer",
+					"description": "Number of tags to skip, for paging (default 0)",
+				},
+				"include_clip_context": map[string]interface{}{
+					"type":        "boolean",
+					"description": fmt.Sprintf("Add each tag's clip title, start time and duration (skipped for pages over %d tags)", maxClipContextTags),
+				},
+			},
+		},
+	}, makeListTags(c, cfg.Success, cfg.ToolLimit("list_tags"), p))
+
+	r.addReadOnlyTool(mcp.Tool{
+		Name:        "search_tags",
+		Description: "Find tags whose notes or labels mention some text, ignoring case, e.g. \"missed assignment\". Shows the matching part of each tag's notes.",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"query": map[string]interface{}{
+					"type":        "string",
+					"description": "Text to find in tag notes and labels",
+				},
+				"session_id": map[string]interface{}{
+					"type":        "string",
+					"description": "Only search this session's tags",
+				},
+				"limit": map[string]interface{}{
+					"type":        "integer",
+					"description": fmt.Sprintf("Maximum matches (default %d)", cfg.ToolLimit("search_tags")),
+				},
+			},
+			Required: []string{"query"},
+		},
+	}, makeSearchTags(c, cfg.ToolLimit("search_tags")))
+
+	r.addTool(mcp.Tool{
+		Name:        "create_tag",
+		Description: "Create a new tag/annotation for a clip",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"clip_id": map[string]interface{}{
+					"type":        "string",
+					"description": "ID of the clip to tag",
+				},
+				"session_id": map[string]interface{}{
+					"type":        "string",
+					"description": "ID of the session",
+				},
+				"play_type": map[string]interface{}{
+					"type":        "string",
+					"description": "Type of play (Run, Pass, Punt, etc.)",
+				},
+				"formation": map[string]interface{}{
+					"type":        "string",
+					"description": "Formation used",
+				},
+				"result": map[string]interface{}{
+					"type":        "string",
+					"description": "Result of the play",
+				},
+				"down": map[string]interface{}{
+					"type":        "integer",
+					"description": "Down number (1-4)",
+				},
+				"distance": map[string]interface{}{
+					"type":        "integer",
+					"description": "Yards to go",
+				},
+				"yards_gained": map[string]interface{}{
+					"type":        "integer",
+					"description": "Yards gained on the play",
+				},
+				"notes": map[string]interface{}{
+					"type":        "string",
+					"description": "Additional notes",
+				},
+				"quarter": map[string]interface{}{
+					"type":        "integer",
+					"description": "Quarter of the play (1-4; 5 is OT, 6 is OT2 and so on)",
+				},
+				"labels": map[string]interface{}{
+					"type":        "array",
+					"items":       map[string]interface{}{"type": "string"},
+					"description": "Labels for the play, e.g. turnover or redzone",
+				},
+				"template": map[string]interface{}{
+					"type":        "string",
+					"description": "Name of a saved tag template to start from; fields passed here override it",
+				},
+				"game_clock": map[string]interface{}{
+					"type":        "string",
+					"description": "Quarter and time remaining, e.g. \"Q3 04:12\" or \"OT 1:00\"; sets quarter and a clock:mm:ss label",
+				},
+			},
+			Required: []string{"clip_id", "session_id"},
+		},
+	}, makeCreateTag(c, cfg.OvertimeLength, templates))
+
+	r.addTool(mcp.Tool{
+		Name:        "save_tag_template",
+		Description: "Save a named set of create_tag fields for plays that recur, e.g. \"punt fair catch\", then pass template to create_tag. Saving under an existing name replaces it. Needs -data-dir.",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"name": map[string]interface{}{
+					"type":        "string",
+					"description": "Template name, matched ignoring case",
+				},
+				"play_type": map[string]interface{}{
+					"type":        "string",
+					"description": "Type of play (Run, Pass, Punt, etc.)",
+				},
+				"formation": map[string]interface{}{
+					"type":        "string",
+					"description": "Formation used",
+				},
+				"result": map[string]interface{}{
+					"type":        "string",
+					"description": "Result of the play",
+				},
+				"down": map[string]interface{}{
+					"type":        "integer",
+					"description": "Down number (1-4)",
+				},
+				"distance": map[string]interface{}{
+					"type":        "integer",
+					"description": "Yards to go",
+				},
+				"yards_gained": map[string]interface{}{
+					"type":        "integer",
+					"description": "Yards gained on the play",
+				},
+				"quarter": map[string]interface{}{
+					"type":        "integer",
+					"description": "Quarter of the play (1-4; 5 is OT, 6 is OT2 and so on)",
+				},
+				"labels": map[string]interface{}{
+					"type":        "array",
+					"items":       map[string]interface{}{"type": "string"},
+					"description": "Labels for the play",
+				},
+				"notes": map[string]interface{}{
+					"type":        "string",
+					"description": "Notes",
+				},
+			},
+			Required: []string{"name"},
+		},
+	}, makeSaveTagTemplate(templates))
+
+	r.addReadOnlyTool(mcp.Tool{
+		Name:        "list_tag_templates",
+		Description: "List the saved tag templates and their fields",
+		InputSchema: mcp.ToolInputSchema{
+			Type:       "object",
+			Properties: map[string]interface{}{},
+		},
+	}, makeListTagTemplates(templates))
+
+	r.addTool(mcp.Tool{
+		Name:        "bulk_create_tags",
+		Description: fmt.Sprintf("Create up to %d tags in one call, e.g. after a game. Each entry takes the same fields as create_tag; every entry is checked before any tag is created. A failed tag doesn't undo the others.", maxBulkTags),
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"tags": map[string]interface{}{
+					"type": "array",
+					"items": map[string]interface{}{
+						"type":     "object",
+						"required": []string{"clip_id", "session_id"},
+					},
+					"maxItems":    maxBulkTags,
+					"description": "Tags to create, each with clip_id, session_id and any other create_tag fields",
+				},
+			},
+			Required: []string{"tags"},
+		},
+	}, makeBulkCreateTags(c, cfg.OvertimeLength))
+
+	r.addReadOnlyTool(mcp.Tool{
+		Name:        "get_tag",
+		Description: "Get one tag by ID with all its fields",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"tag_id": map[string]interface{}{
+					"type":        "string",
+					"description": "ID of the tag",
+				},
+			},
+			Required: []string{"tag_id"},
+		},
+	}, makeGetTag(c))
+
+	r.addTool(mcp.Tool{
+		Name:        "update_tag",
+		Description: "Correct a tag, e.g. a mistyped yards_gained. Only the fields passed are changed.",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"tag_id": map[string]interface{}{
+					"type":        "string",
+					"description": "ID of the tag",
+				},
+				"play_type": map[string]interface{}{
+					"type":        "string",
+					"description": "Type of play (Run, Pass, Punt, etc.)",
+				},
+				"formation": map[string]interface{}{
+					"type":        "string",
+					"description": "Formation used",
+				},
+				"result": map[string]interface{}{
+					"type":        "string",
+					"description": "Result of the play",
+				},
+				"down": map[string]interface{}{
+					"type":        "integer",
+					"description": "Down number (1-4)",
+				},
+				"distance": map[string]interface{}{
+					"type":        "integer",
+					"description": "Yards to go",
+				},
+				"yards_gained": map[string]interface{}{
+					"type":        "integer",
+					"description": "Yards gained on the play",
+				},
+				"quarter": map[string]interface{}{
+					"type":        "integer",
+					"description": "Quarter (1-4; 5 is OT, 6 is OT2 and so on)",
+				},
+				"game_clock": map[string]interface{}{
+					"type":        "string",
+					"description": "Quarter and time remaining, e.g. \"Q3 04:12\"; sets quarter and replaces the clock:mm:ss label",
+				},
+				"notes": map[string]interface{}{
+					"type":        "string",
+					"description": "Additional notes",
+				},
+				"labels": map[string]interface{}{
+					"type":        "array",
+					"items":       map[string]interface{}{"type": "string"},
+					"description": "Replaces every label on the tag; an empty array clears them",
+				},
+			},
+			Required: []string{"tag_id"},
+		},
+	}, makeUpdateTag(c, cfg.OvertimeLength))
+
+	r.addTool(mcp.Tool{
+		Name:        "delete_tag",
+		Description: "Delete a tag. Without confirm: true, only shows what would be deleted.",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"tag_id": map[string]interface{}{
+					"type":        "string",
+					"description": "ID of the tag",
+				},
+				"confirm": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Delete the tag; without it, only preview",
+				},
+			},
+			Required: []string{"tag_id"},
+		},
+	}, makeDeleteTag(c))
+
+	r.addTool(mcp.Tool{
+		Name:        "mark_tags_reviewed",
+		Description: "Mark one tag (tag_id) or several (tag_ids) reviewed at the end of a review, or unreviewed with reviewed: false. Tags already in that state are skipped; one failure doesn't stop the rest.",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"tag_id": map[string]interface{}{
+					"type":        "string",
+					"description": "ID of one tag",
+				},
+				"tag_ids": map[string]interface{}{
+					"type":        "array",
+					"items":       map[string]interface{}{"type": "string"},
+					"description": "IDs of several tags",
+				},
+				"reviewed": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Whether the tags are reviewed (default true)",
+				},
+			},
+		},
+	}, makeMarkTagsReviewed(c))
+
+	r.addTool(mcp.Tool{
+		Name:        "set_tag_important",
+		Description: "Flag a tag important, or clear the flag with important: false. A reason is added to the tag's notes.",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"tag_id": map[string]interface{}{
+					"type":        "string",
+					"description": "ID of the tag",
+				},
+				"important": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Whether the tag is important",
+				},
+				"reason": map[string]interface{}{
+					"type":        "string",
+					"description": "Why; appended to the tag's notes",
+				},
+			},
+			Required: []string{"tag_id", "important"},
+		},
+	}, makeSetTagImportant(c))
+
+	r.addReadOnlyTool(mcp.Tool{
+		Name:        "explain_success",
+		Description: "Show how the play success rule (1st down: 40% of distance, 2nd: 60%, 3rd/4th: conversion) applies to a tag",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"tag_id": map[string]interface{}{
+					"type":        "string",
+					"description": "ID of the tag",
+				},
+			},
+			Required: []string{"tag_id"},
+		},
+	}, makeExplainSuccess(c, cfg.Success))
+
+	r.addReadOnlyTool(mcp.Tool{
+		Name:        "suggest_labels",
+		Description: "Suggest labels for a tag from how often labels occur together on existing tags with the same play type, formation and labels. Counts are returned so suggestions can be justified.",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"play_type": map[string]interface{}{
+					"type":        "string",
+					"description": "Play type of the tag being labeled",
+				},
+				"formation": map[string]interface{}{
+					"type":        "string",
+					"description": "Formation of the tag being labeled",
+				},
+				"labels": map[string]interface{}{
+					"type":        "array",
+					"items":       map[string]interface{}{"type": "string"},
+					"description": "Labels the tag already has",
+				},
+				"session_id": map[string]interface{}{
+					"type":        "string",
+					"description": "Only learn from this session's tags (default: the whole season)",
+				},
+				"limit": map[string]interface{}{
+					"type":        "number",
+					"description": "Number of labels to suggest (default 5)",
+				},
+			},
+		},
+	}, makeSuggestLabels(c))
+
+	r.addReadOnlyTool(mcp.Tool{
+		Name:        "generate_daily_digest",
+		Description: "Markdown digest of the last 24 hours (or one calendar day): sessions run, clips created by status, tags created, channels in error and media added. The digest can be read back as video://digests/{date}.",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"date": map[string]interface{}{
+					"type":        "string",
+					"description": "Calendar day to summarize (YYYY-MM-DD, server time zone) instead of the last 24 hours",
+				},
+			},
+		},
+	}, makeGenerateDailyDigest(c, p, r.digests))
+
+	r.addUncachedTool(mcp.Tool{
+		Name:        "changes_since",
+		Description: "List sessions, clips and tags created or updated since a time. Each list says whether the platform filtered it (server_filter) or recent pages were scanned and filtered here (client_filter, complete: false if the scan stopped early).",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"since": map[string]interface{}{
+					"type":        "string",
+					"description": "RFC 3339 timestamp, or \"last_call\" for the start of the previous changes_since call in this server process",
+				},
+			},
+			Required: []string{"since"},
+		},
+	}, makeChangesSince(c, &changeWatermark{}))
+
+	// Retention tools
+	r.addReadOnlyTool(mcp.Tool{
+		Name:        "get_retention_policy",
+		Description: "Show how long clips are kept by default and per session type",
+		InputSchema: mcp.ToolInputSchema{
+			Type:       "object",
+			Properties: map[string]interface{}{},
+		},
+	}, makeGetRetentionPolicy(c))
+
+	r.addTool(mcp.Tool{
+		Name:        "set_retention_policy",
+		Description: "Update the default and/or per-session-type clip retention. Durations look like 30d, 12w, 1y or forever; minimum 1 day.",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"default": map[string]interface{}{
+					"type":        "string",
+					"description": "Retention for session types without their own entry (e.g. forever)",
+				},
+				"session_types": map[string]interface{}{
+					"type":        "object",
+					"description": "Retention per session type, e.g. {\"practice\": \"30d\", \"game\": \"forever\"}; \"default\" removes an entry",
+					"additionalProperties": map[string]interface{}{
+						"type": "string",
+					},
+				},
+			},
+		},
+	}, makeSetRetentionPolicy(c))
+
+	r.addTool(mcp.Tool{
+		Name:        "set_session_retention",
+		Description: "Override clip retention for one session, taking precedence over the policy",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"session_id": map[string]interface{}{
+					"type":        "string",
+					"description": "ID of the session",
+				},
+				"retention": map[string]interface{}{
+					"type":        "string",
+					"description": "Duration like 30d, 12w, 1y or forever; \"default\" clears the override",
+				},
+			},
+			Required: []string{"session_id", "retention"},
+		},
+	}, makeSetSessionRetention(c))
+
+	r.addReadOnlyTool(mcp.Tool{
+		Name:        "retention_report",
+		Description: "List sessions whose media is older than their retention allows, most overdue first",
+		InputSchema: mcp.ToolInputSchema{
+			Type:       "object",
+			Properties: map[string]interface{}{},
+		},
+	}, makeRetentionReport(c))
+
+	// Diagnostics tools
+	r.addUncachedTool(mcp.Tool{
+		Name:        "connection_info",
+		Description: "Report the transport the server is running on and, for the sse transport, the connected client count, total connections and uptime",
+		InputSchema: mcp.ToolInputSchema{
+			Type:       "object",
+			Properties: map[string]interface{}{},
+		},
+	}, makeConnectionInfo(&r.transport, cfg, r.started))
+
+	r.addUncachedTool(mcp.Tool{
+		Name:        "run_self_test",
+		Description: "Run read-only connectivity checks against the video platform and report pass/fail for each",
+		InputSchema: mcp.ToolInputSchema{
+			Type:       "object",
+			Properties: map[string]interface{}{},
+		},
+	}, makeRunSelfTest(s, c))
+
+	r.addUncachedTool(mcp.Tool{
+		Name:        "generate_support_bundle",
+		Description: "Collect a JSON snapshot of server config (secrets redacted), version, tools, metrics, recent calls and platform health to attach to a bug report",
+		InputSchema: mcp.ToolInputSchema{
+			Type:       "object",
+			Properties: map[string]interface{}{},
+		},
+	}, makeGenerateSupportBundle(r, c, cfg))
+
+	if cfg.EnableRawRequests {
+		r.addTool(mcp.Tool{
+			Name:        "platform_request",
+			Description: "Call a platform API endpoint that has no dedicated tool. Path must begin with /api/. Methods other than GET require confirm: true.",
+			InputSchema: mcp.ToolInputSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"method": map[string]interface{}{
+						"type":        "string",
+						"description": "HTTP method",
+						"enum":        rawMethods,
+					},
+					"path": map[string]interface{}{
+						"type":        "string",
+						"description": "Path under /api/, e.g. /api/v1/sessions/abc/notes",
+					},
+					"query": map[string]interface{}{
+						"type":        "object",
+						"description": "Query parameters",
+					},
+					"body": map[string]interface{}{
+						"description": "JSON request body",
+					},
+					"confirm": map[string]interface{}{
+						"type":        "boolean",
+						"description": "Required for POST, PUT, PATCH and DELETE",
+					},
+				},
+				Required: []string{"method", "path"},
+			},
+		}, makePlatformRequest(c))
+	}
+
+	return r
+}
+
+// Tool handler factories
+
+func makeListSessions(c *videoplatform.Client, limit int, sessionTypes []string, p presenter) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		params := videoplatform.ListSessionsParams{Limit: limit}
+
+		status, err := enumArg(req.Params.Arguments, "status", videoplatform.SessionStatuses)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		params.Status = status
+		sessionType, err := sessionTypeArg(req.Params.Arguments, sessionTypes)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		params.SessionType = sessionType
+		if search, ok := req.Params.Arguments["search"].(string); ok {
+			params.Search = search
+		}
+		if opponent, ok := req.Params.Arguments["opponent"].(string); ok {
+			params.Opponent = strings.TrimSpace(opponent)
+		}
+		if limit, ok := req.Params.Arguments["limit"].(float64); ok {
+			params.Limit = int(limit)
+		}
+		if offset, ok := req.Params.Arguments["offset"].(float64); ok && offset > 0 {
+			params.Offset = int(offset)
+		}
+		sortKey, err := sortArg(req.Params.Arguments, sessionSortKeys)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		params.Sort = sortKey
+
+		resp, err := c.ListSessions(ctx, params)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list sessions: %v", err)), nil
+		}
+
+		e := newListEnvelope(resp.Data, resp).withRequested(params.Limit, params.Offset)
+		return listResult(e, "sessions", e.nextPageHint(), p), nil
+	}
+}
+
+// Sort keys accepted by list_sessions and list_clips; a "-" prefix sorts
+// descending
+var (
+	sessionSortKeys = []string{"created_at", "-created_at", "scheduled_start", "-scheduled_start", "duration", "-duration"}
+	clipSortKeys    = []string{"created_at", "-created_at", "duration", "-duration"}
+)
+
+// sortArg reads the sort argument, rejecting keys not in allowed
+func sortArg(args map[string]interface{}, allowed []string) (string, error) {
+	key, _ := args["sort"].(string)
+	if key == "" {
+		return "", nil
+	}
+	for _, k := range allowed {
+		if key == k {
+			return key, nil
+		}
+	}
+	return "", fmt.Errorf("invalid sort %q: must be one of %s", key, joinOr(allowed))
+}
+
+// enumValues converts typed enum values to the strings a schema enum lists
+func enumValues[T ~string](values []T) []string {
+	out := make([]string, len(values))
+	for i, v := range values {
+		out[i] = string(v)
+	}
+	return out
+}
+
+// enumArg reads an optional string argument, rejecting values that aren't
+// one of the known values of T
+func enumArg[T interface {
+	~string
+	IsValid() bool
+}](args map[string]interface{}, key string, values []T) (T, error) {
+	s, _ := args[key].(string)
+	v := T(s)
+	if s != "" && !v.IsValid() {
+		return "", fmt.Errorf("invalid %s %q: must be one of %s", key, s, joinOr(enumValues(values)))
+	}
+	return v, nil
+}
+
+// sessionTypeArg reads an optional session_type argument. Custom types such
+// as "7v7" pass through to the platform unless allowed is set, in which
+// case the type must be one of allowed.
+func sessionTypeArg(args map[string]interface{}, allowed []string) (videoplatform.SessionType, error) {
+	s, _ := args["session_type"].(string)
+	s = strings.TrimSpace(s)
+	if s != "" && len(allowed) > 0 && !slices.Contains(allowed, s) {
+		return "", fmt.Errorf("invalid session_type %q: must be one of %s", s, joinOr(allowed))
+	}
+	return videoplatform.SessionType(s), nil
+}
+
+// sessionTypeSchema describes a session_type argument: an enum of allowed
+// when the server restricts session types, otherwise free text with the
+// common types as examples
+func sessionTypeSchema(description string, allowed []string) map[string]interface{} {
+	if len(allowed) > 0 {
+		return map[string]interface{}{
+			"type":        "string",
+			"description": description,
+			"enum":        allowed,
+		}
+	}
+	return map[string]interface{}{
+		"type":        "string",
+		"description": fmt.Sprintf("%s. Common types are %s; custom types such as 7v7 or film_review are accepted too.", description, joinOr(enumValues(videoplatform.SessionTypes))),
+	}
+}
+
+// opponentSchema accepts one opponent or a list for multi-opponent events
+var opponentSchema = map[string]interface{}{
+	"anyOf": []interface{}{
+		map[string]interface{}{"type": "string"},
+		map[string]interface{}{"type": "array", "items": map[string]interface{}{"type": "string"}},
+	},
+	"description": "Opponent name (for games), or a list of opponents for a jamboree or split-squad event",
+}
+
+// scheduledStartSchema describes the forms parseScheduledStart accepts
+var scheduledStartSchema = map[string]interface{}{
+	"type":        "string",
+	"description": "Scheduled start time: RFC 3339 (e.g. 2026-10-17T19:00:00Z), a duration from now like \"+2h\", or \"today 18:00\" / \"tomorrow 18:00\" in the server's time zone",
+}
+
+// opponentsArg reads the opponent argument, which may be a string or an
+// array of strings. Blank names are dropped.
+func opponentsArg(args map[string]interface{}) ([]string, error) {
+	var opponents []string
+	switch v := args["opponent"].(type) {
+	case nil:
+	case string:
+		if name := strings.TrimSpace(v); name != "" {
+			opponents = append(opponents, name)
+		}
+	case []interface{}:
+		for _, item := range v {
+			name, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("opponent must be a string or an array of strings")
+			}
+			if name = strings.TrimSpace(name); name != "" {
+				opponents = append(opponents, name)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("opponent must be a string or an array of strings")
+	}
+	return opponents, nil
+}
+
+// clipIDsArg reads the clip_ids array argument
+func clipIDsArg(args map[string]interface{}) ([]string, error) {
+	return idsArg(args, "clip_ids", "clip")
+}
+
+// idsArg reads an array argument of IDs, e.g. clip_ids; noun names what
+// the IDs identify in the error
+func idsArg(args map[string]interface{}, key, noun string) ([]string, error) {
+	items, ok := args[key].([]interface{})
+	if !ok && args[key] != nil {
+		return nil, fmt.Errorf("%s must be an array of %s IDs", key, noun)
+	}
+	ids := make([]string, 0, len(items))
+	for _, item := range items {
+		id, ok := item.(string)
+		if !ok || id == "" {
+			return nil, fmt.Errorf("%s must be an array of %s IDs", key, noun)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func makeCreateSession(c *videoplatform.Client, sessionTypes []string, idx *index.Index) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		name, _ := req.Params.Arguments["name"].(string)
+		sessionType, err := sessionTypeArg(req.Params.Arguments, sessionTypes)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		if name == "" || sessionType == "" {
+			return mcp.NewToolResultError("name and session_type are required"), nil
+		}
+
+		createReq := videoplatform.CreateSessionRequest{
+			Name:        name,
+			SessionType: sessionType,
+		}
+
+		opponents, err := opponentsArg(req.Params.Arguments)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		createReq.Opponents = opponents
+		if location, ok := req.Params.Arguments["location"].(string); ok {
+			createReq.Location = &location
+		}
+		if scheduledStart, ok := req.Params.Arguments["scheduled_start"].(string); ok {
+			start, err := parseScheduledStart(scheduledStart, time.Now())
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			createReq.ScheduledStart = &start
+		}
+		if notes, ok := req.Params.Arguments["notes"].(string); ok && strings.TrimSpace(notes) != "" {
+			createReq.Notes = &notes
+		}
+
+		session, err := c.CreateSession(ctx, createReq)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create session: %v", err)), nil
+		}
+		idx.Upsert(*session)
+
+		data, _ := json.MarshalIndent(session, "", "  ")
+		return mcp.NewToolResultText(fmt.Sprintf("Session created successfully:\n%s", string(data))), nil
+	}
+}
+
+func makeCloneSession(c *videoplatform.Client, idx *index.Index) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		sourceID, _ := req.Params.Arguments["source_session_id"].(string)
+		if sourceID == "" {
+			return mcp.NewToolResultError("source_session_id is required"), nil
+		}
+
+		source, err := c.GetSession(ctx, sourceID)
+		if err != nil {
+			if apiStatus(err) == http.StatusNotFound {
+				return mcp.NewToolResultError(fmt.Sprintf("Source session %s not found", sourceID)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get source session: %v", err)), nil
+		}
+
+		// The schedule is specific to the source session, so it is only set
+		// when passed
+		createReq := videoplatform.CreateSessionRequest{
+			Name:        source.Name,
+			SessionType: source.SessionType,
+			Opponent:    source.Opponent,
+			Opponents:   source.Opponents,
+			Location:    source.Location,
+		}
+		if name, ok := req.Params.Arguments["name"].(string); ok && name != "" {
+			createReq.Name = name
+		}
+		if _, ok := req.Params.Arguments["opponent"]; ok {
+			opponents, err := opponentsArg(req.Params.Arguments)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			createReq.Opponent, createReq.Opponents = nil, opponents
+		}
+		if location, ok := req.Params.Arguments["location"].(string); ok {
+			createReq.Location = &location
+		}
+		if scheduledStart, ok := req.Params.Arguments["scheduled_start"].(string); ok {
+			start, err := parseScheduledStart(scheduledStart, time.Now())
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			createReq.ScheduledStart = &start
+		}
+
+		session, err := c.CreateSession(ctx, createReq)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create session: %v", err)), nil
+		}
+		idx.Upsert(*session)
+
+		data, _ := json.MarshalIndent(session, "", "  ")
+		return mcp.NewToolResultText(fmt.Sprintf("Cloned session %s as %s:\n%s", source.ID, session.ID, string(data))), nil
+	}
+}
+
+func makeUpdateSession(c *videoplatform.Client, sessionTypes []string, locks *sessionLocks, idx *index.Index) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		sessionID, _ := req.Params.Arguments["session_id"].(string)
+		if sessionID == "" {
+			return mcp.NewToolResultError("session_id is required"), nil
+		}
+
+		var updateReq videoplatform.UpdateSessionRequest
+		if name, ok := req.Params.Arguments["name"].(string); ok {
+			updateReq.Name = &name
+		}
+		if sessionType, err := sessionTypeArg(req.Params.Arguments, sessionTypes); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if sessionType != "" {
+			updateReq.SessionType = &sessionType
+		}
+		// A blank opponent or an empty list clears the opponents
+		if opponents, err := opponentsArg(req.Params.Arguments); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if req.Params.Arguments["opponent"] != nil {
+			if opponents == nil {
+				opponents = []string{}
+			}
+			updateReq.Opponents = &opponents
+		}
+		if location, ok := req.Params.Arguments["location"].(string); ok {
+			updateReq.Location = &location
+		}
+		if scheduledStart, ok := req.Params.Arguments["scheduled_start"].(string); ok {
+			start, err := parseScheduledStart(scheduledStart, time.Now())
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			updateReq.ScheduledStart = &start
+		}
+		if notes, ok := req.Params.Arguments["notes"].(string); ok {
+			updateReq.Notes = &notes
+		}
+		if updateReq.IsEmpty() {
+			return mcp.NewToolResultError("Nothing to update: pass at least one of name, session_type, opponent, location, scheduled_start or notes"), nil
+		}
+
+		if refusal := locks.guard(ctx, req, sessionID); refusal != nil {
+			return refusal, nil
+		}
+
+		session, err := c.UpdateSession(ctx, sessionID, updateReq)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to update session: %v", err)), nil
+		}
+		idx.Upsert(*session)
+
+		data, _ := json.MarshalIndent(session, "", "  ")
+		return mcp.NewToolResultText(fmt.Sprintf("Session updated:\n%s", string(data))), nil
+	}
+}
+
+func makeStartSession(c *videoplatform.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		sessionID, _ := req.Params.Arguments["session_id"].(string)
+		if sessionID == "" {
+			return mcp.NewToolResultError("session_id is required"), nil
+		}
+		channelIDs, err := idsArg(req.Params.Arguments, "channel_ids", "channel")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		exclusive, _ := req.Params.Arguments["exclusive"].(bool)
+		if exclusive && len(channelIDs) == 0 {
+			return mcp.NewToolResultError("exclusive requires channel_ids"), nil
+		}
+		if refusal := checkTransition(ctx, c, sessionID, "start"); refusal != nil {
+			return refusal, nil
+		}
+
+		var used, deactivated []bulkChannel
+		if len(channelIDs) > 0 {
+			var refusal *mcp.CallToolResult
+			if used, deactivated, refusal = assignSessionChannels(ctx, c, req, channelIDs, exclusive); refusal != nil {
+				return refusal, nil
+			}
+		}
+
+		session, err := c.StartSession(ctx, sessionID)
+		if err != nil {
+			return transitionError(ctx, c, sessionID, "start", err), nil
+		}
+
+		text := fmt.Sprintf("Session '%s' started successfully. Status: %s", session.Name, session.Status)
+		if len(used) > 0 {
+			text += "\nRecording from: " + channelNames(used)
+		}
+		if len(deactivated) > 0 {
+			text += "\nDeactivated: " + channelNames(deactivated)
+		}
+		return mcp.NewToolResultText(text), nil
+	}
+}
+
+func makePauseSession(c *videoplatform.Client, locks *sessionLocks) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		sessionID, _ := req.Params.Arguments["session_id"].(string)
+		if sessionID == "" {
+			return mcp.NewToolResultError("session_id is required"), nil
+		}
+		if refusal := locks.guard(ctx, req, sessionID); refusal != nil {
+			return refusal, nil
+		}
+		if refusal := checkTransition(ctx, c, sessionID, "pause"); refusal != nil {
+			return refusal, nil
+		}
+
+		session, err := c.PauseSession(ctx, sessionID)
+		if err != nil {
+			return transitionError(ctx, c, sessionID, "pause", err), nil
+		}
+
+		return mcp.NewToolResultText(fmt.Sprintf("Session '%s' paused. Status: %s", session.Name, session.Status)), nil
+	}
+}
+
+func makeResumeSession(c *videoplatform.Client, locks *sessionLocks) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		sessionID, _ := req.Params.Arguments["session_id"].(string)
+		if sessionID == "" {
+			return mcp.NewToolResultError("session_id is required"), nil
+		}
+		if refusal := locks.guard(ctx, req, sessionID); refusal != nil {
+			return refusal, nil
+		}
+		if refusal := checkTransition(ctx, c, sessionID, "resume"); refusal != nil {
+			return refusal, nil
+		}
+
+		session, err := c.ResumeSession(ctx, sessionID)
+		if err != nil {
+			return transitionError(ctx, c, sessionID, "resume", err), nil
+		}
+
+		return mcp.NewToolResultText(fmt.Sprintf("Session '%s' resumed. Status: %s", session.Name, session.Status)), nil
+	}
+}
+
+func makeCompleteSession(c *videoplatform.Client, locks *sessionLocks) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		sessionID, _ := req.Params.Arguments["session_id"].(string)
+		if sessionID == "" {
+			return mcp.NewToolResultError("session_id is required"), nil
+		}
+		var final videoplatform.CompleteSessionRequest
+		var err error
+		if final.OurScore, err = scoreArg(req.Params.Arguments, "our_score"); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if final.TheirScore, err = scoreArg(req.Params.Arguments, "their_score"); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if result, ok := req.Params.Arguments["result"].(string); ok && result != "" {
+			final.Result = &result
+		}
+		if notes, ok := req.Params.Arguments["notes"].(string); ok && notes != "" {
+			final.Notes = &notes
+		}
+
+		if refusal := locks.guard(ctx, req, sessionID); refusal != nil {
+			return refusal, nil
+		}
+		if refusal := checkTransition(ctx, c, sessionID, "complete"); refusal != nil {
+			return refusal, nil
+		}
+
+		// Without any final-score arguments the platform gets no body, as
+		// before they existed
+		var session *videoplatform.Session
+		if final == (videoplatform.CompleteSessionRequest{}) {
+			session, err = c.CompleteSession(ctx, sessionID)
+		} else {
+			session, err = c.CompleteSessionWithResult(ctx, sessionID, final)
+		}
+		if err != nil {
+			return transitionError(ctx, c, sessionID, "complete", err), nil
+		}
+
+		data, _ := json.MarshalIndent(session, "", "  ")
+		return mcp.NewToolResultText(fmt.Sprintf("Session completed:\n%s", string(data))), nil
+	}
+}
+
+// scoreArg reads an optional non-negative whole-number score argument
+func scoreArg(args map[string]interface{}, key string) (*int, error) {
+	score, ok := args[key].(float64)
+	if !ok {
+		return nil, nil
+	}
+	if score < 0 || score != math.Trunc(score) {
+		return nil, fmt.Errorf("%s must be a whole number of at least 0", key)
+	}
+	n := int(score)
+	return &n, nil
+}
+
+func makeCancelSession(c *videoplatform.Client, locks *sessionLocks) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		sessionID, _ := req.Params.Arguments["session_id"].(string)
+		if sessionID == "" {
+			return mcp.NewToolResultError("session_id is required"), nil
+		}
+		if refusal := locks.guard(ctx, req, sessionID); refusal != nil {
+			return refusal, nil
+		}
+
+		if refusal := checkTransition(ctx, c, sessionID, "cancel"); refusal != nil {
+			return refusal, nil
+		}
+
+		var cancelReq videoplatform.CancelSessionRequest
+		if reason, _ := req.Params.Arguments["reason"].(string); reason != "" {
+			cancelReq.Reason = &reason
+		}
+		session, err := c.CancelSession(ctx, sessionID, cancelReq)
+		if err != nil {
+			return transitionError(ctx, c, sessionID, "cancel", err), nil
+		}
+
+		text := fmt.Sprintf("Session '%s' cancelled. Status: %s", session.Name, session.Status)
+		if cancelReq.Reason != nil {
+			text += fmt.Sprintf("\nReason: %s", *cancelReq.Reason)
+		}
+		return mcp.NewToolResultText(text), nil
+	}
+}
+
+func makeArchiveSession(c *videoplatform.Client, locks *sessionLocks) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		sessionID, _ := req.Params.Arguments["session_id"].(string)
+		if sessionID == "" {
+			return mcp.NewToolResultError("session_id is required"), nil
+		}
+		if refusal := locks.guard(ctx, req, sessionID); refusal != nil {
+			return refusal, nil
+		}
+
+		current, err := c.GetSession(ctx, sessionID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get session: %v", err)), nil
+		}
+		if current.Status.IsLive() {
+			return mcp.NewToolResultError(fmt.Sprintf(
+				"Cannot archive session '%s' while it is %s; it is still recording. Complete it with complete_session first.",
+				current.Name, current.Status)), nil
+		}
+
+		session, err := c.ArchiveSession(ctx, sessionID)
+		if err != nil {
+			return transitionError(ctx, c, sessionID, "archive", err), nil
+		}
+
+		data, _ := json.MarshalIndent(session, "", "  ")
+		return mcp.NewToolResultText(fmt.Sprintf("Session archived:\n%s", string(data))), nil
+	}
+}
+
+func makeDeleteSession(c *videoplatform.Client, locks *sessionLocks, idx *index.Index) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		sessionID, _ := req.Params.Arguments["session_id"].(string)
+		if sessionID == "" {
+			return mcp.NewToolResultError("session_id is required"), nil
+		}
+
+		session, err := c.GetSession(ctx, sessionID)
+		if apiStatus(err) == http.StatusNotFound {
+			return mcp.NewToolResultError(fmt.Sprintf("Session %s not found; it may already have been deleted", sessionID)), nil
+		}
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get session: %v", err)), nil
+		}
+
+		if confirm, _ := req.Params.Arguments["confirm"].(bool); !confirm {
+			return mcp.NewToolResultText(fmt.Sprintf(
+				"Session '%s' (%s, %s) has %d clips and %d tags. Call delete_session again with confirm: true to delete it.",
+				session.Name, sessionID, session.Status, session.ClipCount, session.TagCount)), nil
+		}
+
+		if refusal := locks.guard(ctx, req, sessionID); refusal != nil {
+			return refusal, nil
+		}
+
+		if err := c.DeleteSession(ctx, sessionID); err != nil {
+			switch apiStatus(err) {
+			case http.StatusNotFound:
+				return mcp.NewToolResultError(fmt.Sprintf("Session %s not found; it may already have been deleted", sessionID)), nil
+			case http.StatusConflict:
+				return mcp.NewToolResultError(fmt.Sprintf("Cannot delete session '%s' while it is %s. Complete it with complete_session first.", session.Name, session.Status)), nil
+			default:
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to delete session: %v", err)), nil
+			}
+		}
+		idx.Remove(sessionID)
+
+		return mcp.NewToolResultText(fmt.Sprintf("Session '%s' deleted (%d clips, %d tags)", session.Name, session.ClipCount, session.TagCount)), nil
+	}
+}
+
+func makeListClips(c *videoplatform.Client, limit int, p presenter) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		params := videoplatform.ListClipsParams{Limit: limit}
+
+		if sessionID, ok := req.Params.Arguments["session_id"].(string); ok {
+			params.SessionID = sessionID
+		}
+		status, err := enumArg(req.Params.Arguments, "status", videoplatform.ClipStatuses)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		params.Status = status
+		if favorite, ok := req.Params.Arguments["favorite"].(bool); ok {
+			params.Favorite = &favorite
+		}
+		if search, ok := req.Params.Arguments["search"].(string); ok {
+			params.Search = search
+		}
+		if limit, ok := req.Params.Arguments["limit"].(float64); ok {
+			params.Limit = int(limit)
+		}
+		if offset, ok := req.Params.Arguments["offset"].(float64); ok && offset > 0 {
+			params.Offset = int(offset)
+		}
+
+		sortKey, err := sortArg(req.Params.Arguments, clipSortKeys)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		params.Sort = sortKey
+
+		for _, key := range []string{"min_duration_seconds", "max_duration_seconds"} {
+			seconds, ok := req.Params.Arguments[key].(float64)
+			if !ok {
+				continue
+			}
+			if seconds < 0 {
+				return mcp.NewToolResultError(fmt.Sprintf("%s must not be negative", key)), nil
+			}
+			if key == "min_duration_seconds" {
+				params.MinDuration = seconds
+			} else {
+				params.MaxDuration = seconds
+			}
+		}
+		if params.MaxDuration > 0 && params.MinDuration > params.MaxDuration {
+			return mcp.NewToolResultError(fmt.Sprintf("min_duration_seconds (%g) must not be more than max_duration_seconds (%g)", params.MinDuration, params.MaxDuration)), nil
+		}
+		durationFilter := params.MinDuration > 0 || params.MaxDuration > 0
+		if n, ok := req.Params.Arguments["min_rating"].(float64); ok {
+			if err := checkRating("min_rating", n); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			params.MinRating = int(n)
+		}
+		ratingFilter := params.MinRating > 0
+
+		includeTagCounts, _ := req.Params.Arguments["include_tag_counts"].(bool)
+		if includeTagCounts && params.SessionID == "" {
+			return mcp.NewToolResultError("include_tag_counts requires a session_id filter"), nil
+		}
+		deepSearch, _ := req.Params.Arguments["deep_search"].(bool)
+		if deepSearch && params.Search == "" {
+			return mcp.NewToolResultError("deep_search requires a search string"), nil
+		}
+
+		// A platform without duration or rating filtering rejects the
+		// parameters or ignores them; either way the page is filtered here
+		// instead
+		resp, err := c.ListClips(ctx, params)
+		clientFilter := false
+		if err != nil && (durationFilter || ratingFilter) && filterRejected(err) {
+			unfiltered := params
+			unfiltered.MinDuration, unfiltered.MaxDuration, unfiltered.MinRating = 0, 0, 0
+			resp, err = c.ListClips(ctx, unfiltered)
+			clientFilter = true
+		}
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list clips: %v", err)), nil
+		}
+		inRange := func(clip videoplatform.Clip) bool {
+			return clipInDurationRange(clip, params.MinDuration, params.MaxDuration) && clipRatedAtLeast(clip, params.MinRating)
+		}
+		if (durationFilter || ratingFilter) && !clientFilter {
+			clientFilter = slices.ContainsFunc(resp.Data, func(clip videoplatform.Clip) bool { return !inRange(clip) })
+		}
+
+		// Tag matches aren't paged, so they're added to the first page only.
+		// A session's tags are fetched once for both the deep search and
+		// the counts.
+		searchTags := deepSearch && params.Offset == 0
+		uncounted := func(clip videoplatform.Clip) bool { return clip.TagCount == nil }
+		var sessionTags []videoplatform.Tag
+		if params.SessionID != "" && (searchTags || (includeTagCounts && slices.ContainsFunc(resp.Data, uncounted))) {
+			if sessionTags, err = c.ListAllTags(ctx, videoplatform.ListTagsParams{SessionID: params.SessionID}); err != nil {
+				if searchTags {
+					return mcp.NewToolResultError(fmt.Sprintf("Failed to search tags: %v", err)), nil
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to count tags: %v", err)), nil
+			}
+		}
+
+		var tagged []videoplatform.Clip
+		var tagsSearched, tagsTotal int
+		if searchTags {
+			tagged, tagsSearched, tagsTotal, err = clipsMatchingTags(ctx, c, params, sessionTags, resp.Data)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to search tags: %v", err)), nil
+			}
+		}
+
+		if includeTagCounts {
+			annotateTagCounts(sessionTags, resp.Data)
+			annotateTagCounts(sessionTags, tagged)
+		}
+
+		e := newListEnvelope(resp.Data, resp).withRequested(params.Limit, params.Offset)
+		if clientFilter {
+			e = e.filterPage(inRange)
+			var filters []string
+			if durationFilter {
+				filters = append(filters, "duration")
+			}
+			if ratingFilter {
+				filters = append(filters, "rating")
+			}
+			e.Notice = fmt.Sprintf("The platform doesn't filter clips by %s, so this page was filtered here: %d of its %d clips matched. total and offset count all clips.", joinOr(filters), len(e.Data), e.pageLen())
+		}
+		if !deepSearch {
+			return listResult(e, "clips", e.nextPageHint(), p), nil
+		}
+
+		merged := withTagMatches(e, tagged)
+		if len(tagged) > 0 {
+			merged.Notice = joinNotices(merged.Notice, fmt.Sprintf("%d clips matched via tag notes or labels and were added after the title matches; total and offset count title matches only.", len(tagged)))
+		}
+		if tagsSearched < tagsTotal {
+			merged.Notice = joinNotices(merged.Notice, fmt.Sprintf("Only the first %d of %d tags were searched; pass session_id to search all of a session's tags.", tagsSearched, tagsTotal))
+		}
+		return listResult(merged, "clips", merged.nextPageHint(), p), nil
+	}
+}
+
+// clipInDurationRange reports whether a clip's length lies within the
+// bounds, in seconds; a zero bound is open. Clips of unknown length are
+// kept so nothing is silently dropped.
+func clipInDurationRange(clip videoplatform.Clip, minSeconds, maxSeconds float64) bool {
+	d := clipDuration(clip)
+	if d <= 0 {
+		return true
+	}
+	return (minSeconds <= 0 || d >= minSeconds) && (maxSeconds <= 0 || d <= maxSeconds)
+}
+
+// clipRatedAtLeast reports whether a clip is rated minRating or higher;
+// unrated clips only pass when there's no minimum
+func clipRatedAtLeast(clip videoplatform.Clip, minRating int) bool {
+	return minRating <= 0 || (clip.Rating != nil && *clip.Rating >= minRating)
+}
+
+// annotateTagCounts fills in TagCount on clips the platform didn't count
+// from the tags of their session
+func annotateTagCounts(tags []videoplatform.Tag, clips []videoplatform.Clip) {
+	var counts map[string]int
+	for i := range clips {
+		if clips[i].TagCount != nil {
+			continue
+		}
+		if counts == nil {
+			counts = make(map[string]int)
+			for _, tag := range tags {
+				counts[tag.ClipID]++
+			}
+		}
+		count := counts[clips[i].ID]
+		clips[i].TagCount = &count
+	}
+}
+
+func makeGetClip(c *videoplatform.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		clipID, _ := req.Params.Arguments["clip_id"].(string)
+		if clipID == "" {
+			return mcp.NewToolResultError("clip_id is required"), nil
+		}
+		includeTags, _ := req.Params.Arguments["include_tags"].(bool)
+		recordView, _ := req.Params.Arguments["record_view"].(bool)
+
+		clip, err := c.GetClip(ctx, clipID)
+		if err != nil {
+			if apiStatus(err) == http.StatusNotFound {
+				return mcp.NewToolResultError(fmt.Sprintf("Clip %s not found", clipID)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get clip: %v", err)), nil
+		}
+
+		// The view is a side effect of the review; failing to count it
+		// shouldn't keep the clip from the reviewer
+		var viewNote string
+		if recordView {
+			if err := c.RecordView(ctx, clipID); err != nil {
+				viewNote = fmt.Sprintf("\n\nThe view was not recorded: %v", err)
+			} else {
+				clip.ViewCount++
+			}
+		}
+
+		var data []byte
+		if !includeTags {
+			data, _ = json.MarshalIndent(clip, "", "  ")
+		} else {
+			tags, err := c.ListAllTags(ctx, videoplatform.ListTagsParams{ClipID: clipID})
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to list tags: %v", err)), nil
+			}
+			if tags == nil {
+				tags = []videoplatform.Tag{}
+			}
+			result := struct {
+				*videoplatform.Clip
+				Tags []videoplatform.Tag `json:"tags"`
+			}{clip, tags}
+			data, _ = json.MarshalIndent(result, "", "  ")
+		}
+		return mcp.NewToolResultText(string(data) + viewNote), nil
+	}
+}
+
+// maxBatchClips caps how many clips get_clips_batch fetches per call
+const maxBatchClips = 50
+
+func makeGetClipsBatch(c *videoplatform.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		clipIDs, err := clipIDsArg(req.Params.Arguments)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		switch {
+		case len(clipIDs) == 0:
+			return mcp.NewToolResultError("clip_ids must list at least one clip"), nil
+		case len(clipIDs) > maxBatchClips:
+			return mcp.NewToolResultError(fmt.Sprintf("clip_ids lists %d clips; fetch at most %d per call", len(clipIDs), maxBatchClips)), nil
+		}
+
+		type failure struct {
+			ClipID string `json:"clip_id"`
+			Error  string `json:"error"`
+		}
+		result := struct {
+			Clips  []videoplatform.Clip `json:"clips"`
+			Failed []failure            `json:"failed"`
+		}{Clips: []videoplatform.Clip{}, Failed: []failure{}}
+		for _, r := range c.GetClipsInOrder(ctx, clipIDs) {
+			switch {
+			case r.Err == nil:
+				result.Clips = append(result.Clips, *r.Clip)
+			case apiStatus(r.Err) == http.StatusNotFound:
+				result.Failed = append(result.Failed, failure{r.ID, "clip not found"})
+			default:
+				result.Failed = append(result.Failed, failure{r.ID, r.Err.Error()})
+			}
+		}
+
+		data, _ := json.MarshalIndent(result, "", "  ")
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
+
+func makeRecordView(c *videoplatform.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		clipID, _ := req.Params.Arguments["clip_id"].(string)
+		if clipID == "" {
+			return mcp.NewToolResultError("clip_id is required"), nil
+		}
+
+		if err := c.RecordView(ctx, clipID); err != nil {
+			if apiStatus(err) == http.StatusNotFound {
+				return mcp.NewToolResultError(fmt.Sprintf("Clip %s not found", clipID)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to record view: %v", err)), nil
+		}
+		return mcp.NewToolResultText(fmt.Sprintf("Recorded a view of clip %s", clipID)), nil
+	}
+}
+
+func makeGetClipURL(c *videoplatform.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		clipID, _ := req.Params.Arguments["clip_id"].(string)
+		if clipID == "" {
+			return mcp.NewToolResultError("clip_id is required"), nil
+		}
+		urlType, err := enumArg(req.Params.Arguments, "url_type", videoplatform.ClipURLTypes)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if urlType == "" {
+			urlType = videoplatform.ClipURLStream
+		}
+
+		link, err := c.GetClipURL(ctx, clipID, urlType)
+		switch {
+		case apiStatus(err) == http.StatusNotFound:
+			return mcp.NewToolResultError(fmt.Sprintf("Clip %s not found, or it has no %s media", clipID, urlType)), nil
+		case apiStatus(err) == http.StatusGone:
+			return mcp.NewToolResultError(fmt.Sprintf("The media of clip %s has expired or been deleted", clipID)), nil
+		case err != nil:
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get clip URL: %v", err)), nil
+		case link.URL == "":
+			return mcp.NewToolResultError(fmt.Sprintf("Clip %s has no %s media yet; check its status with get_clip", clipID, urlType)), nil
+		}
+		if link.ExpiresAt != nil {
+			if expires, err := time.Parse(time.RFC3339, *link.ExpiresAt); err == nil && !expires.After(time.Now()) {
+				return mcp.NewToolResultError(fmt.Sprintf("The %s link for clip %s expired at %s", urlType, clipID, *link.ExpiresAt)), nil
+			}
+		}
+
+		result := struct {
+			ClipID string                    `json:"clip_id"`
+			Type   videoplatform.ClipURLType `json:"type"`
+			*videoplatform.ClipURL
+		}{clipID, urlType, link}
+		data, _ := json.MarshalIndent(result, "", "  ")
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
+
+func makeCreateClip(c *videoplatform.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var createReq videoplatform.CreateClipRequest
+		createReq.SessionID, _ = req.Params.Arguments["session_id"].(string)
+		createReq.ChannelID, _ = req.Params.Arguments["channel_id"].(string)
+		createReq.StartTime, _ = req.Params.Arguments["start_time"].(string)
+		createReq.EndTime, _ = req.Params.Arguments["end_time"].(string)
+		if createReq.SessionID == "" || createReq.ChannelID == "" || createReq.StartTime == "" || createReq.EndTime == "" {
+			return mcp.NewToolResultError("session_id, channel_id, start_time and end_time are required"), nil
+		}
+		start, err := time.Parse(time.RFC3339, createReq.StartTime)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("invalid start_time %q: must be RFC 3339, e.g. 2026-10-17T19:04:10Z", createReq.StartTime)), nil
+		}
+		end, err := time.Parse(time.RFC3339, createReq.EndTime)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("invalid end_time %q: must be RFC 3339, e.g. 2026-10-17T19:04:22Z", createReq.EndTime)), nil
+		}
+		if !end.After(start) {
+			return mcp.NewToolResultError("end_time must be after start_time"), nil
+		}
+		if title, ok := req.Params.Arguments["title"].(string); ok && strings.TrimSpace(title) != "" {
+			createReq.Title = &title
+		}
+
+		clip, err := c.CreateClip(ctx, createReq)
+		if err != nil {
+			if apiStatus(err) == http.StatusNotFound {
+				return mcp.NewToolResultError(fmt.Sprintf("Session %s or channel %s not found", createReq.SessionID, createReq.ChannelID)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create clip: %v", err)), nil
+		}
+
+		data, _ := json.MarshalIndent(clip, "", "  ")
+		return mcp.NewToolResultText(fmt.Sprintf("Created clip %s (%g seconds):\n%s", clip.ID, end.Sub(start).Seconds(), string(data))), nil
+	}
+}
+
+func makeUpdateClip(c *videoplatform.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		clipID, _ := req.Params.Arguments["clip_id"].(string)
+		if clipID == "" {
+			return mcp.NewToolResultError("clip_id is required"), nil
+		}
+
+		var updateReq videoplatform.UpdateClipRequest
+		if title, ok := req.Params.Arguments["title"].(string); ok {
+			updateReq.Title = &title
+		}
+		if description, ok := req.Params.Arguments["description"].(string); ok {
+			updateReq.Description = &description
+		}
+		if updateReq.IsEmpty() {
+			return mcp.NewToolResultError("Nothing to update: pass at least one of title or description"), nil
+		}
+
+		clip, err := c.UpdateClip(ctx, clipID, updateReq)
+		if err != nil {
+			if apiStatus(err) == http.StatusNotFound {
+				return mcp.NewToolResultError(fmt.Sprintf("Clip %s not found", clipID)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to update clip: %v", err)), nil
+		}
+
+		data, _ := json.MarshalIndent(clip, "", "  ")
+		return mcp.NewToolResultText(fmt.Sprintf("Clip updated:\n%s", string(data))), nil
+	}
+}
+
+func makeFavoriteClip(c *videoplatform.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		clipID, _ := req.Params.Arguments["clip_id"].(string)
+		if clipID == "" {
+			return mcp.NewToolResultError("clip_id is required"), nil
+		}
+		note, hasNote := req.Params.Arguments["note"].(string)
+
+		clip, err := c.FavoriteClip(ctx, clipID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to toggle favorite: %v", err)), nil
+		}
+
+		if !clip.IsFavorite {
+			msg := "Clip removed from favorites"
+			if clip.FavoriteNote != nil {
+				if _, err := c.SetFavoriteNote(ctx, clipID, videoplatform.FavoriteNoteRequest{}); err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("Clip removed from favorites but failed to clear its note: %v", err)), nil
+				}
+				msg += "; note cleared"
+			}
+			if hasNote {
+				msg += "; the note was not saved"
+			}
+			return mcp.NewToolResultText(msg), nil
+		}
+
+		if !hasNote {
+			return mcp.NewToolResultText("Clip added to favorites"), nil
+		}
+		note, truncated := capFavoriteNote(note)
+		if _, err := c.SetFavoriteNote(ctx, clipID, videoplatform.FavoriteNoteRequest{Note: &note}); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Clip added to favorites but failed to save its note: %v", err)), nil
+		}
+		if truncated {
+			return mcp.NewToolResultText(fmt.Sprintf("Clip added to favorites with note (truncated to %d characters)", maxFavoriteNoteLength)), nil
+		}
+		return mcp.NewToolResultText("Clip added to favorites with note"), nil
+	}
+}
+
+// makeSetClipFavorite sets a clip's favorite status to the state asked for.
+// The platform only offers a toggle, so it's called only when the clip isn't
+// already in that state. A note is saved whenever the clip ends up a
+// favorite, so it can also update the note of an existing favorite.
+func makeSetClipFavorite(c *videoplatform.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		clipID, _ := req.Params.Arguments["clip_id"].(string)
+		if clipID == "" {
+			return mcp.NewToolResultError("clip_id is required"), nil
+		}
+		favorite, ok := req.Params.Arguments["favorite"].(bool)
+		if !ok {
+			return mcp.NewToolResultError("favorite is required and must be true or false"), nil
+		}
+		note, hasNote := req.Params.Arguments["note"].(string)
+		if hasNote && !favorite {
+			return mcp.NewToolResultError("note can only be set with favorite: true; removing a favorite clears its note"), nil
+		}
+
+		clip, err := c.GetClip(ctx, clipID)
+		if err != nil {
+			if apiStatus(err) == http.StatusNotFound {
+				return mcp.NewToolResultError(fmt.Sprintf("Clip %s not found", clipID)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get clip: %v", err)), nil
+		}
+
+		var msg string
+		if clip.IsFavorite == favorite {
+			if !hasNote {
+				return mcp.NewToolResultText(fmt.Sprintf("Clip %s is already %s, no change", clipID, favoriteState(favorite))), nil
+			}
+			msg = fmt.Sprintf("Clip %s is already a favorite", clipID)
+		} else {
+			clip, err = c.FavoriteClip(ctx, clipID)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to toggle favorite: %v", err)), nil
+			}
+			// Someone else may have toggled it between the read and the toggle
+			if clip.IsFavorite != favorite {
+				return mcp.NewToolResultError(fmt.Sprintf("Clip %s changed while it was being updated and is now %s; check it with get_clip and try again", clipID, favoriteState(clip.IsFavorite))), nil
+			}
+			if favorite {
+				msg = fmt.Sprintf("Clip %s added to favorites", clipID)
+			}
+		}
+
+		if favorite {
+			if !hasNote {
+				return mcp.NewToolResultText(msg), nil
+			}
+			note, truncated := capFavoriteNote(note)
+			if _, err := c.SetFavoriteNote(ctx, clipID, videoplatform.FavoriteNoteRequest{Note: &note}); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("%s but failed to save its note: %v", msg, err)), nil
+			}
+			msg += "; note saved"
+			if truncated {
+				msg += fmt.Sprintf(" (truncated to %d characters)", maxFavoriteNoteLength)
+			}
+			return mcp.NewToolResultText(msg), nil
+		}
+
+		msg = fmt.Sprintf("Clip %s removed from favorites", clipID)
+		if clip.FavoriteNote != nil {
+			if _, err := c.SetFavoriteNote(ctx, clipID, videoplatform.FavoriteNoteRequest{}); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("%s but failed to clear its note: %v", msg, err)), nil
+			}
+			msg += "; note cleared"
+		}
+		return mcp.NewToolResultText(msg), nil
+	}
+}
+
+// favoriteState describes a clip's favorite status for messages
+func favoriteState(favorite bool) string {
+	if favorite {
+		return "a favorite"
+	}
+	return "not a favorite"
+}
+
+// Clip ratings grade a rep from minClipRating to maxClipRating
+const (
+	minClipRating = 1
+	maxClipRating = 5
+)
+
+// checkRating rejects ratings that aren't whole numbers on the clip scale
+func checkRating(name string, n float64) error {
+	if n != math.Trunc(n) || n < minClipRating || n > maxClipRating {
+		return fmt.Errorf("%s must be a whole number from %d to %d, got %g", name, minClipRating, maxClipRating, n)
+	}
+	return nil
+}
+
+func makeRateClip(c *videoplatform.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		clipID, _ := req.Params.Arguments["clip_id"].(string)
+		if clipID == "" {
+			return mcp.NewToolResultError("clip_id is required"), nil
+		}
+		rating, ok := req.Params.Arguments["rating"].(float64)
+		if !ok {
+			return mcp.NewToolResultError("rating is required"), nil
+		}
+		if err := checkRating("rating", rating); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		clip, err := c.RateClip(ctx, clipID, int(rating))
+		if err != nil {
+			if apiStatus(err) == http.StatusNotFound {
+				return mcp.NewToolResultError(fmt.Sprintf("Clip %s not found", clipID)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to rate clip: %v", err)), nil
+		}
+
+		data, _ := json.MarshalIndent(clip, "", "  ")
+		return mcp.NewToolResultText(fmt.Sprintf("Rated clip %s %d/%d:\n%s", clipID, int(rating), maxClipRating, data)), nil
+	}
+}
+
+// maxFavoriteNoteLength caps favorite notes so they fit as reel captions
+const maxFavoriteNoteLength = 280
+
+// capFavoriteNote trims a note and truncates it to maxFavoriteNoteLength
+// characters
+func capFavoriteNote(note string) (string, bool) {
+	note = strings.TrimSpace(note)
+	runes := []rune(note)
+	if len(runes) <= maxFavoriteNoteLength {
+		return note, false
+	}
+	return strings.TrimSpace(string(runes[:maxFavoriteNoteLength])), true
+}
+
+func makeListChannels(c *videoplatform.Client, p presenter) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		params := videoplatform.ListChannelsParams{}
+		status, err := enumArg(req.Params.Arguments, "status", videoplatform.ChannelStatuses)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		params.Status = status
+		inputType, err := enumArg(req.Params.Arguments, "input_type", videoplatform.ChannelInputTypes)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		params.InputType = inputType
+		if search, ok := req.Params.Arguments["search"].(string); ok {
+			params.Search = search
+		}
+		if group, ok := req.Params.Arguments["group"].(string); ok {
+			params.Group = strings.TrimSpace(group)
+		}
+		if limit, ok := req.Params.Arguments["limit"].(float64); ok && limit > 0 {
+			params.Limit = int(limit)
+		}
+		if offset, ok := req.Params.Arguments["offset"].(float64); ok && offset > 0 {
+			params.Offset = int(offset)
+		}
+
+		resp, err := c.ListChannelsFiltered(ctx, params)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list channels: %v", err)), nil
+		}
+
+		if includeUsage, _ := req.Params.Arguments["include_usage"].(bool); !includeUsage {
+			e := newListEnvelope(resp.Data, resp).withRequested(params.Limit, params.Offset)
+			return listResult(e, "channels", e.nextPageHint(), p), nil
+		}
+
+		channels, err := addChannelUsage(ctx, c, resp.Data)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to count channel clips: %v", err)), nil
+		}
+
+		e := newListEnvelope(channels, resp).withRequested(params.Limit, params.Offset)
+		return listResult(e, "channels", e.nextPageHint(), p), nil
+	}
+}
+
+// channelWithUsage is a channel annotated by include_usage. ClipCount is
+// nil and UsageError set when the channel's clips could not be counted.
+type channelWithUsage struct {
+	videoplatform.Channel
+	ClipCount        *int   `json:"clip_count,omitempty"`
+	MostRecentClipAt string `json:"most_recent_clip_at,omitempty"`
+	UsageError       string `json:"usage_error,omitempty"`
+}
 
-func makeListSessions(c *client.Client) server.ToolHandlerFunc {
-	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		params := client.ListSessionsParams{Limit: 20}
+// addChannelUsage annotates channels with their clip counts
+func addChannelUsage(ctx context.Context, c *videoplatform.Client, channels []videoplatform.Channel) ([]channelWithUsage, error) {
+	ids := make([]string, len(channels))
+	for i, ch := range channels {
+		ids[i] = ch.ID
+	}
+	usage, err := c.ListChannelUsage(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
 
-		if status, ok := req.Params.Arguments["status"].(string); ok {
-			params.Status = status
+	out := make([]channelWithUsage, len(channels))
+	for i, ch := range channels {
+		out[i] = channelWithUsage{
+			Channel:          ch,
+			MostRecentClipAt: usage[i].MostRecentClipAt,
+			UsageError:       usage[i].Error,
 		}
-		if sessionType, ok := req.Params.Arguments["session_type"].(string); ok {
-			params.SessionType = sessionType
+		if usage[i].Error == "" {
+			count := usage[i].ClipCount
+			out[i].ClipCount = &count
 		}
-		if limit, ok := req.Params.Arguments["limit"].(float64); ok {
-			params.Limit = int(limit)
+	}
+	return out, nil
+}
+
+func makeGetChannel(c *videoplatform.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		channelID, _ := req.Params.Arguments["channel_id"].(string)
+		if channelID == "" {
+			return mcp.NewToolResultError("channel_id is required"), nil
 		}
 
-		resp, err := c.ListSessions(ctx, params)
+		channel, err := c.GetChannel(ctx, channelID)
 		if err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("Failed to list sessions: %v", err)), nil
+			if apiStatus(err) == http.StatusNotFound {
+				return mcp.NewToolResultError(fmt.Sprintf("Channel %s not found", channelID)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get channel: %v", err)), nil
 		}
 
-		data, _ := json.MarshalIndent(resp, "", "  ")
+		data, _ := json.MarshalIndent(channel, "", "  ")
 		return mcp.NewToolResultText(string(data)), nil
 	}
 }
 
-func makeCreateSession(c *client.Client) server.ToolHandlerFunc {
+func makeCreateChannel(c *videoplatform.Client) server.ToolHandlerFunc {
 	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		name, _ := req.Params.Arguments["name"].(string)
-		sessionType, _ := req.Params.Arguments["session_type"].(string)
-
-		if name == "" || sessionType == "" {
-			return mcp.NewToolResultError("name and session_type are required"), nil
+		inputType, err := enumArg(req.Params.Arguments, "input_type", videoplatform.ChannelInputTypes)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
 		}
-
-		createReq := client.CreateSessionRequest{
-			Name:        name,
-			SessionType: sessionType,
+		if strings.TrimSpace(name) == "" || inputType == "" {
+			return mcp.NewToolResultError("name and input_type are required"), nil
 		}
 
-		if opponent, ok := req.Params.Arguments["opponent"].(string); ok {
-			createReq.Opponent = &opponent
+		createReq := videoplatform.CreateChannelRequest{Name: name, InputType: inputType}
+		if description, ok := req.Params.Arguments["description"].(string); ok && description != "" {
+			createReq.Description = &description
 		}
-		if location, ok := req.Params.Arguments["location"].(string); ok {
-			createReq.Location = &location
+		if group, ok := req.Params.Arguments["group"].(string); ok && strings.TrimSpace(group) != "" {
+			group = strings.TrimSpace(group)
+			createReq.Group = &group
 		}
-
-		session, err := c.CreateSession(ctx, createReq)
-		if err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("Failed to create session: %v", err)), nil
+		if inputURL, ok := req.Params.Arguments["input_url"].(string); ok && inputURL != "" {
+			createReq.InputURL = &inputURL
 		}
-
-		data, _ := json.MarshalIndent(session, "", "  ")
-		return mcp.NewToolResultText(fmt.Sprintf("Session created successfully:\n%s", string(data))), nil
-	}
-}
-
-func makeStartSession(c *client.Client) server.ToolHandlerFunc {
-	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		sessionID, _ := req.Params.Arguments["session_id"].(string)
-		if sessionID == "" {
-			return mcp.NewToolResultError("session_id is required"), nil
+		if inputType.IsNetwork() {
+			if createReq.InputURL == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("input_url is required for %s inputs", inputType)), nil
+			}
+			if err := checkInputURL(inputType, *createReq.InputURL); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+		}
+		if resolution, ok := req.Params.Arguments["resolution"].(string); ok && resolution != "" {
+			createReq.Resolution = &resolution
+		}
+		if n, ok := req.Params.Arguments["framerate"].(float64); ok {
+			if n < 1 {
+				return mcp.NewToolResultError("framerate must be at least 1"), nil
+			}
+			framerate := int(n)
+			createReq.Framerate = &framerate
 		}
 
-		session, err := c.StartSession(ctx, sessionID)
+		channel, err := c.CreateChannel(ctx, createReq)
 		if err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("Failed to start session: %v", err)), nil
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create channel: %v", err)), nil
 		}
 
-		return mcp.NewToolResultText(fmt.Sprintf("Session '%s' started successfully. Status: %s", session.Name, session.Status)), nil
+		data, _ := json.MarshalIndent(channel, "", "  ")
+		return mcp.NewToolResultText(fmt.Sprintf("Channel '%s' created with ID %s. Status: %s\n%s", channel.Name, channel.ID, channel.Status, data)), nil
 	}
 }
 
-func makePauseSession(c *client.Client) server.ToolHandlerFunc {
+func makeDeleteChannel(c *videoplatform.Client) server.ToolHandlerFunc {
 	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		sessionID, _ := req.Params.Arguments["session_id"].(string)
-		if sessionID == "" {
-			return mcp.NewToolResultError("session_id is required"), nil
+		channelID, _ := req.Params.Arguments["channel_id"].(string)
+		if channelID == "" {
+			return mcp.NewToolResultError("channel_id is required"), nil
 		}
 
-		session, err := c.PauseSession(ctx, sessionID)
+		channel, err := c.GetChannel(ctx, channelID)
+		if apiStatus(err) == http.StatusNotFound {
+			return mcp.NewToolResultError(fmt.Sprintf("Channel %s not found; it may already have been deleted", channelID)), nil
+		}
 		if err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("Failed to pause session: %v", err)), nil
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get channel: %v", err)), nil
+		}
+		if channel.Status == videoplatform.ChannelActive {
+			return mcp.NewToolResultError(fmt.Sprintf("Channel '%s' is active. Deactivate it with deactivate_channel before deleting it.", channel.Name)), nil
 		}
 
-		return mcp.NewToolResultText(fmt.Sprintf("Session '%s' paused. Status: %s", session.Name, session.Status)), nil
-	}
-}
-
-func makeCompleteSession(c *client.Client) server.ToolHandlerFunc {
-	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		sessionID, _ := req.Params.Arguments["session_id"].(string)
-		if sessionID == "" {
-			return mcp.NewToolResultError("session_id is required"), nil
+		if confirm, _ := req.Params.Arguments["confirm"].(bool); !confirm {
+			return mcp.NewToolResultText(fmt.Sprintf(
+				"Channel '%s' (%s, %s) will be deleted. Call delete_channel again with confirm: true to delete it.",
+				channel.Name, channelID, channel.Status)), nil
 		}
 
-		session, err := c.CompleteSession(ctx, sessionID)
-		if err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("Failed to complete session: %v", err)), nil
+		if err := c.DeleteChannel(ctx, channelID); err != nil {
+			switch apiStatus(err) {
+			case http.StatusNotFound:
+				return mcp.NewToolResultError(fmt.Sprintf("Channel %s not found; it may already have been deleted", channelID)), nil
+			case http.StatusConflict:
+				return mcp.NewToolResultError(fmt.Sprintf("Cannot delete channel '%s' while it is in use: %v", channel.Name, err)), nil
+			default:
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to delete channel: %v", err)), nil
+			}
 		}
 
-		data, _ := json.MarshalIndent(session, "", "  ")
-		return mcp.NewToolResultText(fmt.Sprintf("Session completed:\n%s", string(data))), nil
+		return mcp.NewToolResultText(fmt.Sprintf("Channel '%s' deleted", channel.Name)), nil
 	}
 }
 
-func makeListClips(c *client.Client) server.ToolHandlerFunc {
-	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		params := client.ListClipsParams{Limit: 20}
+// channelInError reports whether a channel is in error state or still
+// carries an error message
+func channelInError(ch *videoplatform.Channel) bool {
+	return ch.Status == videoplatform.ChannelError || (ch.ErrorMessage != nil && *ch.ErrorMessage != "")
+}
 
-		if sessionID, ok := req.Params.Arguments["session_id"].(string); ok {
-			params.SessionID = sessionID
+func makeClearChannelError(c *videoplatform.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		channelID, _ := req.Params.Arguments["channel_id"].(string)
+		if channelID == "" {
+			return mcp.NewToolResultError("channel_id is required"), nil
 		}
-		if status, ok := req.Params.Arguments["status"].(string); ok {
-			params.Status = status
+
+		channel, err := c.GetChannel(ctx, channelID)
+		if apiStatus(err) == http.StatusNotFound {
+			return mcp.NewToolResultError(fmt.Sprintf("Channel %s not found", channelID)), nil
 		}
-		if favorite, ok := req.Params.Arguments["favorite"].(bool); ok {
-			params.Favorite = &favorite
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get channel: %v", err)), nil
 		}
-		if search, ok := req.Params.Arguments["search"].(string); ok {
-			params.Search = search
+		if !channelInError(channel) {
+			return mcp.NewToolResultText(fmt.Sprintf("Channel '%s' is %s with no error to clear; nothing changed.", channel.Name, channel.Status)), nil
 		}
-		if limit, ok := req.Params.Arguments["limit"].(float64); ok {
-			params.Limit = int(limit)
+
+		if err := c.ClearChannelError(ctx, channelID); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to clear channel error: %v", err)), nil
 		}
 
-		resp, err := c.ListClips(ctx, params)
+		// The platform may re-raise the error at once if the fault persists
+		after, err := c.GetChannel(ctx, channelID)
 		if err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("Failed to list clips: %v", err)), nil
+			return mcp.NewToolResultError(fmt.Sprintf("Cleared the error on channel '%s' but checking it failed: %v", channel.Name, err)), nil
+		}
+		data, _ := json.MarshalIndent(after, "", "  ")
+		if channelInError(after) {
+			msg := "no message"
+			if after.ErrorMessage != nil && *after.ErrorMessage != "" {
+				msg = *after.ErrorMessage
+			}
+			return mcp.NewToolResultText(fmt.Sprintf("Cleared the error on channel '%s', but it errored again: %s. Check its signal with test_channel.\n%s", after.Name, msg, data)), nil
 		}
+		return mcp.NewToolResultText(fmt.Sprintf("Cleared the error on channel '%s'. Status: %s\n%s", after.Name, after.Status, data)), nil
+	}
+}
 
-		data, _ := json.MarshalIndent(resp, "", "  ")
-		return mcp.NewToolResultText(string(data)), nil
+// checkInputURL rejects input URLs a network channel couldn't connect to
+func checkInputURL(inputType videoplatform.ChannelInputType, inputURL string) error {
+	if !inputType.IsNetwork() {
+		return nil
+	}
+	if u, err := url.Parse(inputURL); err != nil || u.Scheme == "" || u.Host == "" {
+		return fmt.Errorf("input_url %q is not a valid URL, e.g. %s://10.0.0.12:554/stream1", inputURL, inputType)
 	}
+	return nil
 }
 
-func makeFavoriteClip(c *client.Client) server.ToolHandlerFunc {
+func makeUpdateChannel(c *videoplatform.Client) server.ToolHandlerFunc {
 	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		clipID, _ := req.Params.Arguments["clip_id"].(string)
-		if clipID == "" {
-			return mcp.NewToolResultError("clip_id is required"), nil
+		channelID, _ := req.Params.Arguments["channel_id"].(string)
+		if channelID == "" {
+			return mcp.NewToolResultError("channel_id is required"), nil
 		}
 
-		clip, err := c.FavoriteClip(ctx, clipID)
-		if err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("Failed to toggle favorite: %v", err)), nil
+		var updateReq videoplatform.UpdateChannelRequest
+		if name, ok := req.Params.Arguments["name"].(string); ok {
+			if strings.TrimSpace(name) == "" {
+				return mcp.NewToolResultError("name must not be empty"), nil
+			}
+			updateReq.Name = &name
+		}
+		if description, ok := req.Params.Arguments["description"].(string); ok {
+			updateReq.Description = &description
+		}
+		if group, ok := req.Params.Arguments["group"].(string); ok {
+			group = strings.TrimSpace(group)
+			updateReq.Group = &group
+		}
+		if inputURL, ok := req.Params.Arguments["input_url"].(string); ok {
+			updateReq.InputURL = &inputURL
+		}
+		if resolution, ok := req.Params.Arguments["resolution"].(string); ok {
+			updateReq.Resolution = &resolution
+		}
+		if n, ok := req.Params.Arguments["framerate"].(float64); ok {
+			if n < 1 {
+				return mcp.NewToolResultError("framerate must be at least 1"), nil
+			}
+			framerate := int(n)
+			updateReq.Framerate = &framerate
+		}
+		if updateReq.IsEmpty() {
+			return mcp.NewToolResultError("Nothing to update: pass at least one of name, description, group, input_url, resolution or framerate"), nil
 		}
 
-		status := "added to"
-		if !clip.IsFavorite {
-			status = "removed from"
+		// A new input URL is checked against the channel's input type, and an
+		// active channel keeps reading the old one until it reconnects
+		var restartWarning string
+		if updateReq.InputURL != nil {
+			current, err := c.GetChannel(ctx, channelID)
+			if err != nil {
+				if apiStatus(err) == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("Channel %s not found", channelID)), nil
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to get channel: %v", err)), nil
+			}
+			if current.InputType != nil {
+				if err := checkInputURL(*current.InputType, *updateReq.InputURL); err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+			}
+			changed := current.InputURL == nil || *current.InputURL != *updateReq.InputURL
+			if changed && current.Status == videoplatform.ChannelActive {
+				if refusal := recordingGuard(ctx, c, req, channelID, "changing the input of"); refusal != nil {
+					return refusal, nil
+				}
+				restartWarning = fmt.Sprintf("\n\nChannel '%s' is active and may keep reading its old input. Run deactivate_channel then activate_channel to switch it to the new input_url.", current.Name)
+			}
 		}
-		return mcp.NewToolResultText(fmt.Sprintf("Clip %s favorites", status)), nil
-	}
-}
 
-func makeListChannels(c *client.Client) server.ToolHandlerFunc {
-	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		resp, err := c.ListChannels(ctx)
+		channel, err := c.UpdateChannel(ctx, channelID, updateReq)
 		if err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("Failed to list channels: %v", err)), nil
+			if apiStatus(err) == http.StatusNotFound {
+				return mcp.NewToolResultError(fmt.Sprintf("Channel %s not found", channelID)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to update channel: %v", err)), nil
 		}
 
-		data, _ := json.MarshalIndent(resp, "", "  ")
-		return mcp.NewToolResultText(string(data)), nil
+		data, _ := json.MarshalIndent(channel, "", "  ")
+		return mcp.NewToolResultText(fmt.Sprintf("Channel updated:\n%s%s", data, restartWarning)), nil
 	}
 }
 
-func makeActivateChannel(c *client.Client) server.ToolHandlerFunc {
+func makeActivateChannel(c *videoplatform.Client) server.ToolHandlerFunc {
 	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		channelID, _ := req.Params.Arguments["channel_id"].(string)
 		if channelID == "" {
@@ -464,13 +3454,17 @@ func makeActivateChannel(c *client.Client) server.ToolHandlerFunc {
 	}
 }
 
-func makeDeactivateChannel(c *client.Client) server.ToolHandlerFunc {
+func makeDeactivateChannel(c *videoplatform.Client) server.ToolHandlerFunc {
 	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		channelID, _ := req.Params.Arguments["channel_id"].(string)
 		if channelID == "" {
 			return mcp.NewToolResultError("channel_id is required"), nil
 		}
 
+		if refusal := recordingGuard(ctx, c, req, channelID, "deactivating"); refusal != nil {
+			return refusal, nil
+		}
+
 		channel, err := c.DeactivateChannel(ctx, channelID)
 		if err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("Failed to deactivate channel: %v", err)), nil
@@ -480,9 +3474,9 @@ func makeDeactivateChannel(c *client.Client) server.ToolHandlerFunc {
 	}
 }
 
-func makeListTags(c *client.Client) server.ToolHandlerFunc {
+func makeListTags(c *videoplatform.Client, rule stats.Thresholds, limit int, p presenter) server.ToolHandlerFunc {
 	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		params := client.ListTagsParams{Limit: 50}
+		params := videoplatform.ListTagsParams{Limit: limit}
 
 		if sessionID, ok := req.Params.Arguments["session_id"].(string); ok {
 			params.SessionID = sessionID
@@ -502,62 +3496,472 @@ func makeListTags(c *client.Client) server.ToolHandlerFunc {
 		if limit, ok := req.Params.Arguments["limit"].(float64); ok {
 			params.Limit = int(limit)
 		}
+		if offset, ok := req.Params.Arguments["offset"].(float64); ok && offset > 0 {
+			params.Offset = int(offset)
+		}
+		if err := checkPlayFields(req.Params.Arguments); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		params.Formation, _ = req.Params.Arguments["formation"].(string)
+		params.Result, _ = req.Params.Arguments["result"].(string)
+		params.Label, _ = req.Params.Arguments["label"].(string)
+		if down, ok := req.Params.Arguments["down"].(float64); ok {
+			params.Down = int(down)
+		}
+		if quarter, ok := req.Params.Arguments["quarter"].(float64); ok {
+			params.Quarter = int(quarter)
+		}
+		fieldFilters := tagFieldFilters(params)
 
+		// A platform without these filters rejects the parameters or
+		// ignores them; either way the page is filtered here instead
 		resp, err := c.ListTags(ctx, params)
+		clientFilter := false
+		if err != nil && len(fieldFilters) > 0 && filterRejected(err) {
+			unfiltered := params
+			unfiltered.Formation, unfiltered.Result, unfiltered.Label, unfiltered.Down, unfiltered.Quarter = "", "", "", 0, 0
+			resp, err = c.ListTags(ctx, unfiltered)
+			clientFilter = true
+		}
 		if err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("Failed to list tags: %v", err)), nil
 		}
+		matches := func(tag videoplatform.Tag) bool { return tagMatchesFields(tag, params) }
+		if len(fieldFilters) > 0 && !clientFilter {
+			clientFilter = slices.ContainsFunc(resp.Data, func(tag videoplatform.Tag) bool { return !matches(tag) })
+		}
 
-		data, _ := json.MarshalIndent(resp, "", "  ")
-		return mcp.NewToolResultText(string(data)), nil
+		tags := make([]tagWithClip, len(resp.Data))
+		for i, play := range rule.Annotate(resp.Data) {
+			tags[i] = tagWithClip{Play: play}
+		}
+		listing := newListEnvelope(tags, resp).withRequested(params.Limit, params.Offset)
+		if clientFilter {
+			listing = listing.filterPage(func(tag tagWithClip) bool { return matches(tag.Tag) })
+			listing.Notice = fmt.Sprintf("The platform doesn't filter tags by %s, so this page was filtered here: %d of its %d tags matched. total and offset count all tags.", joinOr(fieldFilters), len(listing.Data), listing.pageLen())
+		}
+		if includeClipContext, _ := req.Params.Arguments["include_clip_context"].(bool); includeClipContext {
+			listing.Notice = joinNotices(listing.Notice, addClipContext(ctx, c, listing.Data))
+		}
+
+		return listResult(listing, "tags", listing.nextPageHint(), p), nil
+	}
+}
+
+// tagFieldFilters names the play-field filters set in params, which older
+// platforms may not apply
+func tagFieldFilters(params videoplatform.ListTagsParams) []string {
+	var filters []string
+	for _, f := range []struct {
+		name string
+		set  bool
+	}{
+		{"formation", params.Formation != ""},
+		{"result", params.Result != ""},
+		{"down", params.Down > 0},
+		{"quarter", params.Quarter > 0},
+		{"label", params.Label != ""},
+	} {
+		if f.set {
+			filters = append(filters, f.name)
+		}
+	}
+	return filters
+}
+
+// tagMatchesFields reports whether a tag passes the play-field filters in
+// params. Text fields and labels match ignoring case.
+func tagMatchesFields(tag videoplatform.Tag, params videoplatform.ListTagsParams) bool {
+	textMatches := func(field *string, want string) bool {
+		return want == "" || field != nil && strings.EqualFold(strings.TrimSpace(*field), strings.TrimSpace(want))
 	}
+	intMatches := func(field *int, want int) bool {
+		return want == 0 || field != nil && *field == want
+	}
+	if !textMatches(tag.Formation, params.Formation) || !textMatches(tag.Result, params.Result) ||
+		!intMatches(tag.Down, params.Down) || !intMatches(tag.Quarter, params.Quarter) {
+		return false
+	}
+	return params.Label == "" || slices.ContainsFunc(tag.Labels, func(label string) bool {
+		return strings.EqualFold(label, strings.TrimSpace(params.Label))
+	})
+}
+
+// maxClipContextTags bounds how many tags list_tags will enrich with clip
+// context, since each distinct clip costs a request
+const maxClipContextTags = 100
+
+// deletedClipTitle marks tags whose clip no longer exists
+const deletedClipTitle = "(deleted clip)"
+
+// tagWithClip is a tag with optional context from its clip
+type tagWithClip struct {
+	stats.Play
+	ClipTitle     *string  `json:"clip_title,omitempty"`
+	ClipStartTime string   `json:"clip_start_time,omitempty"`
+	ClipDuration  *float64 `json:"clip_duration,omitempty"`
+}
+
+// addClipContext fills in clip details on each tag, fetching every distinct
+// clip once. It returns a notice when enrichment was skipped or incomplete.
+func addClipContext(ctx context.Context, c *videoplatform.Client, tags []tagWithClip) string {
+	if len(tags) > maxClipContextTags {
+		return fmt.Sprintf("clip context skipped: page has %d tags, limit is %d", len(tags), maxClipContextTags)
+	}
+
+	ids := make([]string, len(tags))
+	for i, tag := range tags {
+		ids[i] = tag.ClipID
+	}
+	clips, err := c.GetClips(ctx, ids)
+	if err != nil {
+		return fmt.Sprintf("clip context unavailable: %v", err)
+	}
+
+	deleted := deletedClipTitle
+	for i := range tags {
+		clip, ok := clips[tags[i].ClipID]
+		if !ok {
+			tags[i].ClipTitle = &deleted
+			continue
+		}
+		duration := clip.DurationSeconds
+		tags[i].ClipTitle = clip.Title
+		tags[i].ClipStartTime = clip.StartTime
+		tags[i].ClipDuration = &duration
+	}
+	return ""
+}
+
+// createTagRequest builds a create request from create_tag's arguments,
+// which bulk_create_tags also accepts for each of its tags
+func createTagRequest(args map[string]interface{}, overtime time.Duration) (videoplatform.CreateTagRequest, error) {
+	clipID, _ := args["clip_id"].(string)
+	sessionID, _ := args["session_id"].(string)
+
+	if clipID == "" || sessionID == "" {
+		return videoplatform.CreateTagRequest{}, errors.New("clip_id and session_id are required")
+	}
+
+	if err := checkPlayFields(args); err != nil {
+		return videoplatform.CreateTagRequest{}, err
+	}
+
+	createReq := videoplatform.CreateTagRequest{
+		ClipID:    clipID,
+		SessionID: sessionID,
+	}
+
+	if playType, ok := args["play_type"].(string); ok {
+		createReq.PlayType = &playType
+	}
+	if formation, ok := args["formation"].(string); ok {
+		createReq.Formation = &formation
+	}
+	if result, ok := args["result"].(string); ok {
+		createReq.Result = &result
+	}
+	if down, ok := args["down"].(float64); ok {
+		d := int(down)
+		createReq.Down = &d
+	}
+	if distance, ok := args["distance"].(float64); ok {
+		d := int(distance)
+		createReq.Distance = &d
+	}
+	if yardsGained, ok := args["yards_gained"].(float64); ok {
+		y := int(yardsGained)
+		createReq.YardsGained = &y
+	}
+	if notes, ok := args["notes"].(string); ok {
+		createReq.Notes = &notes
+	}
+	if quarter, ok := args["quarter"].(float64); ok {
+		q := int(quarter)
+		createReq.Quarter = &q
+	}
+	labels, _, err := labelsArg(args)
+	if err != nil {
+		return videoplatform.CreateTagRequest{}, err
+	}
+	if len(labels) > 0 {
+		createReq.Labels = labels
+	}
+	if clock, ok := args["game_clock"].(string); ok {
+		if createReq.Quarter != nil {
+			return videoplatform.CreateTagRequest{}, errors.New("Pass quarter or game_clock, not both")
+		}
+		gc, err := parseGameClock(clock, overtime)
+		if err != nil {
+			return videoplatform.CreateTagRequest{}, err
+		}
+		createReq.Quarter = &gc.Quarter
+		createReq.Labels = withClockLabel(createReq.Labels, gc)
+	}
+	return createReq, nil
 }
 
-func makeCreateTag(c *client.Client) server.ToolHandlerFunc {
+func makeCreateTag(c *videoplatform.Client, overtime time.Duration, templates *tagTemplateStore) server.ToolHandlerFunc {
 	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		clipID, _ := req.Params.Arguments["clip_id"].(string)
-		sessionID, _ := req.Params.Arguments["session_id"].(string)
+		args := req.Params.Arguments
+		if name, _ := args["template"].(string); strings.TrimSpace(name) != "" {
+			if templates == nil {
+				return mcp.NewToolResultError(errNoDataDir.Error()), nil
+			}
+			t, ok, err := templates.Get(strings.TrimSpace(name))
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to read tag templates: %v", err)), nil
+			}
+			if !ok {
+				return mcp.NewToolResultError(fmt.Sprintf("Tag template %q not found; list_tag_templates shows the saved ones", name)), nil
+			}
+			args = withTemplate(t, args)
+		}
+
+		createReq, err := createTagRequest(args, overtime)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		tag, err := c.CreateTag(ctx, createReq)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create tag: %v", err)), nil
+		}
+
+		data, _ := json.MarshalIndent(tag, "", "  ")
+		return mcp.NewToolResultText(fmt.Sprintf("Tag created:\n%s", string(data))), nil
+	}
+}
+
+func makeGetTag(c *videoplatform.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		tagID, _ := req.Params.Arguments["tag_id"].(string)
+		if tagID == "" {
+			return mcp.NewToolResultError("tag_id is required"), nil
+		}
+
+		tag, err := c.GetTag(ctx, tagID)
+		if err != nil {
+			if apiStatus(err) == http.StatusNotFound {
+				return mcp.NewToolResultError(fmt.Sprintf("Tag %s not found", tagID)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get tag: %v", err)), nil
+		}
+
+		data, _ := json.MarshalIndent(tag, "", "  ")
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
+
+// labelsArg reads the labels array argument; ok is false when it's absent
+func labelsArg(args map[string]interface{}) (labels []string, ok bool, err error) {
+	if args["labels"] == nil {
+		return nil, false, nil
+	}
+	items, isArray := args["labels"].([]interface{})
+	if !isArray {
+		return nil, false, fmt.Errorf("labels must be an array of strings")
+	}
+	labels = make([]string, 0, len(items))
+	for _, item := range items {
+		label, isString := item.(string)
+		if !isString {
+			return nil, false, fmt.Errorf("labels must be an array of strings")
+		}
+		if label = strings.TrimSpace(label); label != "" {
+			labels = append(labels, label)
+		}
+	}
+	return labels, true, nil
+}
 
-		if clipID == "" || sessionID == "" {
-			return mcp.NewToolResultError("clip_id and session_id are required"), nil
+func makeUpdateTag(c *videoplatform.Client, overtime time.Duration) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		tagID, _ := req.Params.Arguments["tag_id"].(string)
+		if tagID == "" {
+			return mcp.NewToolResultError("tag_id is required"), nil
 		}
 
-		createReq := client.CreateTagRequest{
-			ClipID:    clipID,
-			SessionID: sessionID,
+		if err := checkPlayFields(req.Params.Arguments); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
 		}
 
+		var updateReq videoplatform.UpdateTagRequest
 		if playType, ok := req.Params.Arguments["play_type"].(string); ok {
-			createReq.PlayType = &playType
+			updateReq.PlayType = &playType
 		}
 		if formation, ok := req.Params.Arguments["formation"].(string); ok {
-			createReq.Formation = &formation
+			updateReq.Formation = &formation
 		}
 		if result, ok := req.Params.Arguments["result"].(string); ok {
-			createReq.Result = &result
+			updateReq.Result = &result
 		}
 		if down, ok := req.Params.Arguments["down"].(float64); ok {
 			d := int(down)
-			createReq.Down = &d
+			updateReq.Down = &d
 		}
 		if distance, ok := req.Params.Arguments["distance"].(float64); ok {
 			d := int(distance)
-			createReq.Distance = &d
+			updateReq.Distance = &d
 		}
 		if yardsGained, ok := req.Params.Arguments["yards_gained"].(float64); ok {
 			y := int(yardsGained)
-			createReq.YardsGained = &y
+			updateReq.YardsGained = &y
+		}
+		if quarter, ok := req.Params.Arguments["quarter"].(float64); ok {
+			q := int(quarter)
+			updateReq.Quarter = &q
 		}
 		if notes, ok := req.Params.Arguments["notes"].(string); ok {
-			createReq.Notes = &notes
+			updateReq.Notes = &notes
+		}
+		labels, hasLabels, err := labelsArg(req.Params.Arguments)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if hasLabels {
+			updateReq.Labels = &labels
 		}
 
-		tag, err := c.CreateTag(ctx, createReq)
+		clock, hasClock := req.Params.Arguments["game_clock"].(string)
+		if hasClock {
+			if updateReq.Quarter != nil {
+				return mcp.NewToolResultError("Pass quarter or game_clock, not both"), nil
+			}
+			gc, err := parseGameClock(clock, overtime)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			updateReq.Quarter = &gc.Quarter
+
+			// The clock is a label, so it joins the tag's other labels
+			if !hasLabels {
+				current, err := c.GetTag(ctx, tagID)
+				if err != nil {
+					if apiStatus(err) == http.StatusNotFound {
+						return mcp.NewToolResultError(fmt.Sprintf("Tag %s not found", tagID)), nil
+					}
+					return mcp.NewToolResultError(fmt.Sprintf("Failed to get tag: %v", err)), nil
+				}
+				labels = current.Labels
+			}
+			labels = withClockLabel(labels, gc)
+			updateReq.Labels = &labels
+		}
+
+		if updateReq.IsEmpty() {
+			return mcp.NewToolResultError("Nothing to update: pass at least one of play_type, formation, result, down, distance, yards_gained, quarter, game_clock, notes or labels"), nil
+		}
+
+		tag, err := c.UpdateTag(ctx, tagID, updateReq)
 		if err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("Failed to create tag: %v", err)), nil
+			if apiStatus(err) == http.StatusNotFound {
+				return mcp.NewToolResultError(fmt.Sprintf("Tag %s not found", tagID)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to update tag: %v", err)), nil
 		}
 
 		data, _ := json.MarshalIndent(tag, "", "  ")
-		return mcp.NewToolResultText(fmt.Sprintf("Tag created:\n%s", string(data))), nil
+		return mcp.NewToolResultText(fmt.Sprintf("Tag %s updated:\n%s", tag.ID, data)), nil
+	}
+}
+
+func makeDeleteTag(c *videoplatform.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		tagID, _ := req.Params.Arguments["tag_id"].(string)
+		if tagID == "" {
+			return mcp.NewToolResultError("tag_id is required"), nil
+		}
+
+		tag, err := c.GetTag(ctx, tagID)
+		if apiStatus(err) == http.StatusNotFound {
+			return mcp.NewToolResultError(fmt.Sprintf("Tag %s not found; it may already have been deleted", tagID)), nil
+		}
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get tag: %v", err)), nil
+		}
+
+		if confirm, _ := req.Params.Arguments["confirm"].(bool); !confirm {
+			return mcp.NewToolResultText(fmt.Sprintf(
+				"%s will be deleted. Call delete_tag again with confirm: true to delete it.", describeTag(*tag))), nil
+		}
+
+		if err := c.DeleteTag(ctx, tagID); err != nil {
+			if apiStatus(err) == http.StatusNotFound {
+				return mcp.NewToolResultError(fmt.Sprintf("Tag %s not found; it may already have been deleted", tagID)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to delete tag: %v", err)), nil
+		}
+
+		return mcp.NewToolResultText(fmt.Sprintf("Tag %s deleted", tagID)), nil
+	}
+}
+
+// importanceNote is the line set_tag_important appends to a tag's notes
+// to record why it was flagged, e.g. "Important: busted coverage"
+func importanceNote(notes *string, important bool, reason string) string {
+	note := "Important: " + reason
+	if !important {
+		note = "Not important: " + reason
+	}
+	if notes == nil || strings.TrimSpace(*notes) == "" {
+		return note
+	}
+	return strings.TrimRight(*notes, "\n") + "\n" + note
+}
+
+func makeSetTagImportant(c *videoplatform.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		tagID, _ := req.Params.Arguments["tag_id"].(string)
+		if tagID == "" {
+			return mcp.NewToolResultError("tag_id is required"), nil
+		}
+		important, ok := req.Params.Arguments["important"].(bool)
+		if !ok {
+			return mcp.NewToolResultError("important is required"), nil
+		}
+		reason, _ := req.Params.Arguments["reason"].(string)
+		reason = strings.TrimSpace(reason)
+
+		tag, err := c.SetTagImportant(ctx, tagID, important)
+		if err != nil {
+			if apiStatus(err) == http.StatusNotFound {
+				return mcp.NewToolResultError(fmt.Sprintf("Tag %s not found", tagID)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to set tag importance: %v", err)), nil
+		}
+
+		state := "important"
+		if !important {
+			state = "not important"
+		}
+		if reason != "" {
+			notes := importanceNote(tag.Notes, important, reason)
+			updated, err := c.UpdateTag(ctx, tagID, videoplatform.UpdateTagRequest{Notes: &notes})
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Tag %s marked %s, but failed to add the reason to its notes: %v", tagID, state, err)), nil
+			}
+			tag = updated
+		}
+
+		data, _ := json.MarshalIndent(tag, "", "  ")
+		return mcp.NewToolResultText(fmt.Sprintf("Tag %s marked %s:\n%s", tag.ID, state, data)), nil
+	}
+}
+
+func makeExplainSuccess(c *videoplatform.Client, rule stats.Thresholds) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		tagID, _ := req.Params.Arguments["tag_id"].(string)
+		if tagID == "" {
+			return mcp.NewToolResultError("tag_id is required"), nil
+		}
+
+		tag, err := c.GetTag(ctx, tagID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get tag: %v", err)), nil
+		}
+
+		data, _ := json.MarshalIndent(rule.Explain(*tag), "", "  ")
+		return mcp.NewToolResultText(string(data)), nil
 	}
 }
@@ -4,13 +4,23 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
+	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
-	"github.com/Prodro21/video-mcp/internal/client"
+	"github.com/Prodro21/video-mcp/internal/config"
+	"github.com/Prodro21/video-mcp/internal/index"
+	"github.com/Prodro21/video-mcp/internal/stats"
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
 	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
 )
 
 // Helper to create a mock server with custom response
@@ -21,8 +31,8 @@ func mockServer(t *testing.T, handler http.HandlerFunc) *httptest.Server {
 func TestListSessions(t *testing.T) {
 	t.Run("successful list", func(t *testing.T) {
 		server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
-			resp := client.PaginatedResponse[client.Session]{
-				Data: []client.Session{
+			resp := videoplatform.PaginatedResponse[videoplatform.Session]{
+				Data: []videoplatform.Session{
 					{ID: "session-1", Name: "Game 1", SessionType: "game", Status: "active"},
 					{ID: "session-2", Name: "Practice 1", SessionType: "practice", Status: "scheduled"},
 				},
@@ -34,8 +44,8 @@ func TestListSessions(t *testing.T) {
 		})
 		defer server.Close()
 
-		c := client.New(server.URL)
-		handler := makeListSessions(c)
+		c := videoplatform.New(server.URL)
+		handler := makeListSessions(c, 20, nil, newPresenter(false))
 
 		req := mcp.CallToolRequest{}
 		req.Params.Arguments = map[string]interface{}{}
@@ -55,6 +65,143 @@ func TestListSessions(t *testing.T) {
 		}
 	})
 
+	t.Run("offset", func(t *testing.T) {
+		server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+			if got := r.URL.Query().Get("offset"); got != "20" {
+				t.Errorf("Expected offset=20 in query, got %q", r.URL.RawQuery)
+			}
+			sessions := make([]videoplatform.Session, 20)
+			json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Session]{Data: sessions, Total: 57})
+		})
+		defer server.Close()
+
+		handler := makeListSessions(videoplatform.New(server.URL), 20, nil, newPresenter(false))
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"offset": float64(20)}
+
+		result, err := handler(context.Background(), req)
+		if err != nil || result.IsError {
+			t.Fatalf("Expected success, got %v %v", result, err)
+		}
+		text := result.Content[0].(mcp.TextContent).Text
+		for _, want := range []string{`"total": 57`, `"limit": 20`, `"offset": 20`, "17 more sessions not shown — showing 21–40 of 57; call again with offset=40"} {
+			if !strings.Contains(text, want) {
+				t.Errorf("Expected %q in result:\n%s", want, text)
+			}
+		}
+	})
+
+	t.Run("sort", func(t *testing.T) {
+		server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+			if got := r.URL.Query().Get("sort"); got != "-scheduled_start" {
+				t.Errorf("Expected sort=-scheduled_start in query, got %q", r.URL.RawQuery)
+			}
+			json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Session]{Data: []videoplatform.Session{}})
+		})
+		defer server.Close()
+
+		handler := makeListSessions(videoplatform.New(server.URL), 20, nil, newPresenter(false))
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"sort": "-scheduled_start"}
+
+		result, err := handler(context.Background(), req)
+		if err != nil || result.IsError {
+			t.Fatalf("Expected success, got %v %v", result, err)
+		}
+	})
+
+	t.Run("search", func(t *testing.T) {
+		server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+			if got := r.URL.Query().Get("search"); got != "Jefferson" {
+				t.Errorf("Expected search=Jefferson in query, got %q", r.URL.RawQuery)
+			}
+			json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Session]{Data: []videoplatform.Session{}})
+		})
+		defer server.Close()
+
+		handler := makeListSessions(videoplatform.New(server.URL), 20, nil, newPresenter(false))
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"search": "Jefferson"}
+
+		result, err := handler(context.Background(), req)
+		if err != nil || result.IsError {
+			t.Fatalf("Expected success, got %v %v", result, err)
+		}
+	})
+
+	t.Run("session type", func(t *testing.T) {
+		var got string
+		server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+			got = r.URL.Query().Get("session_type")
+			json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Session]{Data: []videoplatform.Session{}})
+		})
+		defer server.Close()
+		c := videoplatform.New(server.URL)
+
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"session_type": "film_review"}
+		result, err := makeListSessions(c, 20, nil, newPresenter(false))(context.Background(), req)
+		if err != nil || result.IsError {
+			t.Fatalf("Expected success, got %v %v", result, err)
+		}
+		if got != "film_review" {
+			t.Errorf("session_type = %q, want film_review", got)
+		}
+
+		restricted := makeListSessions(c, 20, []string{"game", "practice"}, newPresenter(false))
+		result, _ = restricted(context.Background(), req)
+		verifyError(t, result, `invalid session_type "film_review": must be one of game or practice`)
+	})
+
+	t.Run("opponent", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			opponent interface{}
+			want     string
+			sent     bool
+		}{
+			{name: "trimmed", opponent: "  Eagles ", want: "Eagles", sent: true},
+			{name: "blank is unset", opponent: "   "},
+			{name: "absent"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+					got, sent := r.URL.Query()["opponent"]
+					if sent != tt.sent || (sent && got[0] != tt.want) {
+						t.Errorf("query %q, want opponent=%q sent=%v", r.URL.RawQuery, tt.want, tt.sent)
+					}
+					json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Session]{Data: []videoplatform.Session{}})
+				})
+				defer server.Close()
+
+				handler := makeListSessions(videoplatform.New(server.URL), 20, nil, newPresenter(false))
+				req := mcp.CallToolRequest{}
+				req.Params.Arguments = map[string]interface{}{}
+				if tt.opponent != nil {
+					req.Params.Arguments["opponent"] = tt.opponent
+				}
+
+				result, err := handler(context.Background(), req)
+				if err != nil || result.IsError {
+					t.Fatalf("Expected success, got %v %v", result, err)
+				}
+			})
+		}
+	})
+
+	t.Run("invalid sort", func(t *testing.T) {
+		handler := makeListSessions(videoplatform.New("http://localhost:1"), 20, nil, newPresenter(false))
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"sort": "name"}
+
+		result, err := handler(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		verifyError(t, result, "invalid sort")
+	})
+
 	t.Run("with filters", func(t *testing.T) {
 		server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
 			// Verify filters are passed
@@ -65,13 +212,13 @@ func TestListSessions(t *testing.T) {
 				t.Errorf("Expected session_type=game, got %s", r.URL.Query().Get("session_type"))
 			}
 
-			resp := client.PaginatedResponse[client.Session]{Data: []client.Session{}}
+			resp := videoplatform.PaginatedResponse[videoplatform.Session]{Data: []videoplatform.Session{}}
 			json.NewEncoder(w).Encode(resp)
 		})
 		defer server.Close()
 
-		c := client.New(server.URL)
-		handler := makeListSessions(c)
+		c := videoplatform.New(server.URL)
+		handler := makeListSessions(c, 20, nil, newPresenter(false))
 
 		req := mcp.CallToolRequest{}
 		req.Params.Arguments = map[string]interface{}{
@@ -93,8 +240,8 @@ func TestListSessions(t *testing.T) {
 		})
 		defer server.Close()
 
-		c := client.New(server.URL)
-		handler := makeListSessions(c)
+		c := videoplatform.New(server.URL)
+		handler := makeListSessions(c, 20, nil, newPresenter(false))
 
 		req := mcp.CallToolRequest{}
 		req.Params.Arguments = map[string]interface{}{}
@@ -117,14 +264,14 @@ func TestCreateSession(t *testing.T) {
 				t.Errorf("Expected POST, got %s", r.Method)
 			}
 
-			var req client.CreateSessionRequest
+			var req videoplatform.CreateSessionRequest
 			json.NewDecoder(r.Body).Decode(&req)
 
 			if req.Name != "New Game" {
 				t.Errorf("Expected name 'New Game', got %s", req.Name)
 			}
 
-			session := client.Session{
+			session := videoplatform.Session{
 				ID:          "new-session-id",
 				Name:        req.Name,
 				SessionType: req.SessionType,
@@ -134,8 +281,8 @@ func TestCreateSession(t *testing.T) {
 		})
 		defer server.Close()
 
-		c := client.New(server.URL)
-		handler := makeCreateSession(c)
+		c := videoplatform.New(server.URL)
+		handler := makeCreateSession(c, nil, nil)
 
 		req := mcp.CallToolRequest{}
 		req.Params.Arguments = map[string]interface{}{
@@ -155,8 +302,8 @@ func TestCreateSession(t *testing.T) {
 	})
 
 	t.Run("missing required fields", func(t *testing.T) {
-		c := client.New("http://localhost:8080")
-		handler := makeCreateSession(c)
+		c := videoplatform.New("http://localhost:8080")
+		handler := makeCreateSession(c, nil, nil)
 
 		req := mcp.CallToolRequest{}
 		req.Params.Arguments = map[string]interface{}{
@@ -172,16 +319,429 @@ func TestCreateSession(t *testing.T) {
 			t.Error("Expected error result for missing session_type")
 		}
 	})
+
+	t.Run("custom session type", func(t *testing.T) {
+		var got videoplatform.SessionType
+		server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+			var req videoplatform.CreateSessionRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			got = req.SessionType
+			json.NewEncoder(w).Encode(videoplatform.Session{ID: "new-session-id", Name: req.Name, SessionType: req.SessionType})
+		})
+		defer server.Close()
+		handler := makeCreateSession(videoplatform.New(server.URL), nil, nil)
+
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"name": "Tuesday 7v7", "session_type": " 7v7 "}
+
+		result, err := handler(context.Background(), req)
+		if err != nil || result.IsError {
+			t.Fatalf("expected success, got %v %v", result, err)
+		}
+		if got != "7v7" {
+			t.Errorf("session_type sent = %q, want 7v7", got)
+		}
+	})
+
+	t.Run("notes", func(t *testing.T) {
+		var body map[string]interface{}
+		server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+			body = nil
+			json.NewDecoder(r.Body).Decode(&body)
+			json.NewEncoder(w).Encode(videoplatform.Session{ID: "new-session-id"})
+		})
+		defer server.Close()
+		handler := makeCreateSession(videoplatform.New(server.URL), nil, nil)
+
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"name": "Scrimmage", "session_type": "scrimmage", "notes": "backup QB"}
+		if result, err := handler(context.Background(), req); err != nil || result.IsError {
+			t.Fatalf("expected success, got %v %v", result, err)
+		}
+		if body["notes"] != "backup QB" {
+			t.Errorf("notes sent = %v, want backup QB", body["notes"])
+		}
+
+		req.Params.Arguments = map[string]interface{}{"name": "Scrimmage", "session_type": "scrimmage"}
+		if result, err := handler(context.Background(), req); err != nil || result.IsError {
+			t.Fatalf("expected success, got %v %v", result, err)
+		}
+		if _, sent := body["notes"]; sent {
+			t.Errorf("request body = %v, want no notes", body)
+		}
+	})
+
+	t.Run("restricted session types", func(t *testing.T) {
+		handler := makeCreateSession(videoplatform.New("http://localhost:1"), []string{"game", "practice", "7v7"}, nil)
+
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"name": "Jamboree", "session_type": "tournament"}
+
+		result, err := handler(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		verifyError(t, result, `invalid session_type "tournament": must be one of game, practice or 7v7`)
+	})
+
+	t.Run("scheduled start", func(t *testing.T) {
+		var got *string
+		server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+			var req videoplatform.CreateSessionRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			got = req.ScheduledStart
+			json.NewEncoder(w).Encode(videoplatform.Session{ID: "new-session-id", Name: req.Name, Status: "scheduled"})
+		})
+		defer server.Close()
+		handler := makeCreateSession(videoplatform.New(server.URL), nil, nil)
+
+		for _, tt := range []struct {
+			in    string
+			check func(time.Time) bool
+		}{
+			{"2026-10-23T19:00:00-05:00", func(ts time.Time) bool { return ts.Equal(time.Date(2026, 10, 24, 0, 0, 0, 0, time.UTC)) }},
+			{"+2h", func(ts time.Time) bool { return time.Until(ts) > 119*time.Minute && time.Until(ts) <= 2*time.Hour }},
+			{"tomorrow 18:00", func(ts time.Time) bool { return ts.After(time.Now()) && ts.Hour() == 18 && ts.Minute() == 0 }},
+		} {
+			got = nil
+			req := mcp.CallToolRequest{}
+			req.Params.Arguments = map[string]interface{}{"name": "Friday Game", "session_type": "game", "scheduled_start": tt.in}
+			result, err := handler(context.Background(), req)
+			if err != nil || result.IsError {
+				t.Fatalf("%s: expected success, got %v %v", tt.in, result, err)
+			}
+			if got == nil {
+				t.Fatalf("%s: scheduled_start not sent", tt.in)
+			}
+			ts, err := time.Parse(time.RFC3339, *got)
+			if err != nil || !tt.check(ts) {
+				t.Errorf("%s: sent scheduled_start %q", tt.in, *got)
+			}
+		}
+
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"name": "Friday Game", "session_type": "game", "scheduled_start": "friday at 7"}
+		result, _ := handler(context.Background(), req)
+		verifyError(t, result, "2026-10-17T19:00:00Z")
+	})
+}
+
+func TestCloneSession(t *testing.T) {
+	location := "Field 2"
+	opponent := "Jefferson"
+	source := videoplatform.Session{ID: "session-1", Name: "Tuesday Practice", SessionType: "practice", Location: &location, Opponent: &opponent, Opponents: []string{opponent}}
+
+	clone := func(t *testing.T, args map[string]interface{}) (videoplatform.CreateSessionRequest, *mcp.CallToolResult) {
+		t.Helper()
+		var got videoplatform.CreateSessionRequest
+		server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.Method == http.MethodGet && r.URL.Path == "/api/v1/sessions/session-1":
+				json.NewEncoder(w).Encode(source)
+			case r.Method == http.MethodGet:
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`{"error":"not found"}`))
+			case r.Method == http.MethodPost && r.URL.Path == "/api/v1/sessions":
+				json.NewDecoder(r.Body).Decode(&got)
+				json.NewEncoder(w).Encode(videoplatform.Session{ID: "session-2", Name: got.Name, SessionType: got.SessionType})
+			default:
+				t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			}
+		})
+		defer server.Close()
+
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = args
+		result, err := makeCloneSession(videoplatform.New(server.URL), nil)(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return got, result
+	}
+
+	t.Run("copies source", func(t *testing.T) {
+		got, result := clone(t, map[string]interface{}{"source_session_id": "session-1"})
+		if result.IsError {
+			t.Fatalf("Unexpected tool error: %v", result.Content)
+		}
+		if got.Name != "Tuesday Practice" || got.SessionType != "practice" || got.Location == nil || *got.Location != "Field 2" {
+			t.Errorf("create request = %+v", got)
+		}
+		if !reflect.DeepEqual(got.Opponents, []string{"Jefferson"}) || got.ScheduledStart != nil {
+			t.Errorf("create request opponents = %v, scheduled_start = %v", got.Opponents, got.ScheduledStart)
+		}
+		text := result.Content[0].(mcp.TextContent).Text
+		if !strings.Contains(text, "Cloned session session-1 as session-2") {
+			t.Errorf("result should name both sessions: %s", text)
+		}
+	})
+
+	t.Run("overrides", func(t *testing.T) {
+		got, result := clone(t, map[string]interface{}{
+			"source_session_id": "session-1",
+			"name":              "Tuesday Practice (Week 6)",
+			"opponent":          []interface{}{"Lincoln", "Roosevelt"},
+			"location":          "Main Stadium",
+			"scheduled_start":   "2026-10-20T16:00:00Z",
+		})
+		if result.IsError {
+			t.Fatalf("Unexpected tool error: %v", result.Content)
+		}
+		if got.Name != "Tuesday Practice (Week 6)" || *got.Location != "Main Stadium" || got.SessionType != "practice" {
+			t.Errorf("create request = %+v", got)
+		}
+		if !reflect.DeepEqual(got.Opponents, []string{"Lincoln", "Roosevelt"}) {
+			t.Errorf("Opponents = %v", got.Opponents)
+		}
+		if got.ScheduledStart == nil || *got.ScheduledStart != "2026-10-20T16:00:00Z" {
+			t.Errorf("ScheduledStart = %v", got.ScheduledStart)
+		}
+	})
+
+	t.Run("source not found", func(t *testing.T) {
+		_, result := clone(t, map[string]interface{}{"source_session_id": "missing"})
+		verifyError(t, result, "Source session missing not found")
+	})
+
+	t.Run("missing source", func(t *testing.T) {
+		_, result := clone(t, map[string]interface{}{"name": "Copy"})
+		verifyError(t, result, "source_session_id is required")
+	})
+}
+
+func TestUpdateSession(t *testing.T) {
+	// updatePlatform records the PATCH body and reports the given lock
+	updatePlatform := func(t *testing.T, locked bool, body *map[string]interface{}) *videoplatform.Client {
+		server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.Method == http.MethodGet && r.URL.Path == "/api/v1/sessions/session-1/lock":
+				json.NewEncoder(w).Encode(videoplatform.SessionLock{SessionID: "session-1", Locked: locked, LockedBy: "coach"})
+			case r.Method == http.MethodPatch && r.URL.Path == "/api/v1/sessions/session-1":
+				json.NewDecoder(r.Body).Decode(body)
+				json.NewEncoder(w).Encode(videoplatform.Session{ID: "session-1", Name: "Week 3"})
+			default:
+				t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+				w.WriteHeader(http.StatusNotFound)
+			}
+		})
+		t.Cleanup(server.Close)
+		return videoplatform.New(server.URL)
+	}
+
+	t.Run("sends only provided fields", func(t *testing.T) {
+		var body map[string]interface{}
+		c := updatePlatform(t, false, &body)
+		handler := makeUpdateSession(c, nil, newSessionLocks(c), nil)
+
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{
+			"session_id": "session-1",
+			"opponent":   "Wildcats",
+			"location":   "",
+		}
+		result, err := handler(context.Background(), req)
+		if err != nil || result.IsError {
+			t.Fatalf("Expected success, got %v %v", result, err)
+		}
+
+		want := map[string]interface{}{"opponent": "Wildcats", "opponents": []interface{}{"Wildcats"}, "location": ""}
+		if !reflect.DeepEqual(body, want) {
+			t.Errorf("PATCH body = %v, want %v", body, want)
+		}
+	})
+
+	t.Run("notes", func(t *testing.T) {
+		var body map[string]interface{}
+		c := updatePlatform(t, false, &body)
+		handler := makeUpdateSession(c, nil, newSessionLocks(c), nil)
+
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"session_id": "session-1", "notes": "second-string offense, backup QB"}
+		result, err := handler(context.Background(), req)
+		if err != nil || result.IsError {
+			t.Fatalf("Expected success, got %v %v", result, err)
+		}
+		if want := map[string]interface{}{"notes": "second-string offense, backup QB"}; !reflect.DeepEqual(body, want) {
+			t.Errorf("PATCH body = %v, want %v", body, want)
+		}
+	})
+
+	t.Run("multiple opponents", func(t *testing.T) {
+		var body map[string]interface{}
+		c := updatePlatform(t, false, &body)
+		handler := makeUpdateSession(c, nil, newSessionLocks(c), nil)
+
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{
+			"session_id": "session-1",
+			"opponent":   []interface{}{"Wildcats", " ", "Hornets"},
+		}
+		result, err := handler(context.Background(), req)
+		if err != nil || result.IsError {
+			t.Fatalf("Expected success, got %v %v", result, err)
+		}
+
+		want := map[string]interface{}{"opponent": "Wildcats", "opponents": []interface{}{"Wildcats", "Hornets"}}
+		if !reflect.DeepEqual(body, want) {
+			t.Errorf("PATCH body = %v, want %v", body, want)
+		}
+	})
+
+	t.Run("clear opponents", func(t *testing.T) {
+		for _, opponent := range []interface{}{[]interface{}{}, " "} {
+			var body map[string]interface{}
+			c := updatePlatform(t, false, &body)
+			handler := makeUpdateSession(c, nil, newSessionLocks(c), nil)
+
+			req := mcp.CallToolRequest{}
+			req.Params.Arguments = map[string]interface{}{"session_id": "session-1", "opponent": opponent}
+			result, err := handler(context.Background(), req)
+			if err != nil || result.IsError {
+				t.Fatalf("opponent %q: expected success, got %v %v", opponent, result, err)
+			}
+			want := map[string]interface{}{"opponent": "", "opponents": []interface{}{}}
+			if !reflect.DeepEqual(body, want) {
+				t.Errorf("opponent %q: PATCH body = %v, want %v", opponent, body, want)
+			}
+		}
+	})
+
+	t.Run("invalid opponent", func(t *testing.T) {
+		handler := makeUpdateSession(videoplatform.New("http://localhost:8080"), nil, nil, nil)
+
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{
+			"session_id": "session-1",
+			"opponent":   []interface{}{"Wildcats", float64(3)},
+		}
+		result, _ := handler(context.Background(), req)
+		verifyError(t, result, "opponent must be a string or an array of strings")
+	})
+
+	t.Run("nothing to update", func(t *testing.T) {
+		handler := makeUpdateSession(videoplatform.New("http://localhost:8080"), nil, nil, nil)
+
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"session_id": "session-1"}
+		result, _ := handler(context.Background(), req)
+		verifyError(t, result, "Nothing to update")
+	})
+
+	t.Run("invalid scheduled_start", func(t *testing.T) {
+		handler := makeUpdateSession(videoplatform.New("http://localhost:8080"), nil, nil, nil)
+
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"session_id": "session-1", "scheduled_start": "tomorrow 7pm"}
+		result, _ := handler(context.Background(), req)
+		verifyError(t, result, "invalid scheduled_start")
+	})
+
+	t.Run("locked session", func(t *testing.T) {
+		var body map[string]interface{}
+		c := updatePlatform(t, true, &body)
+		handler := makeUpdateSession(c, nil, newSessionLocks(c), nil)
+
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"session_id": "session-1", "name": "Week 3"}
+		result, _ := handler(context.Background(), req)
+		verifyError(t, result, "locked by coach")
+		if body != nil {
+			t.Error("Expected no update on a locked session")
+		}
+
+		req.Params.Arguments["override_lock"] = true
+		if result, _ := handler(context.Background(), req); result.IsError {
+			t.Errorf("Expected override_lock to proceed, got %v", result.Content)
+		}
+		if body["name"] != "Week 3" {
+			t.Errorf("PATCH body = %v", body)
+		}
+	})
+}
+
+func TestDeleteSession(t *testing.T) {
+	// deletePlatform serves session-1 and answers DELETE with deleteStatus
+	deletePlatform := func(t *testing.T, deleteStatus int, deletes *int) *videoplatform.Client {
+		server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.URL.Path == "/api/v1/sessions/missing":
+				w.WriteHeader(http.StatusNotFound)
+			case r.Method == http.MethodGet && r.URL.Path == "/api/v1/sessions/session-1/lock":
+				json.NewEncoder(w).Encode(videoplatform.SessionLock{})
+			case r.Method == http.MethodGet && r.URL.Path == "/api/v1/sessions/session-1":
+				json.NewEncoder(w).Encode(videoplatform.Session{ID: "session-1", Name: "Oops", Status: "active", ClipCount: 3, TagCount: 7})
+			case r.Method == http.MethodDelete && r.URL.Path == "/api/v1/sessions/session-1":
+				*deletes++
+				w.WriteHeader(deleteStatus)
+			default:
+				t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			}
+		})
+		t.Cleanup(server.Close)
+		return videoplatform.New(server.URL)
+	}
+	call := func(c *videoplatform.Client, args map[string]interface{}) *mcp.CallToolResult {
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = args
+		result, err := makeDeleteSession(c, newSessionLocks(c), nil)(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return result
+	}
+
+	t.Run("asks for confirmation", func(t *testing.T) {
+		var deletes int
+		c := deletePlatform(t, http.StatusNoContent, &deletes)
+		for _, args := range []map[string]interface{}{
+			{"session_id": "session-1"},
+			{"session_id": "session-1", "confirm": false},
+		} {
+			result := call(c, args)
+			text := result.Content[0].(mcp.TextContent).Text
+			if result.IsError || !strings.Contains(text, "'Oops'") || !strings.Contains(text, "3 clips") || !strings.Contains(text, "confirm: true") {
+				t.Errorf("Unexpected preview: %s", text)
+			}
+		}
+		if deletes != 0 {
+			t.Errorf("Expected no DELETE without confirm, got %d", deletes)
+		}
+	})
+
+	t.Run("deletes when confirmed", func(t *testing.T) {
+		var deletes int
+		c := deletePlatform(t, http.StatusNoContent, &deletes)
+		result := call(c, map[string]interface{}{"session_id": "session-1", "confirm": true})
+		if result.IsError || deletes != 1 {
+			t.Errorf("Expected one DELETE and success, got %d %v", deletes, result.Content)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		var deletes int
+		result := call(deletePlatform(t, http.StatusNoContent, &deletes), map[string]interface{}{"session_id": "missing", "confirm": true})
+		verifyError(t, result, "Session missing not found")
+	})
+
+	t.Run("still active", func(t *testing.T) {
+		var deletes int
+		result := call(deletePlatform(t, http.StatusConflict, &deletes), map[string]interface{}{"session_id": "session-1", "confirm": true})
+		verifyError(t, result, "Cannot delete session 'Oops' while it is active")
+	})
 }
 
 func TestStartSession(t *testing.T) {
 	t.Run("successful start", func(t *testing.T) {
 		server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodGet {
+				json.NewEncoder(w).Encode(videoplatform.Session{ID: "session-123", Name: "Test Session", Status: "scheduled"})
+				return
+			}
 			if !strings.Contains(r.URL.Path, "/start") {
 				t.Errorf("Expected start endpoint, got %s", r.URL.Path)
 			}
 
-			session := client.Session{
+			session := videoplatform.Session{
 				ID:     "session-123",
 				Name:   "Test Session",
 				Status: "active",
@@ -190,7 +750,7 @@ func TestStartSession(t *testing.T) {
 		})
 		defer server.Close()
 
-		c := client.New(server.URL)
+		c := videoplatform.New(server.URL)
 		handler := makeStartSession(c)
 
 		req := mcp.CallToolRequest{}
@@ -217,7 +777,7 @@ func TestStartSession(t *testing.T) {
 	})
 
 	t.Run("missing session_id", func(t *testing.T) {
-		c := client.New("http://localhost:8080")
+		c := videoplatform.New("http://localhost:8080")
 		handler := makeStartSession(c)
 
 		req := mcp.CallToolRequest{}
@@ -232,12 +792,90 @@ func TestStartSession(t *testing.T) {
 			t.Error("Expected error for missing session_id")
 		}
 	})
+
+	// channelPlatform serves a scheduled session and three channels, of
+	// which cam-3 fails to activate; started reports whether the session
+	// was started
+	channelPlatform := func(t *testing.T, started *bool) *videoplatform.Client {
+		srv := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.URL.Path == "/api/v1/sessions/session-123":
+				json.NewEncoder(w).Encode(videoplatform.Session{ID: "session-123", Name: "Practice", Status: "scheduled"})
+			case r.URL.Path == "/api/v1/sessions/session-123/start":
+				*started = true
+				json.NewEncoder(w).Encode(videoplatform.Session{ID: "session-123", Name: "Practice", Status: "active"})
+			case r.URL.Path == "/api/v1/sessions":
+				json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Session]{})
+			case r.URL.Path == "/api/v1/channels":
+				json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Channel]{
+					Data: []videoplatform.Channel{
+						{ID: "cam-1", Name: "Sideline", Status: videoplatform.ChannelInactive},
+						{ID: "cam-2", Name: "End Zone", Status: videoplatform.ChannelActive},
+						{ID: "cam-3", Name: "Skycam", Status: videoplatform.ChannelInactive},
+					},
+					Total: 3,
+				})
+			case r.URL.Path == "/api/v1/channels/cam-3/activate":
+				w.WriteHeader(http.StatusInternalServerError)
+			case strings.HasSuffix(r.URL.Path, "/activate"), strings.HasSuffix(r.URL.Path, "/deactivate"):
+				id := strings.Split(r.URL.Path, "/")[4]
+				status := videoplatform.ChannelActive
+				if strings.HasSuffix(r.URL.Path, "/deactivate") {
+					status = videoplatform.ChannelInactive
+				}
+				json.NewEncoder(w).Encode(videoplatform.Channel{ID: id, Name: map[string]string{"cam-1": "Sideline", "cam-2": "End Zone"}[id], Status: status})
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		})
+		t.Cleanup(srv.Close)
+		return videoplatform.New(srv.URL)
+	}
+
+	t.Run("exclusive channels", func(t *testing.T) {
+		var started bool
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"session_id": "session-123", "channel_ids": []interface{}{"cam-1"}, "exclusive": true}
+
+		result, err := makeStartSession(channelPlatform(t, &started))(context.Background(), req)
+		if err != nil || result.IsError {
+			t.Fatalf("Expected success, got %v %v", result, err)
+		}
+		text := result.Content[0].(mcp.TextContent).Text
+		if !started || !strings.Contains(text, "Recording from: Sideline (cam-1)") || !strings.Contains(text, "Deactivated: End Zone (cam-2)") {
+			t.Errorf("Unexpected result: %s", text)
+		}
+	})
+
+	t.Run("channel fails to activate", func(t *testing.T) {
+		var started bool
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"session_id": "session-123", "channel_ids": []interface{}{"cam-1", "cam-3"}}
+
+		result, _ := makeStartSession(channelPlatform(t, &started))(context.Background(), req)
+		verifyError(t, result, "Session not started: could not activate cam-3")
+		if started {
+			t.Error("Expected the session not to be started")
+		}
+	})
+
+	t.Run("exclusive without channels", func(t *testing.T) {
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"session_id": "session-123", "exclusive": true}
+
+		result, _ := makeStartSession(videoplatform.New("http://unused"))(context.Background(), req)
+		verifyError(t, result, "exclusive requires channel_ids")
+	})
 }
 
 func TestPauseSession(t *testing.T) {
 	t.Run("successful pause", func(t *testing.T) {
 		server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
-			session := client.Session{
+			if r.Method == http.MethodGet && r.URL.Path == "/api/v1/sessions/session-123" {
+				json.NewEncoder(w).Encode(videoplatform.Session{ID: "session-123", Name: "Test Session", Status: "active"})
+				return
+			}
+			session := videoplatform.Session{
 				ID:     "session-123",
 				Name:   "Test Session",
 				Status: "paused",
@@ -246,8 +884,8 @@ func TestPauseSession(t *testing.T) {
 		})
 		defer server.Close()
 
-		c := client.New(server.URL)
-		handler := makePauseSession(c)
+		c := videoplatform.New(server.URL)
+		handler := makePauseSession(c, newSessionLocks(c))
 
 		req := mcp.CallToolRequest{}
 		req.Params.Arguments = map[string]interface{}{
@@ -265,10 +903,45 @@ func TestPauseSession(t *testing.T) {
 	})
 }
 
+func TestResumeSession(t *testing.T) {
+	server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/sessions/session-123/lock":
+			json.NewEncoder(w).Encode(videoplatform.SessionLock{})
+		case "/api/v1/sessions/session-123":
+			json.NewEncoder(w).Encode(videoplatform.Session{ID: "session-123", Name: "Practice", Status: "paused"})
+		case "/api/v1/sessions/session-123/resume":
+			if r.Method != http.MethodPost {
+				t.Errorf("Expected POST, got %s", r.Method)
+			}
+			json.NewEncoder(w).Encode(videoplatform.Session{ID: "session-123", Name: "Practice", Status: "active"})
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+	defer server.Close()
+
+	c := videoplatform.New(server.URL)
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{"session_id": "session-123"}
+
+	result, err := makeResumeSession(c, newSessionLocks(c))(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if text := result.Content[0].(mcp.TextContent).Text; result.IsError || text != "Session 'Practice' resumed. Status: active" {
+		t.Errorf("Unexpected result: %s", text)
+	}
+}
+
 func TestCompleteSession(t *testing.T) {
 	t.Run("successful complete", func(t *testing.T) {
 		server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
-			session := client.Session{
+			if r.Method == http.MethodGet && r.URL.Path == "/api/v1/sessions/session-123" {
+				json.NewEncoder(w).Encode(videoplatform.Session{ID: "session-123", Name: "Test Session", Status: "paused"})
+				return
+			}
+			session := videoplatform.Session{
 				ID:     "session-123",
 				Name:   "Test Session",
 				Status: "completed",
@@ -277,8 +950,8 @@ func TestCompleteSession(t *testing.T) {
 		})
 		defer server.Close()
 
-		c := client.New(server.URL)
-		handler := makeCompleteSession(c)
+		c := videoplatform.New(server.URL)
+		handler := makeCompleteSession(c, newSessionLocks(c))
 
 		req := mcp.CallToolRequest{}
 		req.Params.Arguments = map[string]interface{}{
@@ -296,12 +969,214 @@ func TestCompleteSession(t *testing.T) {
 	})
 }
 
-func TestListClips(t *testing.T) {
-	t.Run("successful list", func(t *testing.T) {
-		server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
-			resp := client.PaginatedResponse[client.Clip]{
-				Data: []client.Clip{
-					{ID: "clip-1", SessionID: "session-1", Status: "ready"},
+func TestCompleteSession_FinalScore(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     map[string]interface{}
+		wantBody string
+		wantErr  string
+	}{
+		{name: "no score", wantBody: ""},
+		{
+			name:     "score and result",
+			args:     map[string]interface{}{"our_score": float64(21), "their_score": float64(14), "result": "win", "notes": "Clinched the division"},
+			wantBody: `{"our_score":21,"their_score":14,"result":"win","notes":"Clinched the division"}`,
+		},
+		{name: "shutout", args: map[string]interface{}{"our_score": float64(0), "their_score": float64(7)}, wantBody: `{"our_score":0,"their_score":7}`},
+		{name: "negative score", args: map[string]interface{}{"our_score": float64(-3)}, wantErr: "our_score must be a whole number of at least 0"},
+		{name: "fractional score", args: map[string]interface{}{"their_score": 6.5}, wantErr: "their_score must be a whole number of at least 0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var completes int
+			server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/api/v1/sessions/session-1/lock":
+					json.NewEncoder(w).Encode(videoplatform.SessionLock{})
+				case "/api/v1/sessions/session-1":
+					json.NewEncoder(w).Encode(videoplatform.Session{ID: "session-1", Status: "active"})
+				case "/api/v1/sessions/session-1/complete":
+					completes++
+					body, _ := io.ReadAll(r.Body)
+					if string(body) != tt.wantBody {
+						t.Errorf("body = %q, want %q", body, tt.wantBody)
+					}
+					json.NewEncoder(w).Encode(videoplatform.Session{ID: "session-1", Status: "completed"})
+				}
+			})
+			defer server.Close()
+
+			c := videoplatform.New(server.URL)
+			req := mcp.CallToolRequest{}
+			req.Params.Arguments = map[string]interface{}{"session_id": "session-1"}
+			for k, v := range tt.args {
+				req.Params.Arguments[k] = v
+			}
+
+			result, err := makeCompleteSession(c, newSessionLocks(c))(context.Background(), req)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if tt.wantErr != "" {
+				verifyError(t, result, tt.wantErr)
+				if completes != 0 {
+					t.Errorf("Expected no complete request, got %d", completes)
+				}
+				return
+			}
+			if result.IsError || completes != 1 {
+				t.Errorf("Expected one complete request and success, got %d: %v", completes, result.Content)
+			}
+		})
+	}
+}
+
+func TestCancelSession(t *testing.T) {
+	started := "2026-10-17T19:02:00Z"
+	tests := []struct {
+		name    string
+		session videoplatform.Session
+		args    map[string]interface{}
+		want    string
+		wantErr string
+		reason  string
+	}{
+		{
+			name:    "scheduled with reason",
+			session: videoplatform.Session{Name: "Week 4", Status: "scheduled"},
+			args:    map[string]interface{}{"reason": "rained out"},
+			want:    "Session 'Week 4' cancelled. Status: cancelled\nReason: rained out",
+			reason:  "rained out",
+		},
+		{
+			name:    "scheduled without reason",
+			session: videoplatform.Session{Name: "Week 4", Status: "scheduled"},
+			want:    "Session 'Week 4' cancelled. Status: cancelled",
+		},
+		{
+			name:    "refuses active session",
+			session: videoplatform.Session{Name: "Week 4", Status: "active", ActualStart: &started},
+			wantErr: "Cannot cancel: session is active (started 19:02). Did you mean pause_session or complete_session?",
+		},
+		{
+			name:    "refuses cancelled session",
+			session: videoplatform.Session{Name: "Week 4", Status: "cancelled"},
+			wantErr: "Cannot cancel: session is already cancelled. Did you mean archive_session?",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var cancels int
+			server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.URL.Path == "/api/v1/sessions/session-1/lock":
+					json.NewEncoder(w).Encode(videoplatform.SessionLock{})
+				case r.Method == http.MethodGet && r.URL.Path == "/api/v1/sessions/session-1":
+					json.NewEncoder(w).Encode(tt.session)
+				case r.Method == http.MethodPost && r.URL.Path == "/api/v1/sessions/session-1/cancel":
+					cancels++
+					var body videoplatform.CancelSessionRequest
+					json.NewDecoder(r.Body).Decode(&body)
+					var got string
+					if body.Reason != nil {
+						got = *body.Reason
+					}
+					if got != tt.reason {
+						t.Errorf("Expected reason %q, got %q", tt.reason, got)
+					}
+					json.NewEncoder(w).Encode(videoplatform.Session{ID: "session-1", Name: tt.session.Name, Status: "cancelled"})
+				default:
+					t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+				}
+			})
+			defer server.Close()
+
+			c := videoplatform.New(server.URL)
+			req := mcp.CallToolRequest{}
+			req.Params.Arguments = map[string]interface{}{"session_id": "session-1"}
+			for k, v := range tt.args {
+				req.Params.Arguments[k] = v
+			}
+
+			result, err := makeCancelSession(c, newSessionLocks(c))(context.Background(), req)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			text := result.Content[0].(mcp.TextContent).Text
+			if tt.wantErr != "" {
+				if !result.IsError || text != tt.wantErr {
+					t.Errorf("got %q\nwant error %q", text, tt.wantErr)
+				}
+				if cancels != 0 {
+					t.Errorf("Expected no cancel request, got %d", cancels)
+				}
+				return
+			}
+			if result.IsError || text != tt.want {
+				t.Errorf("got %q\nwant %q", text, tt.want)
+			}
+		})
+	}
+}
+
+func TestArchiveSession(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   videoplatform.SessionStatus
+		wantErr  string
+		archives int
+	}{
+		{name: "completed session", status: "completed", archives: 1},
+		{name: "cancelled session", status: "cancelled", archives: 1},
+		{name: "refuses active session", status: "active", wantErr: "Cannot archive session 'Week 1' while it is active"},
+		{name: "refuses paused session", status: "paused", wantErr: "Complete it with complete_session first"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var archives int
+			server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.URL.Path == "/api/v1/sessions/session-1/lock":
+					json.NewEncoder(w).Encode(videoplatform.SessionLock{})
+				case r.Method == http.MethodGet && r.URL.Path == "/api/v1/sessions/session-1":
+					json.NewEncoder(w).Encode(videoplatform.Session{ID: "session-1", Name: "Week 1", Status: tt.status})
+				case r.Method == http.MethodPost && r.URL.Path == "/api/v1/sessions/session-1/archive":
+					archives++
+					json.NewEncoder(w).Encode(videoplatform.Session{ID: "session-1", Name: "Week 1", Status: "archived"})
+				default:
+					t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+				}
+			})
+			defer server.Close()
+
+			c := videoplatform.New(server.URL)
+			req := mcp.CallToolRequest{}
+			req.Params.Arguments = map[string]interface{}{"session_id": "session-1"}
+
+			result, err := makeArchiveSession(c, newSessionLocks(c))(context.Background(), req)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if tt.wantErr != "" {
+				verifyError(t, result, tt.wantErr)
+			} else if result.IsError {
+				t.Errorf("Expected success, got %v", result.Content)
+			}
+			if archives != tt.archives {
+				t.Errorf("Expected %d archive calls, got %d", tt.archives, archives)
+			}
+		})
+	}
+}
+
+func TestListClips(t *testing.T) {
+	t.Run("successful list", func(t *testing.T) {
+		server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+			resp := videoplatform.PaginatedResponse[videoplatform.Clip]{
+				Data: []videoplatform.Clip{
+					{ID: "clip-1", SessionID: "session-1", Status: "ready"},
 					{ID: "clip-2", SessionID: "session-1", Status: "ready"},
 				},
 				Total: 2,
@@ -310,8 +1185,8 @@ func TestListClips(t *testing.T) {
 		})
 		defer server.Close()
 
-		c := client.New(server.URL)
-		handler := makeListClips(c)
+		c := videoplatform.New(server.URL)
+		handler := makeListClips(c, 20, newPresenter(false))
 
 		req := mcp.CallToolRequest{}
 		req.Params.Arguments = map[string]interface{}{
@@ -327,12 +1202,782 @@ func TestListClips(t *testing.T) {
 			t.Error("Expected success")
 		}
 	})
+
+	t.Run("offset", func(t *testing.T) {
+		server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+			if got := r.URL.Query().Get("offset"); got != "20" {
+				t.Errorf("Expected offset=20 in query, got %q", r.URL.RawQuery)
+			}
+			clips := make([]videoplatform.Clip, 20)
+			json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Clip]{Data: clips, Total: 312})
+		})
+		defer server.Close()
+
+		handler := makeListClips(videoplatform.New(server.URL), 20, newPresenter(false))
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"session_id": "session-1", "offset": float64(20)}
+
+		result, err := handler(context.Background(), req)
+		if err != nil || result.IsError {
+			t.Fatalf("Expected success, got %v %v", result, err)
+		}
+		text := result.Content[0].(mcp.TextContent).Text
+		if !strings.HasSuffix(text, "272 more clips not shown — showing 21–40 of 312; call again with offset=40") {
+			t.Errorf("Expected a next-page hint, got:\n%s", text)
+		}
+	})
+
+	t.Run("sort", func(t *testing.T) {
+		server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+			if got := r.URL.Query().Get("sort"); got != "-duration" {
+				t.Errorf("Expected sort=-duration in query, got %q", r.URL.RawQuery)
+			}
+			json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Clip]{Data: []videoplatform.Clip{}})
+		})
+		defer server.Close()
+
+		handler := makeListClips(videoplatform.New(server.URL), 20, newPresenter(false))
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"sort": "-duration"}
+
+		result, err := handler(context.Background(), req)
+		if err != nil || result.IsError {
+			t.Fatalf("Expected success, got %v %v", result, err)
+		}
+	})
+
+	t.Run("invalid sort", func(t *testing.T) {
+		handler := makeListClips(videoplatform.New("http://localhost:1"), 20, newPresenter(false))
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"sort": "scheduled_start"}
+
+		result, err := handler(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		verifyError(t, result, "must be one of created_at")
+	})
+
+	t.Run("include tag counts", func(t *testing.T) {
+		tagRequests := 0
+		server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/api/v1/clips":
+				resp := videoplatform.PaginatedResponse[videoplatform.Clip]{
+					Data: []videoplatform.Clip{
+						{ID: "clip-1", SessionID: "session-1"},
+						{ID: "clip-2", SessionID: "session-1"},
+					},
+					Total: 2,
+				}
+				json.NewEncoder(w).Encode(resp)
+			case "/api/v1/tags":
+				tagRequests++
+				if r.URL.Query().Get("session_id") != "session-1" {
+					t.Errorf("Expected session_id=session-1, got %s", r.URL.Query().Get("session_id"))
+				}
+				resp := videoplatform.PaginatedResponse[videoplatform.Tag]{
+					Data: []videoplatform.Tag{
+						{ID: "tag-1", ClipID: "clip-1"},
+						{ID: "tag-2", ClipID: "clip-1"},
+					},
+					Total: 2,
+				}
+				json.NewEncoder(w).Encode(resp)
+			}
+		})
+		defer server.Close()
+
+		c := videoplatform.New(server.URL)
+		handler := makeListClips(c, 20, newPresenter(false))
+
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{
+			"session_id":         "session-1",
+			"include_tag_counts": true,
+		}
+
+		result, err := handler(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		var resp videoplatform.PaginatedResponse[videoplatform.Clip]
+		if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &resp); err != nil {
+			t.Fatalf("Failed to parse result: %v", err)
+		}
+		if *resp.Data[0].TagCount != 2 || *resp.Data[1].TagCount != 0 {
+			t.Errorf("Expected tag counts 2 and 0, got %d and %d", *resp.Data[0].TagCount, *resp.Data[1].TagCount)
+		}
+		if tagRequests != 1 {
+			t.Errorf("Expected 1 tag request, got %d", tagRequests)
+		}
+	})
+
+	t.Run("include tag counts requires session", func(t *testing.T) {
+		c := videoplatform.New("http://localhost:0")
+		handler := makeListClips(c, 20, newPresenter(false))
+
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{
+			"include_tag_counts": true,
+		}
+
+		result, _ := handler(context.Background(), req)
+		verifyError(t, result, "include_tag_counts requires a session_id filter")
+	})
+}
+
+func TestListClips_Duration(t *testing.T) {
+	clips := []videoplatform.Clip{
+		{ID: "dead-ball", DurationSeconds: 2},
+		{ID: "play", DurationSeconds: 12},
+		{ID: "accident", DurationSeconds: 640},
+		{ID: "unknown"},
+	}
+	list := func(t *testing.T, handler http.HandlerFunc, args map[string]interface{}) listEnvelope[videoplatform.Clip] {
+		t.Helper()
+		server := mockServer(t, handler)
+		defer server.Close()
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = args
+		result, err := makeListClips(videoplatform.New(server.URL), 20, newPresenter(false))(context.Background(), req)
+		if err != nil || result.IsError {
+			t.Fatalf("Expected success, got %v %v", result, err)
+		}
+		var e listEnvelope[videoplatform.Clip]
+		if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &e); err != nil {
+			t.Fatalf("result is not JSON: %v", err)
+		}
+		return e
+	}
+	ids := func(clips []videoplatform.Clip) []string {
+		var ids []string
+		for _, clip := range clips {
+			ids = append(ids, clip.ID)
+		}
+		return ids
+	}
+	args := map[string]interface{}{"min_duration_seconds": float64(3), "max_duration_seconds": float64(600)}
+
+	t.Run("platform filters", func(t *testing.T) {
+		e := list(t, func(w http.ResponseWriter, r *http.Request) {
+			if q := r.URL.Query(); q.Get("min_duration") != "3" || q.Get("max_duration") != "600" {
+				t.Errorf("Expected duration filters in query, got %q", r.URL.RawQuery)
+			}
+			json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Clip]{Data: clips[1:2], Total: 1})
+		}, args)
+		if e.Notice != "" || len(e.Data) != 1 {
+			t.Errorf("Expected the platform's page unchanged, got %d clips, notice %q", len(e.Data), e.Notice)
+		}
+	})
+
+	t.Run("platform ignores filters", func(t *testing.T) {
+		e := list(t, func(w http.ResponseWriter, r *http.Request) {
+			json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Clip]{Data: clips, Total: 4})
+		}, args)
+		if got := ids(e.Data); !reflect.DeepEqual(got, []string{"play", "unknown"}) {
+			t.Errorf("clips = %v, want play and unknown", got)
+		}
+		if !strings.Contains(e.Notice, "filtered here: 2 of its 4 clips matched") {
+			t.Errorf("notice = %q", e.Notice)
+		}
+		if e.Truncated {
+			t.Error("Filtered last page marked truncated")
+		}
+	})
+
+	t.Run("platform rejects filters", func(t *testing.T) {
+		e := list(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Has("min_duration") {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Clip]{Data: clips, Total: 4})
+		}, map[string]interface{}{"max_duration_seconds": float64(600)})
+		if got := ids(e.Data); !reflect.DeepEqual(got, []string{"dead-ball", "play", "unknown"}) {
+			t.Errorf("clips = %v, want all but accident", got)
+		}
+		if e.Notice == "" {
+			t.Error("Expected a notice about client-side filtering")
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, tt := range []struct {
+			args map[string]interface{}
+			want string
+		}{
+			{map[string]interface{}{"min_duration_seconds": float64(-1)}, "min_duration_seconds must not be negative"},
+			{map[string]interface{}{"min_duration_seconds": float64(30), "max_duration_seconds": float64(10)}, "must not be more than max_duration_seconds"},
+		} {
+			req := mcp.CallToolRequest{}
+			req.Params.Arguments = tt.args
+			result, _ := makeListClips(videoplatform.New("http://localhost:8080"), 20, newPresenter(false))(context.Background(), req)
+			verifyError(t, result, tt.want)
+		}
+	})
+}
+
+func TestListClips_MinRating(t *testing.T) {
+	two, four, five := 2, 4, 5
+	clips := []videoplatform.Clip{
+		{ID: "poor", Rating: &two},
+		{ID: "good", Rating: &four},
+		{ID: "great", Rating: &five},
+		{ID: "ungraded"},
+	}
+	list := func(t *testing.T, handler http.HandlerFunc, args map[string]interface{}) *mcp.CallToolResult {
+		t.Helper()
+		srv := mockServer(t, handler)
+		defer srv.Close()
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = args
+		result, err := makeListClips(videoplatform.New(srv.URL), 20, newPresenter(false))(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return result
+	}
+	args := map[string]interface{}{"min_rating": float64(4)}
+
+	for name, handler := range map[string]http.HandlerFunc{
+		"platform ignores filter": func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("min_rating") != "4" {
+				t.Errorf("Expected min_rating=4 in query, got %q", r.URL.RawQuery)
+			}
+			json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Clip]{Data: clips, Total: 4})
+		},
+		"platform rejects filter": func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Has("min_rating") {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Clip]{Data: clips, Total: 4})
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			result := list(t, handler, args)
+			if result.IsError {
+				t.Fatalf("Unexpected tool error: %v", result.Content)
+			}
+			var e listEnvelope[videoplatform.Clip]
+			if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &e); err != nil {
+				t.Fatalf("result is not JSON: %v", err)
+			}
+			var got []string
+			for _, clip := range e.Data {
+				got = append(got, clip.ID)
+			}
+			if !reflect.DeepEqual(got, []string{"good", "great"}) {
+				t.Errorf("clips = %v, want good and great", got)
+			}
+			if !strings.Contains(e.Notice, "doesn't filter clips by rating") {
+				t.Errorf("notice = %q", e.Notice)
+			}
+		})
+	}
+
+	t.Run("out of range", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) { t.Error("Unexpected request") }
+		verifyError(t, list(t, handler, map[string]interface{}{"min_rating": float64(6)}), "min_rating must be a whole number from 1 to 5")
+	})
+}
+
+func TestListClips_DeepSearch(t *testing.T) {
+	note, other := "Missed block by 72", "good seal"
+	titled := "Missed block on 3rd down"
+	clips := map[string]videoplatform.Clip{
+		"clip-1": {ID: "clip-1", SessionID: "s-1", Title: &titled},
+		"clip-2": {ID: "clip-2", SessionID: "s-1"},
+		"clip-3": {ID: "clip-3", SessionID: "s-1"},
+		"clip-4": {ID: "clip-4", SessionID: "s-1"},
+	}
+	tags := []videoplatform.Tag{
+		{ID: "tag-1", ClipID: "clip-1", Notes: &note},
+		{ID: "tag-2", ClipID: "clip-2", Notes: &note},
+		{ID: "tag-3", ClipID: "clip-2", Labels: []string{"missed-block"}},
+		{ID: "tag-4", ClipID: "clip-3", Labels: []string{"MISSED BLOCK"}},
+		{ID: "tag-5", ClipID: "clip-4", Notes: &other},
+		{ID: "tag-6", ClipID: "deleted", Notes: &note},
+	}
+	var tagLists atomic.Int32
+	srv := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/v1/clips":
+			// The platform matches titles only
+			json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Clip]{Data: []videoplatform.Clip{clips["clip-1"]}, Total: 1})
+		case r.URL.Path == "/api/v1/tags":
+			tagLists.Add(1)
+			data := tags
+			if r.URL.Query().Get("session_id") == "" {
+				data = tags[:2]
+			}
+			json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Tag]{Data: data, Total: len(tags)})
+		case strings.HasPrefix(r.URL.Path, "/api/v1/clips/"):
+			clip, ok := clips[strings.TrimPrefix(r.URL.Path, "/api/v1/clips/")]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			json.NewEncoder(w).Encode(clip)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer srv.Close()
+	list := func(t *testing.T, args map[string]interface{}) listEnvelope[searchClip] {
+		t.Helper()
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = args
+		result, err := makeListClips(videoplatform.New(srv.URL), 20, newPresenter(false))(context.Background(), req)
+		if err != nil || result.IsError {
+			t.Fatalf("Expected success, got %v %v", result, err)
+		}
+		var e listEnvelope[searchClip]
+		if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &e); err != nil {
+			t.Fatalf("result is not JSON: %v", err)
+		}
+		return e
+	}
+
+	t.Run("merges tag matches", func(t *testing.T) {
+		e := list(t, map[string]interface{}{"session_id": "s-1", "search": "missed block", "deep_search": true})
+		var got []string
+		for _, clip := range e.Data {
+			got = append(got, clip.ID+":"+clip.MatchedVia)
+		}
+		if want := []string{"clip-1:", "clip-2:tag", "clip-3:tag"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("clips = %v, want %v", got, want)
+		}
+		if e.Total != 1 || e.Truncated || !strings.Contains(e.Notice, "2 clips matched via tag notes or labels") {
+			t.Errorf("total = %d, truncated = %v, notice = %q", e.Total, e.Truncated, e.Notice)
+		}
+	})
+
+	t.Run("with tag counts", func(t *testing.T) {
+		tagLists.Store(0)
+		e := list(t, map[string]interface{}{"session_id": "s-1", "search": "missed block", "deep_search": true, "include_tag_counts": true})
+		var got []string
+		for _, clip := range e.Data {
+			if clip.TagCount == nil {
+				t.Fatalf("clip %s has no tag count", clip.ID)
+			}
+			got = append(got, clip.ID+":"+strconv.Itoa(*clip.TagCount))
+		}
+		if want := []string{"clip-1:1", "clip-2:2", "clip-3:1"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("tag counts = %v, want %v", got, want)
+		}
+		if n := tagLists.Load(); n != 1 {
+			t.Errorf("Expected the session's tags fetched once, got %d listings", n)
+		}
+	})
+
+	t.Run("unscoped search is capped", func(t *testing.T) {
+		e := list(t, map[string]interface{}{"search": "missed block", "deep_search": true})
+		if len(e.Data) != 2 || !strings.Contains(e.Notice, "Only the first 2 of 6 tags were searched") {
+			t.Errorf("got %d clips, notice %q", len(e.Data), e.Notice)
+		}
+	})
+
+	t.Run("later pages", func(t *testing.T) {
+		e := list(t, map[string]interface{}{"session_id": "s-1", "search": "missed block", "deep_search": true, "offset": float64(20)})
+		if len(e.Data) != 1 {
+			t.Errorf("got %d clips, want the title match only", len(e.Data))
+		}
+	})
+
+	t.Run("requires search", func(t *testing.T) {
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"deep_search": true}
+		result, _ := makeListClips(videoplatform.New(srv.URL), 20, newPresenter(false))(context.Background(), req)
+		verifyError(t, result, "deep_search requires a search string")
+	})
+}
+
+func TestGetClip(t *testing.T) {
+	var tagQuery string
+	server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/clips/clip-1":
+			json.NewEncoder(w).Encode(videoplatform.Clip{ID: "clip-1", Status: videoplatform.ClipReady, DurationSeconds: 8})
+		case "/api/v1/tags":
+			tagQuery = r.URL.Query().Get("clip_id")
+			tags := []videoplatform.Tag{{ID: "tag-1", ClipID: "clip-1"}}
+			json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Tag]{Data: tags, Total: len(tags)})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer server.Close()
+	handler := makeGetClip(videoplatform.New(server.URL))
+
+	get := func(t *testing.T, args map[string]interface{}) map[string]interface{} {
+		t.Helper()
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = args
+		result, err := handler(context.Background(), req)
+		if err != nil || result.IsError {
+			t.Fatalf("Expected success, got %v %v", result, err)
+		}
+		var got map[string]interface{}
+		if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &got); err != nil {
+			t.Fatalf("result is not JSON: %v", err)
+		}
+		return got
+	}
+
+	t.Run("clip", func(t *testing.T) {
+		got := get(t, map[string]interface{}{"clip_id": "clip-1"})
+		if got["id"] != "clip-1" || got["status"] != "ready" || got["duration_seconds"] != float64(8) {
+			t.Errorf("clip = %v", got)
+		}
+		if _, ok := got["tags"]; ok {
+			t.Errorf("tags included without include_tags: %v", got)
+		}
+	})
+
+	t.Run("include tags", func(t *testing.T) {
+		got := get(t, map[string]interface{}{"clip_id": "clip-1", "include_tags": true})
+		tags, _ := got["tags"].([]interface{})
+		if got["id"] != "clip-1" || len(tags) != 1 {
+			t.Errorf("clip = %v, want one embedded tag", got)
+		}
+		if tagQuery != "clip-1" {
+			t.Errorf("tags listed for clip_id %q, want clip-1", tagQuery)
+		}
+	})
+
+	t.Run("clip not found", func(t *testing.T) {
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"clip_id": "missing"}
+		result, _ := handler(context.Background(), req)
+		verifyError(t, result, "Clip missing not found")
+	})
+
+	t.Run("missing clip_id", func(t *testing.T) {
+		result, _ := handler(context.Background(), mcp.CallToolRequest{})
+		verifyError(t, result, "clip_id is required")
+	})
+}
+
+func TestGetClipsBatch(t *testing.T) {
+	srv := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch id := strings.TrimPrefix(r.URL.Path, "/api/v1/clips/"); id {
+		case "gone":
+			w.WriteHeader(http.StatusNotFound)
+		case "broken":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			json.NewEncoder(w).Encode(videoplatform.Clip{ID: id})
+		}
+	})
+	defer srv.Close()
+	handler := makeGetClipsBatch(videoplatform.New(srv.URL))
+	batch := func(ids interface{}) *mcp.CallToolResult {
+		t.Helper()
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"clip_ids": ids}
+		result, err := handler(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return result
+	}
+
+	t.Run("in order with failures", func(t *testing.T) {
+		result := batch([]interface{}{"clip-3", "gone", "clip-1", "broken"})
+		if result.IsError {
+			t.Fatalf("Unexpected tool error: %v", result.Content)
+		}
+		var got struct {
+			Clips  []videoplatform.Clip `json:"clips"`
+			Failed []struct {
+				ClipID string `json:"clip_id"`
+				Error  string `json:"error"`
+			} `json:"failed"`
+		}
+		if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &got); err != nil {
+			t.Fatalf("result is not JSON: %v", err)
+		}
+		if len(got.Clips) != 2 || got.Clips[0].ID != "clip-3" || got.Clips[1].ID != "clip-1" {
+			t.Errorf("clips = %+v, want clip-3 then clip-1", got.Clips)
+		}
+		if len(got.Failed) != 2 || got.Failed[0].ClipID != "gone" || got.Failed[0].Error != "clip not found" || !strings.Contains(got.Failed[1].Error, "500") {
+			t.Errorf("failed = %+v", got.Failed)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		tooMany := make([]interface{}, maxBatchClips+1)
+		for i := range tooMany {
+			tooMany[i] = "clip-" + strconv.Itoa(i)
+		}
+		verifyError(t, batch(tooMany), "clip_ids lists 51 clips; fetch at most 50 per call")
+		verifyError(t, batch([]interface{}{}), "at least one clip")
+		verifyError(t, batch([]interface{}{"clip-1", 2}), "must be an array of clip IDs")
+	})
+}
+
+func TestRecordView(t *testing.T) {
+	var views []string
+	viewStatus := http.StatusNoContent
+	srv := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/clips/clip-1/view":
+			views = append(views, r.URL.Path)
+			w.WriteHeader(viewStatus)
+		case r.URL.Path == "/api/v1/clips/clip-1":
+			json.NewEncoder(w).Encode(videoplatform.Clip{ID: "clip-1", ViewCount: 4})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer srv.Close()
+	c := videoplatform.New(srv.URL)
+	call := func(handler server.ToolHandlerFunc, args map[string]interface{}) *mcp.CallToolResult {
+		t.Helper()
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = args
+		result, err := handler(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return result
+	}
+
+	t.Run("record_view tool", func(t *testing.T) {
+		views = nil
+		result := call(makeRecordView(c), map[string]interface{}{"clip_id": "clip-1"})
+		if result.IsError {
+			t.Fatalf("Unexpected tool error: %v", result.Content)
+		}
+		if len(views) != 1 || views[0] != "/api/v1/clips/clip-1/view" {
+			t.Errorf("views = %v, want one POST to /api/v1/clips/clip-1/view", views)
+		}
+	})
+
+	t.Run("get_clip records a view", func(t *testing.T) {
+		views = nil
+		result := call(makeGetClip(c), map[string]interface{}{"clip_id": "clip-1", "record_view": true})
+		if result.IsError {
+			t.Fatalf("Unexpected tool error: %v", result.Content)
+		}
+		if len(views) != 1 {
+			t.Errorf("views = %v, want one", views)
+		}
+		if text := result.Content[0].(mcp.TextContent).Text; !strings.Contains(text, `"view_count": 5`) {
+			t.Errorf("Expected the counted view in the clip, got:\n%s", text)
+		}
+	})
+
+	t.Run("get_clip without record_view", func(t *testing.T) {
+		views = nil
+		call(makeGetClip(c), map[string]interface{}{"clip_id": "clip-1"})
+		if len(views) != 0 {
+			t.Errorf("views = %v, want none", views)
+		}
+	})
+
+	t.Run("view failure doesn't fail get_clip", func(t *testing.T) {
+		viewStatus = http.StatusServiceUnavailable
+		defer func() { viewStatus = http.StatusNoContent }()
+		result := call(makeGetClip(c), map[string]interface{}{"clip_id": "clip-1", "record_view": true})
+		if result.IsError {
+			t.Fatalf("Expected get_clip to succeed, got %v", result.Content)
+		}
+		text := result.Content[0].(mcp.TextContent).Text
+		if !strings.Contains(text, `"view_count": 4`) || !strings.Contains(text, "The view was not recorded") {
+			t.Errorf("Expected the clip with a note about the failed view, got:\n%s", text)
+		}
+	})
+
+	t.Run("record_view errors", func(t *testing.T) {
+		verifyError(t, call(makeRecordView(c), map[string]interface{}{}), "clip_id is required")
+		verifyError(t, call(makeRecordView(c), map[string]interface{}{"clip_id": "missing"}), "Clip missing not found")
+	})
+}
+
+func TestGetClipURL(t *testing.T) {
+	future := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	past := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+	server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		urlType := r.URL.Query().Get("type")
+		switch r.URL.Path {
+		case "/api/v1/clips/clip-1/url":
+			json.NewEncoder(w).Encode(videoplatform.ClipURL{URL: "https://cdn.example/clip-1." + urlType, ExpiresAt: &future})
+		case "/api/v1/clips/clip-stale/url":
+			json.NewEncoder(w).Encode(videoplatform.ClipURL{URL: "https://cdn.example/clip-stale", ExpiresAt: &past})
+		case "/api/v1/clips/clip-purged/url":
+			w.WriteHeader(http.StatusGone)
+		case "/api/v1/clips/clip-pending/url":
+			json.NewEncoder(w).Encode(videoplatform.ClipURL{})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer server.Close()
+	handler := makeGetClipURL(videoplatform.New(server.URL))
+
+	call := func(args map[string]interface{}) *mcp.CallToolResult {
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = args
+		result, err := handler(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return result
+	}
+
+	for _, tt := range []struct {
+		urlType string
+		want    string
+	}{
+		{"", "https://cdn.example/clip-1.stream"},
+		{"stream", "https://cdn.example/clip-1.stream"},
+		{"download", "https://cdn.example/clip-1.download"},
+	} {
+		args := map[string]interface{}{"clip_id": "clip-1"}
+		if tt.urlType != "" {
+			args["url_type"] = tt.urlType
+		}
+		result := call(args)
+		if result.IsError {
+			t.Fatalf("url_type %q: unexpected tool error: %v", tt.urlType, result.Content)
+		}
+		var got struct {
+			ClipID    string `json:"clip_id"`
+			Type      string `json:"type"`
+			URL       string `json:"url"`
+			ExpiresAt string `json:"expires_at"`
+		}
+		if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &got); err != nil {
+			t.Fatalf("result is not JSON: %v", err)
+		}
+		if got.URL != tt.want || got.ClipID != "clip-1" || got.ExpiresAt != future {
+			t.Errorf("url_type %q: got %+v, want url %s", tt.urlType, got, tt.want)
+		}
+	}
+
+	verifyError(t, call(map[string]interface{}{"clip_id": "clip-1", "url_type": "embed"}), `invalid url_type "embed"`)
+	verifyError(t, call(map[string]interface{}{"clip_id": "clip-stale"}), "The stream link for clip clip-stale expired at "+past)
+	verifyError(t, call(map[string]interface{}{"clip_id": "clip-purged"}), "The media of clip clip-purged has expired or been deleted")
+	verifyError(t, call(map[string]interface{}{"clip_id": "clip-pending", "url_type": "download"}), "Clip clip-pending has no download media yet")
+	verifyError(t, call(map[string]interface{}{"clip_id": "missing"}), "Clip missing not found, or it has no stream media")
+	verifyError(t, call(map[string]interface{}{}), "clip_id is required")
+}
+
+func TestCreateClip(t *testing.T) {
+	var got videoplatform.CreateClipRequest
+	calls := 0
+	server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		json.NewDecoder(r.Body).Decode(&got)
+		json.NewEncoder(w).Encode(videoplatform.Clip{ID: "clip-9", SessionID: got.SessionID, StartTime: got.StartTime, EndTime: got.EndTime})
+	})
+	defer server.Close()
+	handler := makeCreateClip(videoplatform.New(server.URL))
+
+	args := func(start, end string) map[string]interface{} {
+		return map[string]interface{}{"session_id": "s-1", "channel_id": "ch-1", "start_time": start, "end_time": end}
+	}
+
+	t.Run("creates clip", func(t *testing.T) {
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = args("2026-10-17T19:04:10Z", "2026-10-17T15:04:22.5-04:00")
+		req.Params.Arguments["title"] = "Missed TD"
+		result, err := handler(context.Background(), req)
+		if err != nil || result.IsError {
+			t.Fatalf("Expected success, got %v %v", result, err)
+		}
+		if text := result.Content[0].(mcp.TextContent).Text; !strings.HasPrefix(text, "Created clip clip-9 (12.5 seconds):") {
+			t.Errorf("result = %q", text)
+		}
+		if got.SessionID != "s-1" || got.ChannelID != "ch-1" || got.Title == nil || *got.Title != "Missed TD" {
+			t.Errorf("request = %+v", got)
+		}
+	})
+
+	t.Run("invalid ranges", func(t *testing.T) {
+		calls = 0
+		for _, tt := range []struct {
+			start, end, want string
+		}{
+			{"2026-10-17T19:04:10Z", "19:04:22", `invalid end_time "19:04:22"`},
+			{"yesterday", "2026-10-17T19:04:22Z", `invalid start_time "yesterday"`},
+			{"2026-10-17T19:04:10Z", "2026-10-17T19:04:10Z", "end_time must be after start_time"},
+			{"2026-10-17T19:04:10Z", "2026-10-17T19:03:00Z", "end_time must be after start_time"},
+		} {
+			req := mcp.CallToolRequest{}
+			req.Params.Arguments = args(tt.start, tt.end)
+			result, _ := handler(context.Background(), req)
+			verifyError(t, result, tt.want)
+		}
+		if calls != 0 {
+			t.Errorf("platform called %d times for invalid ranges", calls)
+		}
+	})
+
+	t.Run("missing fields", func(t *testing.T) {
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"session_id": "s-1", "start_time": "2026-10-17T19:04:10Z"}
+		result, _ := handler(context.Background(), req)
+		verifyError(t, result, "session_id, channel_id, start_time and end_time are required")
+	})
+}
+
+func TestUpdateClip(t *testing.T) {
+	var body map[string]interface{}
+	server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || r.URL.Path != "/api/v1/clips/clip-1" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		body = nil
+		json.NewDecoder(r.Body).Decode(&body)
+		json.NewEncoder(w).Encode(videoplatform.Clip{ID: "clip-1"})
+	})
+	defer server.Close()
+	handler := makeUpdateClip(videoplatform.New(server.URL))
+
+	t.Run("sends only provided fields", func(t *testing.T) {
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"clip_id": "clip-1", "title": "Johnson 45yd TD"}
+		result, err := handler(context.Background(), req)
+		if err != nil || result.IsError {
+			t.Fatalf("Expected success, got %v %v", result, err)
+		}
+		if want := map[string]interface{}{"title": "Johnson 45yd TD"}; !reflect.DeepEqual(body, want) {
+			t.Errorf("PATCH body = %v, want %v", body, want)
+		}
+	})
+
+	t.Run("nothing to update", func(t *testing.T) {
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"clip_id": "clip-1"}
+		result, _ := handler(context.Background(), req)
+		verifyError(t, result, "Nothing to update: pass at least one of title or description")
+	})
+
+	t.Run("clip not found", func(t *testing.T) {
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"clip_id": "missing", "description": "Sweep left"}
+		result, _ := handler(context.Background(), req)
+		verifyError(t, result, "Clip missing not found")
+	})
+
+	t.Run("missing clip_id", func(t *testing.T) {
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"title": "Johnson 45yd TD"}
+		result, _ := handler(context.Background(), req)
+		verifyError(t, result, "clip_id is required")
+	})
 }
 
 func TestFavoriteClip(t *testing.T) {
 	t.Run("add to favorites", func(t *testing.T) {
 		server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
-			clip := client.Clip{
+			clip := videoplatform.Clip{
 				ID:         "clip-1",
 				IsFavorite: true,
 			}
@@ -340,7 +1985,7 @@ func TestFavoriteClip(t *testing.T) {
 		})
 		defer server.Close()
 
-		c := client.New(server.URL)
+		c := videoplatform.New(server.URL)
 		handler := makeFavoriteClip(c)
 
 		req := mcp.CallToolRequest{}
@@ -368,7 +2013,7 @@ func TestFavoriteClip(t *testing.T) {
 
 	t.Run("remove from favorites", func(t *testing.T) {
 		server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
-			clip := client.Clip{
+			clip := videoplatform.Clip{
 				ID:         "clip-1",
 				IsFavorite: false,
 			}
@@ -376,7 +2021,7 @@ func TestFavoriteClip(t *testing.T) {
 		})
 		defer server.Close()
 
-		c := client.New(server.URL)
+		c := videoplatform.New(server.URL)
 		handler := makeFavoriteClip(c)
 
 		req := mcp.CallToolRequest{}
@@ -399,7 +2044,7 @@ func TestFavoriteClip(t *testing.T) {
 	})
 
 	t.Run("missing clip_id", func(t *testing.T) {
-		c := client.New("http://localhost:8080")
+		c := videoplatform.New("http://localhost:8080")
 		handler := makeFavoriteClip(c)
 
 		req := mcp.CallToolRequest{}
@@ -416,11 +2061,229 @@ func TestFavoriteClip(t *testing.T) {
 	})
 }
 
+func TestFavoriteClip_Notes(t *testing.T) {
+	// A single clip whose favorite flag toggles and whose note is patched
+	var mu sync.Mutex
+	clip := videoplatform.Clip{ID: "clip-1"}
+	server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/clips/clip-1/favorite":
+			clip.IsFavorite = !clip.IsFavorite
+		case r.Method == http.MethodPatch && r.URL.Path == "/api/v1/clips/clip-1":
+			var body map[string]*string
+			json.NewDecoder(r.Body).Decode(&body)
+			note, ok := body["favorite_note"]
+			if !ok {
+				t.Error("Expected favorite_note in PATCH body")
+			}
+			clip.FavoriteNote = note
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode(clip)
+	})
+	defer server.Close()
+
+	handler := makeFavoriteClip(videoplatform.New(server.URL))
+	favorite := func(args map[string]interface{}) string {
+		t.Helper()
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = args
+		result, err := handler(context.Background(), req)
+		if err != nil || result.IsError {
+			t.Fatalf("favorite_clip(%v) = %v, %v", args, result, err)
+		}
+		return result.Content[0].(mcp.TextContent).Text
+	}
+
+	t.Run("note persisted", func(t *testing.T) {
+		msg := favorite(map[string]interface{}{"clip_id": "clip-1", "note": "  Pancake block on the edge  "})
+		if msg != "Clip added to favorites with note" {
+			t.Errorf("Unexpected message: %s", msg)
+		}
+		if clip.FavoriteNote == nil || *clip.FavoriteNote != "Pancake block on the edge" {
+			t.Errorf("FavoriteNote = %v", clip.FavoriteNote)
+		}
+	})
+
+	t.Run("unfavorite clears note", func(t *testing.T) {
+		msg := favorite(map[string]interface{}{"clip_id": "clip-1"})
+		if msg != "Clip removed from favorites; note cleared" {
+			t.Errorf("Unexpected message: %s", msg)
+		}
+		if clip.FavoriteNote != nil {
+			t.Errorf("FavoriteNote = %q, want cleared", *clip.FavoriteNote)
+		}
+	})
+
+	t.Run("note capped", func(t *testing.T) {
+		msg := favorite(map[string]interface{}{"clip_id": "clip-1", "note": strings.Repeat("é", maxFavoriteNoteLength+20)})
+		if !strings.Contains(msg, "truncated to 280 characters") {
+			t.Errorf("Unexpected message: %s", msg)
+		}
+		if clip.FavoriteNote == nil || len([]rune(*clip.FavoriteNote)) != maxFavoriteNoteLength {
+			t.Errorf("FavoriteNote not capped to %d characters", maxFavoriteNoteLength)
+		}
+	})
+}
+
+func TestSetClipFavorite(t *testing.T) {
+	// A single clip whose favorite flag toggles; toggles are counted
+	var mu sync.Mutex
+	var toggles int
+	clip := videoplatform.Clip{ID: "clip-1"}
+	server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/clips/clip-1":
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/clips/clip-1/favorite":
+			toggles++
+			clip.IsFavorite = !clip.IsFavorite
+		case r.Method == http.MethodPatch && r.URL.Path == "/api/v1/clips/clip-1":
+			var req videoplatform.FavoriteNoteRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			clip.FavoriteNote = req.Note
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode(clip)
+	})
+	defer server.Close()
+
+	handler := makeSetClipFavorite(videoplatform.New(server.URL))
+	set := func(args map[string]interface{}) *mcp.CallToolResult {
+		t.Helper()
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = args
+		result, err := handler(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return result
+	}
+
+	tests := []struct {
+		name        string
+		favorite    bool
+		want        string
+		wantToggles int
+	}{
+		{"add", true, "Clip clip-1 added to favorites", 1},
+		{"already a favorite", true, "Clip clip-1 is already a favorite, no change", 1},
+		{"remove", false, "Clip clip-1 removed from favorites; note cleared", 2},
+		{"already not a favorite", false, "Clip clip-1 is already not a favorite, no change", 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			note := "Pancake block"
+			mu.Lock()
+			clip.FavoriteNote = &note
+			mu.Unlock()
+
+			result := set(map[string]interface{}{"clip_id": "clip-1", "favorite": tt.favorite})
+			if result.IsError {
+				t.Fatalf("Unexpected tool error: %v", result.Content)
+			}
+			if text := result.Content[0].(mcp.TextContent).Text; text != tt.want {
+				t.Errorf("result = %q, want %q", text, tt.want)
+			}
+			if toggles != tt.wantToggles {
+				t.Errorf("toggles = %d, want %d", toggles, tt.wantToggles)
+			}
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		verifyError(t, set(map[string]interface{}{"favorite": true}), "clip_id is required")
+		verifyError(t, set(map[string]interface{}{"clip_id": "clip-1"}), "favorite is required")
+		verifyError(t, set(map[string]interface{}{"clip_id": "clip-1", "favorite": "yes"}), "favorite is required")
+		verifyError(t, set(map[string]interface{}{"clip_id": "missing", "favorite": true}), "Clip missing not found")
+		verifyError(t, set(map[string]interface{}{"clip_id": "clip-1", "favorite": false, "note": "Pancake block"}), "note can only be set with favorite: true")
+	})
+
+	t.Run("note", func(t *testing.T) {
+		notes := []struct {
+			name, note, want string
+			wantNote         string
+		}{
+			{"added with note", "  Pancake block  ", "Clip clip-1 added to favorites; note saved", "Pancake block"},
+			{"note on existing favorite", strings.Repeat("a", maxFavoriteNoteLength+10), "Clip clip-1 is already a favorite; note saved (truncated to 280 characters)", strings.Repeat("a", maxFavoriteNoteLength)},
+		}
+		for _, tt := range notes {
+			result := set(map[string]interface{}{"clip_id": "clip-1", "favorite": true, "note": tt.note})
+			if result.IsError {
+				t.Fatalf("%s: unexpected tool error: %v", tt.name, result.Content)
+			}
+			if text := result.Content[0].(mcp.TextContent).Text; text != tt.want {
+				t.Errorf("%s: result = %q, want %q", tt.name, text, tt.want)
+			}
+			mu.Lock()
+			if clip.FavoriteNote == nil || *clip.FavoriteNote != tt.wantNote {
+				t.Errorf("%s: saved note = %v, want %q", tt.name, clip.FavoriteNote, tt.wantNote)
+			}
+			mu.Unlock()
+		}
+		if !clip.IsFavorite || toggles != 3 {
+			t.Errorf("favorite = %v after %d toggles, want a favorite after 3", clip.IsFavorite, toggles)
+		}
+	})
+}
+
+func TestRateClip(t *testing.T) {
+	var posted []int
+	srv := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v1/clips/clip-1/rating" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var body struct {
+			Rating int `json:"rating"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		posted = append(posted, body.Rating)
+		json.NewEncoder(w).Encode(videoplatform.Clip{ID: "clip-1", Rating: &body.Rating})
+	})
+	defer srv.Close()
+	handler := makeRateClip(videoplatform.New(srv.URL))
+	rate := func(args map[string]interface{}) *mcp.CallToolResult {
+		t.Helper()
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = args
+		result, err := handler(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return result
+	}
+
+	result := rate(map[string]interface{}{"clip_id": "clip-1", "rating": float64(4)})
+	if result.IsError {
+		t.Fatalf("Unexpected tool error: %v", result.Content)
+	}
+	if text := result.Content[0].(mcp.TextContent).Text; !strings.HasPrefix(text, "Rated clip clip-1 4/5") {
+		t.Errorf("result = %q", text)
+	}
+
+	for _, rating := range []float64{0, 6, 3.5} {
+		verifyError(t, rate(map[string]interface{}{"clip_id": "clip-1", "rating": rating}), "rating must be a whole number from 1 to 5")
+	}
+	verifyError(t, rate(map[string]interface{}{"clip_id": "clip-1"}), "rating is required")
+	verifyError(t, rate(map[string]interface{}{"clip_id": "missing", "rating": float64(3)}), "Clip missing not found")
+	if !reflect.DeepEqual(posted, []int{4}) {
+		t.Errorf("posted ratings = %v, want only 4", posted)
+	}
+}
+
 func TestListChannels(t *testing.T) {
 	t.Run("successful list", func(t *testing.T) {
 		server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
-			resp := client.PaginatedResponse[client.Channel]{
-				Data: []client.Channel{
+			resp := videoplatform.PaginatedResponse[videoplatform.Channel]{
+				Data: []videoplatform.Channel{
 					{ID: "camera-1", Name: "Main Camera", Status: "active"},
 					{ID: "camera-2", Name: "Secondary", Status: "inactive"},
 				},
@@ -430,27 +2293,448 @@ func TestListChannels(t *testing.T) {
 		})
 		defer server.Close()
 
-		c := client.New(server.URL)
-		handler := makeListChannels(c)
+		c := videoplatform.New(server.URL)
+		handler := makeListChannels(c, newPresenter(false))
 
 		req := mcp.CallToolRequest{}
 		req.Params.Arguments = map[string]interface{}{}
 
-		result, err := handler(context.Background(), req)
-		if err != nil {
-			t.Fatalf("Unexpected error: %v", err)
+		result, err := handler(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if result.IsError {
+			t.Error("Expected success")
+		}
+	})
+
+	t.Run("filters", func(t *testing.T) {
+		srv := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+			q := r.URL.Query()
+			if q.Get("status") != "error" || q.Get("input_type") != "ndi" || q.Get("search") != "booth" || q.Get("offset") != "5" {
+				t.Errorf("Expected status, input_type, search and offset filters, got %s", r.URL.RawQuery)
+			}
+			json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Channel]{
+				Data:  []videoplatform.Channel{{ID: "camera-7", Name: "Booth", Status: "error"}},
+				Total: 7,
+			})
+		})
+		defer srv.Close()
+
+		handler := makeListChannels(videoplatform.New(srv.URL), newPresenter(false))
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"status": "error", "input_type": "ndi", "search": "booth", "offset": float64(5)}
+
+		result, err := handler(context.Background(), req)
+		if err != nil || result.IsError {
+			t.Fatalf("Expected success, got %v %v", result, err)
+		}
+		if text := result.Content[0].(mcp.TextContent).Text; !strings.Contains(text, "1 more channels not shown") || !strings.Contains(text, "offset=6") {
+			t.Errorf("Expected a next-page hint, got %s", text)
+		}
+	})
+
+	t.Run("invalid status", func(t *testing.T) {
+		handler := makeListChannels(videoplatform.New("http://unused"), newPresenter(false))
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"status": "broken"}
+
+		result, _ := handler(context.Background(), req)
+		verifyError(t, result, "status")
+	})
+
+	t.Run("include usage", func(t *testing.T) {
+		server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/api/v1/channels":
+				json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Channel]{
+					Data: []videoplatform.Channel{
+						{ID: "camera-1", Name: "Main Camera", Status: "active"},
+						{ID: "camera-2", Name: "Secondary", Status: "inactive"},
+						{ID: "camera-3", Name: "Broken", Status: "error"},
+					},
+					Total: 3,
+				})
+			case "/api/v1/clips":
+				switch r.URL.Query().Get("channel_id") {
+				case "camera-1":
+					json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Clip]{
+						Data:  []videoplatform.Clip{{ID: "clip-9", CreatedAt: "2026-10-01T18:00:00Z"}},
+						Total: 9,
+					})
+				case "camera-2":
+					json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Clip]{})
+				default:
+					w.WriteHeader(http.StatusInternalServerError)
+				}
+			}
+		})
+		defer server.Close()
+
+		handler := makeListChannels(videoplatform.New(server.URL), newPresenter(false))
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"include_usage": true}
+
+		result, err := handler(context.Background(), req)
+		if err != nil || result.IsError {
+			t.Fatalf("Expected success, got %v %v", result, err)
+		}
+
+		var resp videoplatform.PaginatedResponse[channelWithUsage]
+		if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &resp); err != nil {
+			t.Fatalf("Failed to parse result: %v", err)
+		}
+		if len(resp.Data) != 3 {
+			t.Fatalf("Expected 3 channels, got %d", len(resp.Data))
+		}
+		if ch := resp.Data[0]; ch.ClipCount == nil || *ch.ClipCount != 9 || ch.MostRecentClipAt != "2026-10-01T18:00:00Z" {
+			t.Errorf("camera-1 usage = %+v", ch)
+		}
+		if ch := resp.Data[1]; ch.ClipCount == nil || *ch.ClipCount != 0 {
+			t.Errorf("camera-2 usage = %+v, want clip_count 0", ch)
+		}
+		if ch := resp.Data[2]; ch.ClipCount != nil || ch.UsageError == "" {
+			t.Errorf("camera-3 usage = %+v, want usage_error", ch)
+		}
+	})
+}
+
+func TestGetChannel(t *testing.T) {
+	srv := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/channels/camera-1" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		seen, msg := "2026-10-17T19:00:00Z", "RTMP handshake failed"
+		json.NewEncoder(w).Encode(videoplatform.Channel{ID: "camera-1", Name: "Main Camera", Status: "error", LastSeenAt: &seen, ErrorMessage: &msg})
+	})
+	defer srv.Close()
+	handler := makeGetChannel(videoplatform.New(srv.URL))
+	get := func(args map[string]interface{}) *mcp.CallToolResult {
+		t.Helper()
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = args
+		result, err := handler(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return result
+	}
+
+	result := get(map[string]interface{}{"channel_id": "camera-1"})
+	if result.IsError {
+		t.Fatalf("Unexpected tool error: %v", result.Content)
+	}
+	text := result.Content[0].(mcp.TextContent).Text
+	for _, want := range []string{`"last_seen_at": "2026-10-17T19:00:00Z"`, `"error_message": "RTMP handshake failed"`} {
+		if !strings.Contains(text, want) {
+			t.Errorf("Expected %s in result, got:\n%s", want, text)
+		}
+	}
+
+	verifyError(t, get(map[string]interface{}{}), "channel_id is required")
+	verifyError(t, get(map[string]interface{}{"channel_id": "camera-9"}), "Channel camera-9 not found")
+}
+
+func TestCreateChannel(t *testing.T) {
+	var created []videoplatform.CreateChannelRequest
+	srv := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var req videoplatform.CreateChannelRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		created = append(created, req)
+		json.NewEncoder(w).Encode(videoplatform.Channel{ID: "camera-3", Name: req.Name, InputType: &req.InputType, InputURL: req.InputURL, Status: videoplatform.ChannelInactive})
+	})
+	defer srv.Close()
+	handler := makeCreateChannel(videoplatform.New(srv.URL))
+
+	tests := []struct {
+		name    string
+		args    map[string]interface{}
+		wantErr string
+	}{
+		{"rtsp camera", map[string]interface{}{"name": "End Zone", "input_type": "rtsp", "input_url": "rtsp://10.0.0.12:554/stream1", "framerate": float64(60)}, ""},
+		{"usb camera without url", map[string]interface{}{"name": "Press Box", "input_type": "usb"}, ""},
+		{"missing name", map[string]interface{}{"input_type": "usb"}, "name and input_type are required"},
+		{"unknown input type", map[string]interface{}{"name": "Drone", "input_type": "hdmi"}, `invalid input_type "hdmi"`},
+		{"network input without url", map[string]interface{}{"name": "End Zone", "input_type": "ndi"}, "input_url is required for ndi inputs"},
+		{"unparseable url", map[string]interface{}{"name": "End Zone", "input_type": "rtsp", "input_url": "10.0.0.12 stream"}, "is not a valid URL"},
+		{"bad framerate", map[string]interface{}{"name": "End Zone", "input_type": "usb", "framerate": float64(0)}, "framerate must be at least 1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := mcp.CallToolRequest{}
+			req.Params.Arguments = tt.args
+			result, err := handler(context.Background(), req)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if tt.wantErr != "" {
+				verifyError(t, result, tt.wantErr)
+				return
+			}
+			if result.IsError {
+				t.Fatalf("Unexpected tool error: %v", result.Content)
+			}
+			if text := result.Content[0].(mcp.TextContent).Text; !strings.Contains(text, "created with ID camera-3. Status: inactive") {
+				t.Errorf("result = %q", text)
+			}
+		})
+	}
+
+	if len(created) != 2 {
+		t.Fatalf("created %d channels, want 2", len(created))
+	}
+	if got := created[0]; got.Framerate == nil || *got.Framerate != 60 || got.InputURL == nil {
+		t.Errorf("request = %+v", got)
+	}
+}
+
+func TestUpdateChannel(t *testing.T) {
+	rtsp := videoplatform.ChannelInputRTSP
+	oldURL := "rtsp://10.0.0.12/stream1"
+	channels := map[string]videoplatform.Channel{
+		"camera-1": {ID: "camera-1", Name: "End Zone", InputType: &rtsp, InputURL: &oldURL, Status: videoplatform.ChannelActive},
+		"camera-2": {ID: "camera-2", Name: "Sideline", InputType: &rtsp, InputURL: &oldURL, Status: videoplatform.ChannelInactive},
+	}
+	var patches []map[string]interface{}
+	var sessions []videoplatform.Session
+	srv := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/sessions":
+			json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Session]{Data: sessions, Total: len(sessions)})
+			return
+		case "/api/v1/channels":
+			json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Channel]{Data: []videoplatform.Channel{channels["camera-1"], channels["camera-2"]}, Total: 2})
+			return
+		case "/api/v1/clips":
+			json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Clip]{Data: []videoplatform.Clip{{ID: "clip-1", ChannelID: "camera-1"}}, Total: 1})
+			return
+		}
+		channel, ok := channels[strings.TrimPrefix(r.URL.Path, "/api/v1/channels/")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Method == http.MethodPatch {
+			var body map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&body)
+			patches = append(patches, body)
+		}
+		json.NewEncoder(w).Encode(channel)
+	})
+	defer srv.Close()
+	handler := makeUpdateChannel(videoplatform.New(srv.URL))
+	update := func(args map[string]interface{}) *mcp.CallToolResult {
+		t.Helper()
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = args
+		result, err := handler(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return result
+	}
+
+	t.Run("partial update", func(t *testing.T) {
+		patches = nil
+		result := update(map[string]interface{}{"channel_id": "camera-2", "framerate": float64(30)})
+		if result.IsError {
+			t.Fatalf("Unexpected tool error: %v", result.Content)
+		}
+		if want := []map[string]interface{}{{"framerate": float64(30)}}; !reflect.DeepEqual(patches, want) {
+			t.Errorf("patches = %v, want %v", patches, want)
+		}
+	})
+
+	t.Run("new input on an active channel", func(t *testing.T) {
+		result := update(map[string]interface{}{"channel_id": "camera-1", "input_url": "rtsp://192.168.1.40/stream1"})
+		if result.IsError {
+			t.Fatalf("Unexpected tool error: %v", result.Content)
+		}
+		if text := result.Content[0].(mcp.TextContent).Text; !strings.Contains(text, "Run deactivate_channel then activate_channel") {
+			t.Errorf("Expected a restart warning, got:\n%s", text)
+		}
+	})
+
+	t.Run("new input on a recording channel", func(t *testing.T) {
+		sessions = []videoplatform.Session{{ID: "session-1", Name: "Week 3", Status: videoplatform.SessionActive}}
+		defer func() { sessions = nil }()
+		patches = nil
+		result := update(map[string]interface{}{"channel_id": "camera-1", "input_url": "rtsp://192.168.1.40/stream1"})
+		verifyError(t, result, "Channel camera-1 is recording for active session 'Week 3' (session-1); changing the input of it would stop the recording")
+		if len(patches) != 0 {
+			t.Errorf("patches = %v, want none", patches)
+		}
+
+		result = update(map[string]interface{}{"channel_id": "camera-1", "input_url": "rtsp://192.168.1.40/stream1", "force": true})
+		if result.IsError || len(patches) != 1 {
+			t.Errorf("Expected force to update the channel, got %v", result.Content)
+		}
+	})
+
+	t.Run("new input on an inactive channel", func(t *testing.T) {
+		sessions = []videoplatform.Session{{ID: "session-1", Name: "Week 3", Status: videoplatform.SessionActive}}
+		defer func() { sessions = nil }()
+		result := update(map[string]interface{}{"channel_id": "camera-2", "input_url": "rtsp://192.168.1.41/stream1"})
+		if text := result.Content[0].(mcp.TextContent).Text; result.IsError || strings.Contains(text, "deactivate_channel") {
+			t.Errorf("Expected no restart warning, got:\n%s", text)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		patches = nil
+		verifyError(t, update(map[string]interface{}{}), "channel_id is required")
+		verifyError(t, update(map[string]interface{}{"channel_id": "camera-1"}), "Nothing to update")
+		verifyError(t, update(map[string]interface{}{"channel_id": "camera-1", "name": " "}), "name must not be empty")
+		verifyError(t, update(map[string]interface{}{"channel_id": "camera-1", "input_url": "192.168.1.40"}), "is not a valid URL")
+		verifyError(t, update(map[string]interface{}{"channel_id": "camera-9", "input_url": "rtsp://192.168.1.40/stream1"}), "Channel camera-9 not found")
+		if len(patches) != 0 {
+			t.Errorf("patches = %v, want none", patches)
+		}
+	})
+}
+
+func TestClearChannelError(t *testing.T) {
+	var clears []string
+	cleared := map[string]bool{}
+	srv := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		id, action, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/api/v1/channels/"), "/")
+		if action == "clear-error" {
+			clears = append(clears, id)
+			cleared[id] = true
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		cable, signal := "cable unplugged", "no signal"
+		switch {
+		case id == "camera-1" && !cleared[id]:
+			json.NewEncoder(w).Encode(videoplatform.Channel{ID: id, Name: "Sideline", Status: videoplatform.ChannelError, ErrorMessage: &cable})
+		case id == "camera-1":
+			json.NewEncoder(w).Encode(videoplatform.Channel{ID: id, Name: "Sideline", Status: videoplatform.ChannelActive})
+		case id == "camera-2":
+			json.NewEncoder(w).Encode(videoplatform.Channel{ID: id, Name: "End Zone", Status: videoplatform.ChannelError, ErrorMessage: &signal})
+		case id == "camera-3":
+			json.NewEncoder(w).Encode(videoplatform.Channel{ID: id, Name: "Skycam", Status: videoplatform.ChannelActive})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer srv.Close()
+
+	handler := makeClearChannelError(videoplatform.New(srv.URL))
+	call := func(channelID string) *mcp.CallToolResult {
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"channel_id": channelID}
+		result, err := handler(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return result
+	}
+
+	if result := call("camera-1"); result.IsError || !strings.HasPrefix(result.Content[0].(mcp.TextContent).Text, "Cleared the error on channel 'Sideline'. Status: active") {
+		t.Errorf("Expected camera-1 to recover, got %v", result.Content)
+	}
+	if result := call("camera-2"); result.IsError || !strings.Contains(result.Content[0].(mcp.TextContent).Text, "but it errored again: no signal") {
+		t.Errorf("Expected camera-2 to re-error, got %v", result.Content)
+	}
+	if result := call("camera-3"); result.IsError || !strings.Contains(result.Content[0].(mcp.TextContent).Text, "no error to clear; nothing changed") {
+		t.Errorf("Expected camera-3 to be left alone, got %v", result.Content)
+	}
+	verifyError(t, call("camera-9"), "Channel camera-9 not found")
+
+	if !reflect.DeepEqual(clears, []string{"camera-1", "camera-2"}) {
+		t.Errorf("clear-error calls = %v, want camera-1 and camera-2", clears)
+	}
+}
+
+func TestDeleteChannel(t *testing.T) {
+	// deleteStatus is how the platform answers the DELETE
+	newPlatform := func(t *testing.T, deleteStatus int, deletes *int) *videoplatform.Client {
+		srv := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+			var channel videoplatform.Channel
+			switch r.URL.Path {
+			case "/api/v1/channels/camera-1":
+				channel = videoplatform.Channel{ID: "camera-1", Name: "Old Press Box", Status: videoplatform.ChannelInactive}
+			case "/api/v1/channels/camera-2":
+				channel = videoplatform.Channel{ID: "camera-2", Name: "End Zone", Status: videoplatform.ChannelActive}
+			default:
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			if r.Method == http.MethodDelete {
+				*deletes++
+				w.WriteHeader(deleteStatus)
+				return
+			}
+			json.NewEncoder(w).Encode(channel)
+		})
+		t.Cleanup(srv.Close)
+		return videoplatform.New(srv.URL)
+	}
+	call := func(c *videoplatform.Client, args map[string]interface{}) *mcp.CallToolResult {
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = args
+		result, err := makeDeleteChannel(c)(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return result
+	}
+
+	t.Run("asks for confirmation", func(t *testing.T) {
+		var deletes int
+		result := call(newPlatform(t, http.StatusNoContent, &deletes), map[string]interface{}{"channel_id": "camera-1"})
+		text := result.Content[0].(mcp.TextContent).Text
+		if result.IsError || !strings.Contains(text, "'Old Press Box'") || !strings.Contains(text, "confirm: true") {
+			t.Errorf("Unexpected preview: %s", text)
+		}
+		if deletes != 0 {
+			t.Errorf("Expected no DELETE without confirm, got %d", deletes)
+		}
+	})
+
+	t.Run("deletes when confirmed", func(t *testing.T) {
+		var deletes int
+		result := call(newPlatform(t, http.StatusNoContent, &deletes), map[string]interface{}{"channel_id": "camera-1", "confirm": true})
+		if result.IsError || deletes != 1 || result.Content[0].(mcp.TextContent).Text != "Channel 'Old Press Box' deleted" {
+			t.Errorf("Expected one DELETE and success, got %d %v", deletes, result.Content)
 		}
+	})
 
-		if result.IsError {
-			t.Error("Expected success")
+	t.Run("active channel", func(t *testing.T) {
+		var deletes int
+		result := call(newPlatform(t, http.StatusNoContent, &deletes), map[string]interface{}{"channel_id": "camera-2", "confirm": true})
+		verifyError(t, result, "Deactivate it with deactivate_channel")
+		if deletes != 0 {
+			t.Errorf("Expected no DELETE of an active channel, got %d", deletes)
 		}
 	})
+
+	t.Run("not found", func(t *testing.T) {
+		var deletes int
+		result := call(newPlatform(t, http.StatusNoContent, &deletes), map[string]interface{}{"channel_id": "camera-9", "confirm": true})
+		verifyError(t, result, "Channel camera-9 not found")
+	})
+
+	t.Run("deleted meanwhile", func(t *testing.T) {
+		var deletes int
+		result := call(newPlatform(t, http.StatusNotFound, &deletes), map[string]interface{}{"channel_id": "camera-1", "confirm": true})
+		verifyError(t, result, "Channel camera-1 not found; it may already have been deleted")
+	})
+
+	t.Run("in use", func(t *testing.T) {
+		var deletes int
+		result := call(newPlatform(t, http.StatusConflict, &deletes), map[string]interface{}{"channel_id": "camera-1", "confirm": true})
+		verifyError(t, result, "Cannot delete channel 'Old Press Box' while it is in use")
+	})
 }
 
 func TestActivateChannel(t *testing.T) {
 	t.Run("successful activation", func(t *testing.T) {
 		server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
-			channel := client.Channel{
+			channel := videoplatform.Channel{
 				ID:     "camera-1",
 				Name:   "Main Camera",
 				Status: "active",
@@ -459,7 +2743,7 @@ func TestActivateChannel(t *testing.T) {
 		})
 		defer server.Close()
 
-		c := client.New(server.URL)
+		c := videoplatform.New(server.URL)
 		handler := makeActivateChannel(c)
 
 		req := mcp.CallToolRequest{}
@@ -486,7 +2770,7 @@ func TestActivateChannel(t *testing.T) {
 	})
 
 	t.Run("missing channel_id", func(t *testing.T) {
-		c := client.New("http://localhost:8080")
+		c := videoplatform.New("http://localhost:8080")
 		handler := makeActivateChannel(c)
 
 		req := mcp.CallToolRequest{}
@@ -506,7 +2790,7 @@ func TestActivateChannel(t *testing.T) {
 func TestDeactivateChannel(t *testing.T) {
 	t.Run("successful deactivation", func(t *testing.T) {
 		server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
-			channel := client.Channel{
+			channel := videoplatform.Channel{
 				ID:     "camera-1",
 				Name:   "Main Camera",
 				Status: "inactive",
@@ -515,7 +2799,7 @@ func TestDeactivateChannel(t *testing.T) {
 		})
 		defer server.Close()
 
-		c := client.New(server.URL)
+		c := videoplatform.New(server.URL)
 		handler := makeDeactivateChannel(c)
 
 		req := mcp.CallToolRequest{}
@@ -542,11 +2826,121 @@ func TestDeactivateChannel(t *testing.T) {
 	})
 }
 
+func TestDeactivateChannel_RecordingGuard(t *testing.T) {
+	week3 := videoplatform.Session{ID: "session-1", Name: "Week 3", Status: "active"}
+	tests := []struct {
+		name        string
+		sessions    []videoplatform.Session
+		channels    []videoplatform.Channel
+		clips       int
+		clipsFail   bool
+		force       bool
+		wantErr     string
+		deactivated bool
+	}{
+		{
+			name:     "refuses channel recording for active session",
+			sessions: []videoplatform.Session{week3},
+			channels: []videoplatform.Channel{{ID: "camera-1", Status: "active"}, {ID: "camera-2", Status: "active"}},
+			clips:    4,
+			wantErr:  "Channel camera-1 is recording for active session 'Week 3' (session-1); deactivating it would stop the recording",
+		},
+		{
+			name:     "refuses only active channel",
+			sessions: []videoplatform.Session{week3},
+			channels: []videoplatform.Channel{{ID: "camera-1", Status: "active"}, {ID: "camera-2", Status: "inactive"}},
+			wantErr:  "Channel camera-1 is the only active channel while recording active session 'Week 3' (session-1)",
+		},
+		{
+			name:        "other active channels remain",
+			sessions:    []videoplatform.Session{week3},
+			channels:    []videoplatform.Channel{{ID: "camera-1", Status: "active"}, {ID: "camera-2", Status: "active"}},
+			deactivated: true,
+		},
+		{
+			name:      "refuses when the clip check fails",
+			sessions:  []videoplatform.Session{week3},
+			channels:  []videoplatform.Channel{{ID: "camera-1", Status: "active"}, {ID: "camera-2", Status: "active"}},
+			clipsFail: true,
+			wantErr:   "Could not check whether channel camera-1 is recording",
+		},
+		{
+			name:        "force overrides",
+			sessions:    []videoplatform.Session{week3},
+			channels:    []videoplatform.Channel{{ID: "camera-1", Status: "active"}},
+			clips:       4,
+			force:       true,
+			deactivated: true,
+		},
+		{
+			name:        "no active session",
+			channels:    []videoplatform.Channel{{ID: "camera-1", Status: "active"}},
+			deactivated: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mu sync.Mutex
+			deactivated := false
+			server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/api/v1/sessions":
+					if r.URL.Query().Get("status") != "active" {
+						t.Errorf("Expected active session filter, got %q", r.URL.RawQuery)
+					}
+					json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Session]{Data: tt.sessions, Total: len(tt.sessions)})
+				case "/api/v1/channels":
+					json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Channel]{Data: tt.channels, Total: len(tt.channels)})
+				case "/api/v1/clips":
+					if q := r.URL.Query(); q.Get("session_id") != "session-1" || q.Get("channel_id") != "" {
+						t.Errorf("Unexpected clip query %q", r.URL.RawQuery)
+					}
+					if tt.clipsFail {
+						w.WriteHeader(http.StatusInternalServerError)
+						return
+					}
+					var clips []videoplatform.Clip
+					for i := 0; i < tt.clips; i++ {
+						clips = append(clips, videoplatform.Clip{ID: "clip-" + strconv.Itoa(i), ChannelID: "camera-1"})
+					}
+					// Clips from other channels don't count
+					clips = append(clips, videoplatform.Clip{ID: "clip-other", ChannelID: "camera-2"})
+					json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Clip]{Data: clips, Total: len(clips)})
+				case "/api/v1/channels/camera-1/deactivate":
+					mu.Lock()
+					deactivated = true
+					mu.Unlock()
+					json.NewEncoder(w).Encode(videoplatform.Channel{ID: "camera-1", Name: "Main Camera", Status: "inactive"})
+				default:
+					t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+				}
+			})
+			defer server.Close()
+
+			req := mcp.CallToolRequest{}
+			req.Params.Arguments = map[string]interface{}{"channel_id": "camera-1", "force": tt.force}
+			result, err := makeDeactivateChannel(videoplatform.New(server.URL))(context.Background(), req)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if tt.wantErr != "" {
+				verifyError(t, result, tt.wantErr)
+			} else if result.IsError {
+				t.Errorf("Expected success, got %v", result.Content)
+			}
+			if deactivated != tt.deactivated {
+				t.Errorf("deactivated = %v, want %v", deactivated, tt.deactivated)
+			}
+		})
+	}
+}
+
 func TestListTags(t *testing.T) {
 	t.Run("successful list", func(t *testing.T) {
 		server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
-			resp := client.PaginatedResponse[client.Tag]{
-				Data: []client.Tag{
+			resp := videoplatform.PaginatedResponse[videoplatform.Tag]{
+				Data: []videoplatform.Tag{
 					{ID: "tag-1", ClipID: "clip-1", SessionID: "session-1"},
 					{ID: "tag-2", ClipID: "clip-2", SessionID: "session-1"},
 				},
@@ -556,8 +2950,8 @@ func TestListTags(t *testing.T) {
 		})
 		defer server.Close()
 
-		c := client.New(server.URL)
-		handler := makeListTags(c)
+		c := videoplatform.New(server.URL)
+		handler := makeListTags(c, stats.DefaultThresholds, 50, newPresenter(false))
 
 		req := mcp.CallToolRequest{}
 		req.Params.Arguments = map[string]interface{}{
@@ -584,13 +2978,13 @@ func TestListTags(t *testing.T) {
 				t.Errorf("Expected play_type=run, got %s", r.URL.Query().Get("play_type"))
 			}
 
-			resp := client.PaginatedResponse[client.Tag]{Data: []client.Tag{}}
+			resp := videoplatform.PaginatedResponse[videoplatform.Tag]{Data: []videoplatform.Tag{}}
 			json.NewEncoder(w).Encode(resp)
 		})
 		defer server.Close()
 
-		c := client.New(server.URL)
-		handler := makeListTags(c)
+		c := videoplatform.New(server.URL)
+		handler := makeListTags(c, stats.DefaultThresholds, 50, newPresenter(false))
 
 		req := mcp.CallToolRequest{}
 		req.Params.Arguments = map[string]interface{}{
@@ -608,6 +3002,107 @@ func TestListTags(t *testing.T) {
 	})
 }
 
+func TestListTags_Offset(t *testing.T) {
+	server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("offset"); got != "50" {
+			t.Errorf("Expected offset=50, got %q", got)
+		}
+		tags := make([]videoplatform.Tag, 50)
+		for i := range tags {
+			tags[i] = videoplatform.Tag{ID: "tag-" + strconv.Itoa(51+i)}
+		}
+		json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Tag]{Data: tags, Total: 130})
+	})
+	defer server.Close()
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{"session_id": "session-1", "offset": float64(50)}
+	result, err := makeListTags(videoplatform.New(server.URL), stats.DefaultThresholds, 50, newPresenter(true))(context.Background(), req)
+	if err != nil || result.IsError {
+		t.Fatalf("Expected success, got %v %v", result, err)
+	}
+	text := result.Content[0].(mcp.TextContent).Text
+	if !strings.Contains(text, `"offset": 50`) || !strings.HasSuffix(text, "30 more tags not shown — showing 51–100 of 130; call again with offset=100") {
+		t.Errorf("Expected the page's offset and a next-page hint, got %s", text[strings.LastIndex(text, "\n"):])
+	}
+}
+
+func TestListTags_PlayFields(t *testing.T) {
+	intPtr := func(n int) *int { return &n }
+	strPtr := func(s string) *string { return &s }
+	tags := []videoplatform.Tag{
+		{ID: "match", Down: intPtr(3), Formation: strPtr("Trips"), Labels: []string{"RedZone"}},
+		{ID: "wrong-down", Down: intPtr(2), Formation: strPtr("trips"), Labels: []string{"redzone"}},
+		{ID: "wrong-formation", Down: intPtr(3), Formation: strPtr("I-Form"), Labels: []string{"redzone"}},
+		{ID: "no-label", Down: intPtr(3), Formation: strPtr("Trips")},
+	}
+	args := map[string]interface{}{"down": float64(3), "formation": "trips", "label": "redzone"}
+	list := func(t *testing.T, handler http.HandlerFunc, args map[string]interface{}) listEnvelope[tagWithClip] {
+		t.Helper()
+		server := mockServer(t, handler)
+		defer server.Close()
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = args
+		result, err := makeListTags(videoplatform.New(server.URL), stats.DefaultThresholds, 50, newPresenter(false))(context.Background(), req)
+		if err != nil || result.IsError {
+			t.Fatalf("Expected success, got %v %v", result, err)
+		}
+		var e listEnvelope[tagWithClip]
+		if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &e); err != nil {
+			t.Fatalf("result is not JSON: %v", err)
+		}
+		return e
+	}
+
+	t.Run("platform filters", func(t *testing.T) {
+		e := list(t, func(w http.ResponseWriter, r *http.Request) {
+			if q := r.URL.Query(); q.Get("down") != "3" || q.Get("formation") != "trips" || q.Get("label") != "redzone" {
+				t.Errorf("Expected play-field filters in query, got %q", r.URL.RawQuery)
+			}
+			json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Tag]{Data: tags[:1], Total: 1})
+		}, args)
+		if e.Notice != "" || len(e.Data) != 1 {
+			t.Errorf("Expected the platform's page unchanged, got %d tags, notice %q", len(e.Data), e.Notice)
+		}
+	})
+
+	t.Run("platform ignores filters", func(t *testing.T) {
+		e := list(t, func(w http.ResponseWriter, r *http.Request) {
+			json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Tag]{Data: tags, Total: 4})
+		}, args)
+		if len(e.Data) != 1 || e.Data[0].ID != "match" {
+			t.Errorf("Expected only the matching tag, got %+v", e.Data)
+		}
+		if want := "The platform doesn't filter tags by formation, down or label, so this page was filtered here: 1 of its 4 tags matched"; !strings.Contains(e.Notice, want) {
+			t.Errorf("notice = %q", e.Notice)
+		}
+	})
+
+	t.Run("platform rejects filters", func(t *testing.T) {
+		e := list(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Has("quarter") {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Tag]{Data: []videoplatform.Tag{
+				{ID: "q5", Quarter: intPtr(5)},
+				{ID: "q2", Quarter: intPtr(2)},
+				{ID: "unknown"},
+			}, Total: 3})
+		}, map[string]interface{}{"quarter": float64(5)})
+		if len(e.Data) != 1 || e.Data[0].ID != "q5" || e.Notice == "" {
+			t.Errorf("Expected only the overtime tag with a notice, got %+v, notice %q", e.Data, e.Notice)
+		}
+	})
+
+	t.Run("invalid down", func(t *testing.T) {
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"down": float64(5)}
+		result, _ := makeListTags(videoplatform.New("http://localhost:8080"), stats.DefaultThresholds, 50, newPresenter(false))(context.Background(), req)
+		verifyError(t, result, "down must be a whole number from 1 to 4, got 5")
+	})
+}
+
 func TestCreateTag(t *testing.T) {
 	t.Run("successful creation", func(t *testing.T) {
 		server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
@@ -615,14 +3110,14 @@ func TestCreateTag(t *testing.T) {
 				t.Errorf("Expected POST, got %s", r.Method)
 			}
 
-			var req client.CreateTagRequest
+			var req videoplatform.CreateTagRequest
 			json.NewDecoder(r.Body).Decode(&req)
 
 			if req.ClipID != "clip-1" {
 				t.Errorf("Expected clip_id 'clip-1', got %s", req.ClipID)
 			}
 
-			tag := client.Tag{
+			tag := videoplatform.Tag{
 				ID:        "new-tag-id",
 				ClipID:    req.ClipID,
 				SessionID: req.SessionID,
@@ -631,8 +3126,8 @@ func TestCreateTag(t *testing.T) {
 		})
 		defer server.Close()
 
-		c := client.New(server.URL)
-		handler := makeCreateTag(c)
+		c := videoplatform.New(server.URL)
+		handler := makeCreateTag(c, config.DefaultOvertimeLength, nil)
 
 		req := mcp.CallToolRequest{}
 		req.Params.Arguments = map[string]interface{}{
@@ -657,9 +3152,60 @@ func TestCreateTag(t *testing.T) {
 		}
 	})
 
+	t.Run("quarter and labels", func(t *testing.T) {
+		var body map[string]interface{}
+		server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+			json.NewDecoder(r.Body).Decode(&body)
+			json.NewEncoder(w).Encode(videoplatform.Tag{ID: "new-tag-id"})
+		})
+		defer server.Close()
+
+		handler := makeCreateTag(videoplatform.New(server.URL), config.DefaultOvertimeLength, nil)
+		call := func(args map[string]interface{}) *mcp.CallToolResult {
+			req := mcp.CallToolRequest{}
+			req.Params.Arguments = args
+			result, err := handler(context.Background(), req)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			return result
+		}
+
+		result := call(map[string]interface{}{
+			"clip_id":    "clip-1",
+			"session_id": "session-1",
+			"quarter":    float64(5),
+			"labels":     []interface{}{"turnover", " redzone ", ""},
+		})
+		if result.IsError {
+			t.Fatalf("Expected success, got %v", result.Content)
+		}
+		if body["quarter"] != float64(5) {
+			t.Errorf("Expected quarter 5 in body, got %v", body["quarter"])
+		}
+		if labels := body["labels"]; !reflect.DeepEqual(labels, []interface{}{"turnover", "redzone"}) {
+			t.Errorf("Expected labels [turnover redzone] in body, got %v", labels)
+		}
+
+		base := map[string]interface{}{"clip_id": "clip-1", "session_id": "session-1"}
+		with := func(key string, value interface{}) map[string]interface{} {
+			args := map[string]interface{}{key: value}
+			for k, v := range base {
+				args[k] = v
+			}
+			return args
+		}
+		verifyError(t, call(with("quarter", float64(15))), "quarter must be a whole number from 1 to 14 (5 is OT, 6 is OT2 and so on), got 15")
+		verifyError(t, call(with("quarter", 2.5)), "quarter must be a whole number from 1 to 14")
+		verifyError(t, call(with("labels", []interface{}{"turnover", float64(3)})), "labels must be an array of strings")
+		args := with("quarter", float64(2))
+		args["game_clock"] = "Q3 04:12"
+		verifyError(t, call(args), "Pass quarter or game_clock, not both")
+	})
+
 	t.Run("missing required fields", func(t *testing.T) {
-		c := client.New("http://localhost:8080")
-		handler := makeCreateTag(c)
+		c := videoplatform.New("http://localhost:8080")
+		handler := makeCreateTag(c, config.DefaultOvertimeLength, nil)
 
 		req := mcp.CallToolRequest{}
 		req.Params.Arguments = map[string]interface{}{
@@ -694,3 +3240,507 @@ func verifyError(t *testing.T, result *mcp.CallToolResult, expectedMsg string) {
 
 // Suppress unused import error
 var _ = errors.New
+
+func TestUpdateTag(t *testing.T) {
+	var patches []map[string]interface{}
+	srv := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/tags/tag-1" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		tag := videoplatform.Tag{ID: "tag-1", ClipID: "clip-1", Labels: []string{"redzone", "clock:08:00"}}
+		if r.Method == http.MethodPatch {
+			var body map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&body)
+			patches = append(patches, body)
+		}
+		json.NewEncoder(w).Encode(tag)
+	})
+	defer srv.Close()
+
+	handler := makeUpdateTag(videoplatform.New(srv.URL), config.DefaultOvertimeLength)
+	call := func(args map[string]interface{}) *mcp.CallToolResult {
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = args
+		result, err := handler(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return result
+	}
+
+	t.Run("only supplied fields are sent", func(t *testing.T) {
+		patches = nil
+		result := call(map[string]interface{}{"tag_id": "tag-1", "yards_gained": float64(7), "notes": ""})
+		if result.IsError {
+			t.Fatalf("Expected success, got %v", result.Content)
+		}
+		want := map[string]interface{}{"yards_gained": float64(7), "notes": ""}
+		if len(patches) != 1 || !reflect.DeepEqual(patches[0], want) {
+			t.Errorf("PATCH bodies = %v, want %v", patches, want)
+		}
+	})
+
+	t.Run("game clock keeps other labels", func(t *testing.T) {
+		patches = nil
+		call(map[string]interface{}{"tag_id": "tag-1", "game_clock": "Q3 04:12"})
+		want := map[string]interface{}{"quarter": float64(3), "labels": []interface{}{"redzone", "clock:04:12"}}
+		if len(patches) != 1 || !reflect.DeepEqual(patches[0], want) {
+			t.Errorf("PATCH bodies = %v, want %v", patches, want)
+		}
+	})
+
+	t.Run("empty labels clear them", func(t *testing.T) {
+		patches = nil
+		call(map[string]interface{}{"tag_id": "tag-1", "labels": []interface{}{}})
+		want := map[string]interface{}{"labels": []interface{}{}}
+		if len(patches) != 1 || !reflect.DeepEqual(patches[0], want) {
+			t.Errorf("PATCH bodies = %v, want %v", patches, want)
+		}
+	})
+
+	t.Run("nothing to update", func(t *testing.T) {
+		patches = nil
+		verifyError(t, call(map[string]interface{}{"tag_id": "tag-1"}), "Nothing to update")
+		if len(patches) != 0 {
+			t.Errorf("Expected no PATCH, got %v", patches)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		verifyError(t, call(map[string]interface{}{"tag_id": "tag-9", "down": float64(2)}), "Tag tag-9 not found")
+	})
+}
+
+func TestDeleteTag(t *testing.T) {
+	var deletes int
+	srv := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/tags/tag-1" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Method == http.MethodDelete {
+			deletes++
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		playType := "Pass"
+		json.NewEncoder(w).Encode(videoplatform.Tag{ID: "tag-1", ClipID: "clip-1", PlayType: &playType})
+	})
+	defer srv.Close()
+
+	handler := makeDeleteTag(videoplatform.New(srv.URL))
+	call := func(args map[string]interface{}) *mcp.CallToolResult {
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = args
+		result, err := handler(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return result
+	}
+
+	result := call(map[string]interface{}{"tag_id": "tag-1"})
+	if text := result.Content[0].(mcp.TextContent).Text; result.IsError || !strings.Contains(text, "Pass (tag tag-1, clip clip-1) will be deleted") || deletes != 0 {
+		t.Errorf("Expected a preview and no DELETE, got %s (%d deletes)", text, deletes)
+	}
+	result = call(map[string]interface{}{"tag_id": "tag-1", "confirm": true})
+	if result.IsError || deletes != 1 || result.Content[0].(mcp.TextContent).Text != "Tag tag-1 deleted" {
+		t.Errorf("Expected one DELETE, got %v (%d deletes)", result.Content, deletes)
+	}
+	verifyError(t, call(map[string]interface{}{"tag_id": "tag-9", "confirm": true}), "Tag tag-9 not found; it may already have been deleted")
+}
+
+func TestSetTagImportant(t *testing.T) {
+	notes := "Late hit"
+	tag := videoplatform.Tag{ID: "tag-1", Notes: &notes}
+	var patches []videoplatform.UpdateTagRequest
+	srv := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/tags/tag-1/important":
+			var body struct{ Important bool }
+			json.NewDecoder(r.Body).Decode(&body)
+			tag.IsImportant = body.Important
+		case r.Method == http.MethodPatch && r.URL.Path == "/api/v1/tags/tag-1":
+			var update videoplatform.UpdateTagRequest
+			json.NewDecoder(r.Body).Decode(&update)
+			patches = append(patches, update)
+			tag.Notes = update.Notes
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode(tag)
+	})
+	defer srv.Close()
+
+	handler := makeSetTagImportant(videoplatform.New(srv.URL))
+	call := func(args map[string]interface{}) *mcp.CallToolResult {
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = args
+		result, err := handler(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return result
+	}
+
+	result := call(map[string]interface{}{"tag_id": "tag-1", "important": true})
+	if text := result.Content[0].(mcp.TextContent).Text; result.IsError || !strings.HasPrefix(text, "Tag tag-1 marked important:") || !tag.IsImportant || len(patches) != 0 {
+		t.Errorf("Expected the flag set without touching notes, got %s (%d patches)", text, len(patches))
+	}
+
+	result = call(map[string]interface{}{"tag_id": "tag-1", "important": false, "reason": " duplicate of tag-2 "})
+	if text := result.Content[0].(mcp.TextContent).Text; result.IsError || !strings.HasPrefix(text, "Tag tag-1 marked not important:") || tag.IsImportant {
+		t.Errorf("Expected the flag cleared, got %s", text)
+	}
+	if want := "Late hit\nNot important: duplicate of tag-2"; len(patches) != 1 || *patches[0].Notes != want {
+		t.Errorf("Expected notes %q, got %+v", want, patches)
+	}
+
+	verifyError(t, call(map[string]interface{}{"tag_id": "tag-1"}), "important is required")
+	verifyError(t, call(map[string]interface{}{"tag_id": "tag-9", "important": true}), "Tag tag-9 not found")
+}
+
+func TestFindSession(t *testing.T) {
+	server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		opponent := "Eagles"
+		resp := videoplatform.PaginatedResponse[videoplatform.Session]{
+			Data: []videoplatform.Session{
+				{ID: "session-1", Name: "Week 1", Opponent: &opponent},
+				{ID: "session-2", Name: "Tuesday Practice"},
+				{ID: "session-3", Name: "Jamboree", Opponents: []string{"Hornets", "Wildcats"}},
+			},
+			Total: 3,
+		}
+		json.NewEncoder(w).Encode(resp)
+	})
+	defer server.Close()
+
+	c := videoplatform.New(server.URL)
+	handler := makeFindSession(c, nil)
+
+	t.Run("matches opponent", func(t *testing.T) {
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"query": "eagles"}
+
+		result, err := handler(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		text := result.Content[0].(mcp.TextContent).Text
+		if !strings.Contains(text, "session-1") || strings.Contains(text, "session-2") {
+			t.Errorf("Expected only session-1, got %s", text)
+		}
+	})
+
+	t.Run("matches any opponent", func(t *testing.T) {
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"query": "wildcats"}
+
+		result, err := handler(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		text := result.Content[0].(mcp.TextContent).Text
+		if !strings.Contains(text, "session-3") || strings.Contains(text, "session-1") {
+			t.Errorf("Expected only session-3, got %s", text)
+		}
+	})
+
+	t.Run("missing query", func(t *testing.T) {
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{}
+
+		result, _ := handler(context.Background(), req)
+		verifyError(t, result, "query is required")
+	})
+}
+
+func TestFindSession_IndexFollowsChanges(t *testing.T) {
+	week1 := videoplatform.Session{ID: "session-1", Name: "Week 1", Status: videoplatform.SessionCompleted}
+	srv := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/sessions":
+			json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Session]{Data: []videoplatform.Session{week1}, Total: 1})
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/sessions":
+			json.NewEncoder(w).Encode(videoplatform.Session{ID: "session-9", Name: "Week 9", SessionType: "game"})
+		case r.URL.Path == "/api/v1/sessions/session-1/lock":
+			json.NewEncoder(w).Encode(videoplatform.SessionLock{})
+		case r.URL.Path == "/api/v1/sessions/session-1":
+			if r.Method == http.MethodGet {
+				json.NewEncoder(w).Encode(week1)
+			}
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+	defer srv.Close()
+
+	c := videoplatform.New(srv.URL)
+	idx := index.Open(c, t.TempDir(), time.Hour)
+	call := func(handler server.ToolHandlerFunc, args map[string]interface{}) string {
+		t.Helper()
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = args
+		result, err := handler(context.Background(), req)
+		if err != nil || result.IsError {
+			t.Fatalf("Unexpected error: %v %v", err, result.Content)
+		}
+		return result.Content[0].(mcp.TextContent).Text
+	}
+	find := makeFindSession(c, idx)
+
+	if text := call(find, map[string]interface{}{"query": "week"}); !strings.Contains(text, "session-1") {
+		t.Fatalf("Expected session-1, got %s", text)
+	}
+	call(makeCreateSession(c, nil, idx), map[string]interface{}{"name": "Week 9", "session_type": "game"})
+	call(makeDeleteSession(c, newSessionLocks(c), idx), map[string]interface{}{"session_id": "session-1", "confirm": true})
+
+	text := call(find, map[string]interface{}{"query": "week"})
+	if !strings.Contains(text, "session-9") || strings.Contains(text, "session-1") {
+		t.Errorf("Expected the created session and not the deleted one, got %s", text)
+	}
+}
+
+func TestGetActiveSession(t *testing.T) {
+	tests := []struct {
+		name     string
+		sessions []videoplatform.Session
+		want     []string
+		notWant  []string
+	}{
+		{
+			name:     "one active",
+			sessions: []videoplatform.Session{{ID: "session-1", Name: "Week 3", Status: "active"}},
+			want:     []string{`"id": "session-1"`, `"status": "active"`},
+			notWant:  []string{"sessions are active"},
+		},
+		{
+			name:    "none active",
+			want:    []string{"No active session"},
+			notWant: []string{"{"},
+		},
+		{
+			name: "several active",
+			sessions: []videoplatform.Session{
+				{ID: "session-1", Name: "Varsity vs Eagles", Status: "active"},
+				{ID: "session-2", Name: "JV vs Eagles", Status: "active"},
+			},
+			want: []string{"2 sessions are active", `"id": "session-1"`, `"name": "JV vs Eagles"`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Query().Get("status") != "active" {
+					t.Errorf("Expected status=active, got %s", r.URL.Query().Get("status"))
+				}
+				json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Session]{Data: tt.sessions, Total: len(tt.sessions)})
+			})
+			defer server.Close()
+
+			result, err := makeGetActiveSession(videoplatform.New(server.URL))(context.Background(), mcp.CallToolRequest{})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result.IsError {
+				t.Fatalf("Unexpected tool error: %v", result.Content)
+			}
+			text := result.Content[0].(mcp.TextContent).Text
+			for _, s := range tt.want {
+				if !strings.Contains(text, s) {
+					t.Errorf("Expected %q in %s", s, text)
+				}
+			}
+			for _, s := range tt.notWant {
+				if strings.Contains(text, s) {
+					t.Errorf("Did not expect %q in %s", s, text)
+				}
+			}
+		})
+	}
+
+	t.Run("API error", func(t *testing.T) {
+		server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		})
+		defer server.Close()
+
+		result, _ := makeGetActiveSession(videoplatform.New(server.URL))(context.Background(), mcp.CallToolRequest{})
+		verifyError(t, result, "Failed to list sessions")
+	})
+}
+
+func TestExplainSuccess(t *testing.T) {
+	server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/tags/tag-1" {
+			t.Errorf("Expected path /api/v1/tags/tag-1, got %s", r.URL.Path)
+		}
+		down, distance, gained := 1, 10, 4
+		json.NewEncoder(w).Encode(videoplatform.Tag{ID: "tag-1", Down: &down, Distance: &distance, YardsGained: &gained})
+	})
+	defer server.Close()
+
+	c := videoplatform.New(server.URL)
+
+	t.Run("uses configured thresholds", func(t *testing.T) {
+		handler := makeExplainSuccess(c, stats.Thresholds{FirstDown: 0.5, SecondDown: 0.6, LateDown: 1})
+
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"tag_id": "tag-1"}
+
+		result, err := handler(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		var e stats.Explanation
+		json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &e)
+		if e.Success == nil || *e.Success {
+			t.Errorf("Expected unsuccessful under a 50%% rule, got %+v", e)
+		}
+	})
+
+	t.Run("missing tag_id", func(t *testing.T) {
+		handler := makeExplainSuccess(c, stats.DefaultThresholds)
+
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{}
+
+		result, _ := handler(context.Background(), req)
+		verifyError(t, result, "tag_id is required")
+	})
+}
+
+func TestListTags_ClipContext(t *testing.T) {
+	// clipPlatform serves the given tags and counts requests per clip;
+	// clip-gone has been deleted
+	clipPlatform := func(tags []videoplatform.Tag, mu *sync.Mutex, clipRequests map[string]int) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/api/v1/tags" {
+				json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Tag]{Data: tags, Total: len(tags)})
+				return
+			}
+
+			id := strings.TrimPrefix(r.URL.Path, "/api/v1/clips/")
+			mu.Lock()
+			clipRequests[id]++
+			mu.Unlock()
+			if id == "clip-gone" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			title := "Title " + id
+			json.NewEncoder(w).Encode(videoplatform.Clip{ID: id, Title: &title, StartTime: "2026-09-05T19:00:00Z", DurationSeconds: 12.5})
+		}
+	}
+
+	listTags := func(t *testing.T, tags []videoplatform.Tag, clipRequests map[string]int) listEnvelope[tagWithClip] {
+		var mu sync.Mutex
+		server := mockServer(t, clipPlatform(tags, &mu, clipRequests))
+		defer server.Close()
+
+		handler := makeListTags(videoplatform.New(server.URL), stats.DefaultThresholds, 50, newPresenter(false))
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"include_clip_context": true}
+
+		result, err := handler(context.Background(), req)
+		if err != nil || result.IsError {
+			t.Fatalf("Expected success, got %v %+v", err, result)
+		}
+		var listing listEnvelope[tagWithClip]
+		if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &listing); err != nil {
+			t.Fatalf("Failed to parse result: %v", err)
+		}
+		return listing
+	}
+
+	t.Run("one lookup per distinct clip", func(t *testing.T) {
+		clipRequests := map[string]int{}
+		listing := listTags(t, []videoplatform.Tag{
+			{ID: "tag-1", ClipID: "clip-1"},
+			{ID: "tag-2", ClipID: "clip-1"},
+			{ID: "tag-3", ClipID: "clip-2"},
+		}, clipRequests)
+
+		if len(clipRequests) != 2 || clipRequests["clip-1"] != 1 || clipRequests["clip-2"] != 1 {
+			t.Errorf("Expected one request per distinct clip, got %v", clipRequests)
+		}
+		if listing.Data[1].ClipTitle == nil || *listing.Data[1].ClipTitle != "Title clip-1" {
+			t.Errorf("Expected clip title on tag-2, got %+v", listing.Data[1])
+		}
+		if listing.Data[2].ClipDuration == nil || *listing.Data[2].ClipDuration != 12.5 {
+			t.Errorf("Expected clip duration on tag-3, got %+v", listing.Data[2])
+		}
+	})
+
+	t.Run("deleted clip placeholder", func(t *testing.T) {
+		listing := listTags(t, []videoplatform.Tag{{ID: "tag-1", ClipID: "clip-gone"}}, map[string]int{})
+
+		if listing.Data[0].ClipTitle == nil || *listing.Data[0].ClipTitle != deletedClipTitle {
+			t.Errorf("Expected deleted clip placeholder, got %+v", listing.Data[0])
+		}
+		if listing.Notice != "" {
+			t.Errorf("Expected no notice for a deleted clip, got %q", listing.Notice)
+		}
+	})
+
+	t.Run("skips pages over the threshold", func(t *testing.T) {
+		tags := make([]videoplatform.Tag, maxClipContextTags+1)
+		for i := range tags {
+			tags[i] = videoplatform.Tag{ID: "tag", ClipID: "clip-1"}
+		}
+		clipRequests := map[string]int{}
+		listing := listTags(t, tags, clipRequests)
+
+		if len(clipRequests) != 0 {
+			t.Errorf("Expected no clip lookups over the threshold, got %v", clipRequests)
+		}
+		if !strings.Contains(listing.Notice, "clip context skipped") {
+			t.Errorf("Expected a skip notice, got %q", listing.Notice)
+		}
+	})
+}
+
+func TestToolDefaults(t *testing.T) {
+	var gotLimit string
+	platform := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		gotLimit = r.URL.Query().Get("limit")
+		json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Session]{})
+	})
+	defer platform.Close()
+
+	cfg := &config.Config{Tools: config.ToolsConfig{Defaults: map[string]config.ToolDefaults{
+		"list_sessions": {Limit: 200},
+	}}}
+	s := server.NewMCPServer("video-platform", "test")
+	RegisterTools(s, videoplatform.New(platform.URL), cfg)
+
+	// The effective defaults are advertised in the limit descriptions
+	msg := json.RawMessage(`{"jsonrpc":"2.0","id":1,"method":"tools/list"}`)
+	resp, ok := s.HandleMessage(context.Background(), msg).(mcp.JSONRPCResponse)
+	if !ok {
+		t.Fatalf("Expected JSON-RPC response, got %T", resp)
+	}
+	limits := map[string]string{}
+	for _, tool := range resp.Result.(mcp.ListToolsResult).Tools {
+		if limit, ok := tool.InputSchema.Properties["limit"].(map[string]interface{}); ok {
+			limits[tool.Name], _ = limit["description"].(string)
+		}
+	}
+	if limits["list_sessions"] != "Maximum results (default 200)" {
+		t.Errorf("list_sessions limit description = %q", limits["list_sessions"])
+	}
+	if limits["list_tags"] != "Maximum results (default 50)" {
+		t.Errorf("list_tags limit description = %q", limits["list_tags"])
+	}
+
+	// and used when the caller doesn't pass a limit
+	msg = json.RawMessage(`{"jsonrpc":"2.0","id":2,"method":"tools/call","params":{"name":"list_sessions","arguments":{}}}`)
+	if _, ok := s.HandleMessage(context.Background(), msg).(mcp.JSONRPCResponse); !ok {
+		t.Fatal("Expected JSON-RPC response")
+	}
+	if gotLimit != "200" {
+		t.Errorf("list_sessions sent limit=%s, want 200", gotLimit)
+	}
+}
@@ -0,0 +1,164 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// sessionActions names the tool action for each status change in
+// videoplatform.SessionTransitions, in the order they are suggested
+var sessionActions = []struct {
+	action   string
+	from, to videoplatform.SessionStatus
+}{
+	{"start", videoplatform.SessionScheduled, videoplatform.SessionActive},
+	{"cancel", videoplatform.SessionScheduled, videoplatform.SessionCancelled},
+	{"pause", videoplatform.SessionActive, videoplatform.SessionPaused},
+	{"resume", videoplatform.SessionPaused, videoplatform.SessionActive},
+	{"complete", videoplatform.SessionActive, videoplatform.SessionCompleted},
+	{"complete", videoplatform.SessionPaused, videoplatform.SessionCompleted},
+	{"archive", videoplatform.SessionCompleted, videoplatform.SessionArchived},
+	{"archive", videoplatform.SessionCancelled, videoplatform.SessionArchived},
+}
+
+// transitionTools lists the tools that are valid from status
+func transitionTools(status videoplatform.SessionStatus) []string {
+	var tools []string
+	for _, a := range sessionActions {
+		if a.from == status && status.CanTransitionTo(a.to) {
+			tools = append(tools, a.action+"_session")
+		}
+	}
+	return tools
+}
+
+// transitionTarget is the status action moves a session to
+func transitionTarget(action string) videoplatform.SessionStatus {
+	for _, a := range sessionActions {
+		if a.action == action {
+			return a.to
+		}
+	}
+	return ""
+}
+
+// transitionAllowed reports whether action is valid for a session in
+// status, e.g. start only from scheduled
+func transitionAllowed(status videoplatform.SessionStatus, action string) bool {
+	for _, a := range sessionActions {
+		if a.action == action && a.from == status && status.CanTransitionTo(a.to) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkTransition fetches the session and returns a refusal explaining the
+// valid transitions when its status doesn't allow action, or nil when the
+// transition may be attempted. Statuses this client doesn't know are left
+// for the platform to judge.
+func checkTransition(ctx context.Context, c *videoplatform.Client, sessionID, action string) *mcp.CallToolResult {
+	current, err := c.GetSession(ctx, sessionID)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to get session: %v", err))
+	}
+	if current.Status.IsValid() && !transitionAllowed(current.Status, action) {
+		return mcp.NewToolResultError(explainTransition(*current, action))
+	}
+	return nil
+}
+
+// apiStatus returns the HTTP status of a platform error, or 0 if err isn't
+// an *APIError
+func apiStatus(err error) int {
+	var apiErr *videoplatform.APIError
+	if !errors.As(err, &apiErr) {
+		return 0
+	}
+	return apiErr.StatusCode
+}
+
+// isTransitionConflict reports whether err is the platform rejecting a
+// transition from the session's current status
+func isTransitionConflict(err error) bool {
+	status := apiStatus(err)
+	return status == http.StatusConflict || status == http.StatusUnprocessableEntity
+}
+
+// transitionError builds the result for a failed transition. When the
+// platform rejected it as invalid for the session's status, the message
+// explains the current status and suggests the valid transitions instead.
+func transitionError(ctx context.Context, c *videoplatform.Client, sessionID, action string, err error) *mcp.CallToolResult {
+	failed := mcp.NewToolResultError(fmt.Sprintf("Failed to %s session: %v", action, err))
+	if !isTransitionConflict(err) {
+		return failed
+	}
+	session, getErr := c.GetSession(ctx, sessionID)
+	if getErr != nil {
+		return failed
+	}
+	return mcp.NewToolResultError(explainTransition(*session, action))
+}
+
+// explainTransition describes why action isn't valid for the session and
+// which transitions are
+func explainTransition(s videoplatform.Session, action string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Cannot %s: session is ", action)
+	if transitionTarget(action) == s.Status {
+		b.WriteString("already ")
+	}
+	b.WriteString(string(s.Status))
+	if detail := statusDetail(s); detail != "" {
+		fmt.Fprintf(&b, " (%s)", detail)
+	}
+	b.WriteString(".")
+
+	valid := transitionTools(s.Status)
+	switch {
+	case !s.Status.IsValid():
+		b.WriteString(" Check the session with list_sessions before retrying.")
+	case len(valid) == 0:
+		fmt.Fprintf(&b, " No transitions are available from %s.", s.Status)
+	default:
+		fmt.Fprintf(&b, " Did you mean %s?", joinOr(valid))
+	}
+	return b.String()
+}
+
+// statusDetail returns when the session entered its current status, if known
+func statusDetail(s videoplatform.Session) string {
+	var label string
+	var ts *string
+	switch s.Status {
+	case videoplatform.SessionActive, videoplatform.SessionPaused:
+		label, ts = "started", s.ActualStart
+	case videoplatform.SessionCompleted, videoplatform.SessionArchived:
+		label, ts = "ended", s.ActualEnd
+	case videoplatform.SessionScheduled:
+		label, ts = "scheduled for", s.ScheduledStart
+	}
+	if ts == nil {
+		return ""
+	}
+	t, err := time.Parse(time.RFC3339, *ts)
+	if err != nil {
+		return ""
+	}
+	return label + " " + t.Format("15:04")
+}
+
+// joinOr joins items as "a", "a or b" or "a, b or c"
+func joinOr(items []string) string {
+	if len(items) <= 1 {
+		return strings.Join(items, "")
+	}
+	return strings.Join(items[:len(items)-1], ", ") + " or " + items[len(items)-1]
+}
@@ -0,0 +1,244 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// conflictPlatform rejects every transition with status and serves the
+// session in its current state
+func conflictPlatform(t *testing.T, session videoplatform.Session, status int) *videoplatform.Client {
+	platform := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			json.NewEncoder(w).Encode(session)
+			return
+		}
+		w.WriteHeader(status)
+		w.Write([]byte(`{"error":"invalid transition"}`))
+	})
+	t.Cleanup(platform.Close)
+	return videoplatform.New(platform.URL)
+}
+
+func TestTransitionConflicts(t *testing.T) {
+	started := "2026-10-17T19:02:00Z"
+	ended := "2026-10-17T21:30:00Z"
+
+	tests := []struct {
+		name    string
+		tool    func(*videoplatform.Client) server.ToolHandlerFunc
+		session videoplatform.Session
+		want    string
+	}{
+		{
+			name:    "start active",
+			tool:    func(c *videoplatform.Client) server.ToolHandlerFunc { return makeStartSession(c) },
+			session: videoplatform.Session{Status: "active", ActualStart: &started},
+			want:    "Cannot start: session is already active (started 19:02). Did you mean pause_session or complete_session?",
+		},
+		{
+			name:    "start completed",
+			tool:    func(c *videoplatform.Client) server.ToolHandlerFunc { return makeStartSession(c) },
+			session: videoplatform.Session{Status: "completed", ActualEnd: &ended},
+			want:    "Cannot start: session is completed (ended 21:30). Did you mean archive_session?",
+		},
+		{
+			name:    "pause scheduled",
+			tool:    func(c *videoplatform.Client) server.ToolHandlerFunc { return makePauseSession(c, newSessionLocks(c)) },
+			session: videoplatform.Session{Status: "scheduled"},
+			want:    "Cannot pause: session is scheduled. Did you mean start_session or cancel_session?",
+		},
+		{
+			name:    "pause paused",
+			tool:    func(c *videoplatform.Client) server.ToolHandlerFunc { return makePauseSession(c, newSessionLocks(c)) },
+			session: videoplatform.Session{Status: "paused", ActualStart: &started},
+			want:    "Cannot pause: session is already paused (started 19:02). Did you mean resume_session or complete_session?",
+		},
+		{
+			name:    "resume active",
+			tool:    func(c *videoplatform.Client) server.ToolHandlerFunc { return makeResumeSession(c, newSessionLocks(c)) },
+			session: videoplatform.Session{Status: "active", ActualStart: &started},
+			want:    "Cannot resume: session is already active (started 19:02). Did you mean pause_session or complete_session?",
+		},
+		{
+			name:    "resume scheduled",
+			tool:    func(c *videoplatform.Client) server.ToolHandlerFunc { return makeResumeSession(c, newSessionLocks(c)) },
+			session: videoplatform.Session{Status: "scheduled"},
+			want:    "Cannot resume: session is scheduled. Did you mean start_session or cancel_session?",
+		},
+		{
+			name: "complete scheduled",
+			tool: func(c *videoplatform.Client) server.ToolHandlerFunc {
+				return makeCompleteSession(c, newSessionLocks(c))
+			},
+			session: videoplatform.Session{Status: "scheduled"},
+			want:    "Cannot complete: session is scheduled. Did you mean start_session or cancel_session?",
+		},
+		{
+			name: "complete archived",
+			tool: func(c *videoplatform.Client) server.ToolHandlerFunc {
+				return makeCompleteSession(c, newSessionLocks(c))
+			},
+			session: videoplatform.Session{Status: "archived"},
+			want:    "Cannot complete: session is archived. No transitions are available from archived.",
+		},
+	}
+
+	for _, tt := range tests {
+		for _, status := range []int{http.StatusConflict, http.StatusUnprocessableEntity} {
+			t.Run(tt.name, func(t *testing.T) {
+				tt.session.ID = "session-1"
+				handler := tt.tool(conflictPlatform(t, tt.session, status))
+
+				req := mcp.CallToolRequest{}
+				req.Params.Arguments = map[string]interface{}{"session_id": "session-1"}
+				result, err := handler(context.Background(), req)
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				if !result.IsError {
+					t.Fatal("Expected error result")
+				}
+				if got := result.Content[0].(mcp.TextContent).Text; got != tt.want {
+					t.Errorf("got %q\nwant %q", got, tt.want)
+				}
+			})
+		}
+	}
+}
+
+func TestCheckTransition(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    videoplatform.SessionStatus
+		wantErr   string
+		mutations int
+	}{
+		{name: "allowed", status: "scheduled", mutations: 1},
+		{name: "refused before mutation", status: "completed", wantErr: "Cannot start: session is completed. Did you mean archive_session?"},
+		{name: "unknown status left to platform", status: "transcoding", mutations: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mutations int
+			platform := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet {
+					mutations++
+				}
+				json.NewEncoder(w).Encode(videoplatform.Session{ID: "session-1", Name: "Week 3", Status: tt.status})
+			})
+			defer platform.Close()
+
+			req := mcp.CallToolRequest{}
+			req.Params.Arguments = map[string]interface{}{"session_id": "session-1"}
+			result, _ := makeStartSession(videoplatform.New(platform.URL))(context.Background(), req)
+			if tt.wantErr != "" {
+				if got := result.Content[0].(mcp.TextContent).Text; !result.IsError || got != tt.wantErr {
+					t.Errorf("got %q\nwant error %q", got, tt.wantErr)
+				}
+			} else if result.IsError {
+				t.Errorf("Unexpected tool error: %v", result.Content)
+			}
+			if mutations != tt.mutations {
+				t.Errorf("%d mutation requests, want %d", mutations, tt.mutations)
+			}
+		})
+	}
+
+	t.Run("session changed after check", func(t *testing.T) {
+		// Another client starts the session between the check and the
+		// start call; the platform's conflict is still explained
+		var gets int
+		platform := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet {
+				w.WriteHeader(http.StatusConflict)
+				return
+			}
+			gets++
+			status := videoplatform.SessionScheduled
+			if gets > 1 {
+				status = videoplatform.SessionActive
+			}
+			json.NewEncoder(w).Encode(videoplatform.Session{ID: "session-1", Status: status})
+		})
+		defer platform.Close()
+
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"session_id": "session-1"}
+		result, _ := makeStartSession(videoplatform.New(platform.URL))(context.Background(), req)
+		verifyError(t, result, "Cannot start: session is already active")
+	})
+}
+
+func TestTransitionAllowed(t *testing.T) {
+	tests := []struct {
+		status videoplatform.SessionStatus
+		action string
+		want   bool
+	}{
+		{videoplatform.SessionScheduled, "start", true},
+		{videoplatform.SessionActive, "pause", true},
+		{videoplatform.SessionActive, "complete", true},
+		{videoplatform.SessionPaused, "resume", true},
+		{videoplatform.SessionPaused, "complete", true},
+		{videoplatform.SessionCompleted, "start", false},
+		{videoplatform.SessionActive, "start", false},
+		{videoplatform.SessionScheduled, "pause", false},
+		{videoplatform.SessionActive, "resume", false},
+		{videoplatform.SessionScheduled, "complete", false},
+		{videoplatform.SessionArchived, "archive", false},
+		{"transcoding", "start", false},
+	}
+	for _, tt := range tests {
+		if got := transitionAllowed(tt.status, tt.action); got != tt.want {
+			t.Errorf("transitionAllowed(%s, %s) = %v, want %v", tt.status, tt.action, got, tt.want)
+		}
+	}
+}
+
+func TestTransitionError_OtherFailures(t *testing.T) {
+	handler := makeStartSession(conflictPlatform(t, videoplatform.Session{ID: "session-1", Status: "scheduled"}, http.StatusInternalServerError))
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{"session_id": "session-1"}
+	result, _ := handler(context.Background(), req)
+	verifyError(t, result, "Failed to start session: API error 500")
+	if strings.Contains(result.Content[0].(mcp.TextContent).Text, "Did you mean") {
+		t.Error("Non-conflict errors should not suggest transitions")
+	}
+}
+
+func TestSessionActions_CoverTransitions(t *testing.T) {
+	covered := make(map[[2]videoplatform.SessionStatus]string)
+	for _, a := range sessionActions {
+		edge := [2]videoplatform.SessionStatus{a.from, a.to}
+		if !a.from.CanTransitionTo(a.to) {
+			t.Errorf("%s: %s -> %s is not in SessionTransitions", a.action, a.from, a.to)
+		}
+		if prev, ok := covered[edge]; ok {
+			t.Errorf("%s -> %s named by both %s and %s", a.from, a.to, prev, a.action)
+		}
+		covered[edge] = a.action
+	}
+	for from, targets := range videoplatform.SessionTransitions {
+		for _, to := range targets {
+			if _, ok := covered[[2]videoplatform.SessionStatus{from, to}]; !ok {
+				t.Errorf("%s -> %s has no tool action", from, to)
+			}
+		}
+	}
+}
+
+func TestExplainTransition_UnknownStatus(t *testing.T) {
+	got := explainTransition(videoplatform.Session{Status: "transcoding"}, "start")
+	if want := "Cannot start: session is transcoding. Check the session with list_sessions before retrying."; got != want {
+		t.Errorf("got %q\nwant %q", got, want)
+	}
+}
@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// untaggedReport is the result of list_untagged_clips. Untagged counts
+// every untagged clip even when Data is cut to the requested limit.
+type untaggedReport struct {
+	SessionID string               `json:"session_id"`
+	Clips     int                  `json:"clips"`
+	Untagged  int                  `json:"untagged"`
+	Data      []videoplatform.Clip `json:"data"`
+}
+
+// untaggedClips returns the clips no tag points at, ordered by start time
+func untaggedClips(clips []videoplatform.Clip, tags []videoplatform.Tag) []videoplatform.Clip {
+	tagged := make(map[string]bool)
+	for _, tag := range tags {
+		tagged[tag.ClipID] = true
+	}
+	untagged := []videoplatform.Clip{}
+	for _, clip := range clips {
+		if !tagged[clip.ID] {
+			untagged = append(untagged, clip)
+		}
+	}
+	sortByStartTime(untagged)
+	return untagged
+}
+
+func makeListUntaggedClips(c *videoplatform.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		sessionID, _ := req.Params.Arguments["session_id"].(string)
+		if sessionID == "" {
+			return mcp.NewToolResultError("session_id is required"), nil
+		}
+		limit := 0
+		if n, ok := req.Params.Arguments["limit"].(float64); ok && n > 0 {
+			limit = int(n)
+		}
+
+		var clips []videoplatform.Clip
+		var tags []videoplatform.Tag
+		var clipsErr, tagsErr error
+		var wg sync.WaitGroup
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			clips, clipsErr = c.ListAllClips(ctx, videoplatform.ListClipsParams{SessionID: sessionID})
+		}()
+		go func() {
+			defer wg.Done()
+			tags, tagsErr = c.ListAllTags(ctx, videoplatform.ListTagsParams{SessionID: sessionID})
+		}()
+		wg.Wait()
+		// A partial listing would report tagged clips as untagged
+		if clipsErr != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list clips: %v", clipsErr)), nil
+		}
+		if tagsErr != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list tags: %v", tagsErr)), nil
+		}
+
+		untagged := untaggedClips(clips, tags)
+		report := untaggedReport{SessionID: sessionID, Clips: len(clips), Untagged: len(untagged), Data: untagged}
+		text := fmt.Sprintf("%d of %d clips untagged", report.Untagged, report.Clips)
+		if limit > 0 && limit < len(untagged) {
+			report.Data = untagged[:limit]
+			text += fmt.Sprintf(" (showing the first %d by start time)", limit)
+		}
+
+		data, _ := json.MarshalIndent(report, "", "  ")
+		return mcp.NewToolResultText(fmt.Sprintf("%s\n%s", text, data)), nil
+	}
+}
@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestListUntaggedClips(t *testing.T) {
+	// 118 clips listed latest first, more than one page; every clip from
+	// clip-42 on is tagged
+	start := time.Date(2026, 10, 17, 19, 0, 0, 0, time.UTC)
+	var clips []videoplatform.Clip
+	var tags []videoplatform.Tag
+	for i := 117; i >= 0; i-- {
+		id := fmt.Sprintf("clip-%d", i)
+		clips = append(clips, videoplatform.Clip{ID: id, SessionID: "s-1", StartTime: start.Add(time.Duration(i) * time.Minute).Format(time.RFC3339)})
+		if i >= 42 {
+			tags = append(tags, videoplatform.Tag{ID: "tag-" + id, ClipID: id})
+		}
+	}
+	c := bundlePlatform(t, clips, tags)
+
+	list := func(t *testing.T, args map[string]interface{}) (string, untaggedReport) {
+		t.Helper()
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = args
+		result, err := makeListUntaggedClips(c)(context.Background(), req)
+		if err != nil || result.IsError {
+			t.Fatalf("Expected success, got %v %v", result, err)
+		}
+		header, body, _ := strings.Cut(result.Content[0].(mcp.TextContent).Text, "\n")
+		var report untaggedReport
+		if err := json.Unmarshal([]byte(body), &report); err != nil {
+			t.Fatalf("body is not JSON: %v", err)
+		}
+		return header, report
+	}
+
+	t.Run("all", func(t *testing.T) {
+		header, report := list(t, map[string]interface{}{"session_id": "s-1"})
+		if header != "42 of 118 clips untagged" {
+			t.Errorf("header = %q", header)
+		}
+		if report.Clips != 118 || report.Untagged != 42 || len(report.Data) != 42 {
+			t.Fatalf("report has %d clips, %d untagged, %d listed", report.Clips, report.Untagged, len(report.Data))
+		}
+		if report.Data[0].ID != "clip-0" || report.Data[41].ID != "clip-41" {
+			t.Errorf("untagged clips not in start-time order: first %s, last %s", report.Data[0].ID, report.Data[41].ID)
+		}
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		header, report := list(t, map[string]interface{}{"session_id": "s-1", "limit": float64(5)})
+		if header != "42 of 118 clips untagged (showing the first 5 by start time)" {
+			t.Errorf("header = %q", header)
+		}
+		if report.Untagged != 42 || len(report.Data) != 5 || report.Data[4].ID != "clip-4" {
+			t.Errorf("report has %d untagged, %d listed", report.Untagged, len(report.Data))
+		}
+	})
+
+	t.Run("missing session_id", func(t *testing.T) {
+		result, _ := makeListUntaggedClips(c)(context.Background(), mcp.CallToolRequest{})
+		verifyError(t, result, "session_id is required")
+	})
+}
@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// Bounds of upcoming_sessions days
+const (
+	defaultUpcomingDays = 7
+	maxUpcomingDays     = 365
+)
+
+// scheduledSession is a scheduled session with how far it is from now
+type scheduledSession struct {
+	videoplatform.Session
+	OverdueBy string `json:"overdue_by,omitempty"`
+	StartsIn  string `json:"starts_in,omitempty"`
+}
+
+// upcomingReport is the result of upcoming_sessions. Later counts the
+// sessions scheduled beyond the window, which are not listed.
+type upcomingReport struct {
+	Days        int                     `json:"days"`
+	Overdue     []scheduledSession      `json:"overdue"`
+	Upcoming    []scheduledSession      `json:"upcoming"`
+	Unscheduled []videoplatform.Session `json:"unscheduled"`
+	Later       int                     `json:"later"`
+}
+
+// splitScheduled sorts scheduled sessions into overdue (most overdue
+// first), upcoming within days of now (soonest first) and those without a
+// readable scheduled_start
+func splitScheduled(sessions []videoplatform.Session, days int, now time.Time) upcomingReport {
+	report := upcomingReport{
+		Days:        days,
+		Overdue:     []scheduledSession{},
+		Upcoming:    []scheduledSession{},
+		Unscheduled: []videoplatform.Session{},
+	}
+	horizon := now.AddDate(0, 0, days)
+	starts := make(map[string]time.Time, len(sessions))
+	for _, s := range sessions {
+		if s.ScheduledStart == nil {
+			report.Unscheduled = append(report.Unscheduled, s)
+			continue
+		}
+		start, err := time.Parse(time.RFC3339, *s.ScheduledStart)
+		if err != nil {
+			report.Unscheduled = append(report.Unscheduled, s)
+			continue
+		}
+		starts[s.ID] = start
+		switch {
+		case start.Before(now):
+			report.Overdue = append(report.Overdue, scheduledSession{Session: s, OverdueBy: formatElapsed(now.Sub(start))})
+		case start.Before(horizon):
+			report.Upcoming = append(report.Upcoming, scheduledSession{Session: s, StartsIn: formatElapsed(start.Sub(now))})
+		default:
+			report.Later++
+		}
+	}
+	byStart := func(list []scheduledSession) func(i, j int) bool {
+		return func(i, j int) bool { return starts[list[i].ID].Before(starts[list[j].ID]) }
+	}
+	sort.SliceStable(report.Overdue, byStart(report.Overdue))
+	sort.SliceStable(report.Upcoming, byStart(report.Upcoming))
+	return report
+}
+
+func makeUpcomingSessions(c *videoplatform.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		days := defaultUpcomingDays
+		if d, ok := req.Params.Arguments["days"].(float64); ok {
+			days = int(d)
+			if days < 1 || days > maxUpcomingDays {
+				return mcp.NewToolResultError(fmt.Sprintf("days must be between 1 and %d", maxUpcomingDays)), nil
+			}
+		}
+
+		sessions, err := c.ListAllSessions(ctx, videoplatform.ListSessionsParams{Status: videoplatform.SessionScheduled})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list sessions: %v", err)), nil
+		}
+
+		data, _ := json.MarshalIndent(splitScheduled(sessions, days, time.Now()), "", "  ")
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
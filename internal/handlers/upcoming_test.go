@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestSplitScheduled(t *testing.T) {
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) *string {
+		s := now.Add(d).Format(time.RFC3339)
+		return &s
+	}
+	bad := "next tuesday"
+	sessions := []videoplatform.Session{
+		{ID: "in-two-days", ScheduledStart: at(48 * time.Hour)},
+		{ID: "late-by-hour", ScheduledStart: at(-time.Hour)},
+		{ID: "no-start"},
+		{ID: "next-month", ScheduledStart: at(30 * 24 * time.Hour)},
+		{ID: "late-by-day", ScheduledStart: at(-24 * time.Hour)},
+		{ID: "in-an-hour", ScheduledStart: at(time.Hour)},
+		{ID: "unreadable", ScheduledStart: &bad},
+	}
+
+	report := splitScheduled(sessions, 7, now)
+
+	if len(report.Overdue) != 2 || report.Overdue[0].ID != "late-by-day" || report.Overdue[1].ID != "late-by-hour" {
+		t.Errorf("overdue = %+v, want late-by-day then late-by-hour", report.Overdue)
+	}
+	if report.Overdue[1].OverdueBy != "1h 0m" || report.Overdue[1].StartsIn != "" {
+		t.Errorf("overdue_by = %q, starts_in = %q", report.Overdue[1].OverdueBy, report.Overdue[1].StartsIn)
+	}
+	if len(report.Upcoming) != 2 || report.Upcoming[0].ID != "in-an-hour" || report.Upcoming[1].ID != "in-two-days" {
+		t.Errorf("upcoming = %+v, want in-an-hour then in-two-days", report.Upcoming)
+	}
+	if report.Upcoming[0].StartsIn != "1h 0m" {
+		t.Errorf("starts_in = %q, want 1h 0m", report.Upcoming[0].StartsIn)
+	}
+	if len(report.Unscheduled) != 2 || report.Unscheduled[0].ID != "no-start" || report.Unscheduled[1].ID != "unreadable" {
+		t.Errorf("unscheduled = %+v, want no-start and unreadable", report.Unscheduled)
+	}
+	if report.Later != 1 || report.Days != 7 {
+		t.Errorf("later %d, days %d; want 1, 7", report.Later, report.Days)
+	}
+
+	if wide := splitScheduled(sessions, 31, now); len(wide.Upcoming) != 3 || wide.Later != 0 {
+		t.Errorf("31 days: upcoming %d, later %d; want 3, 0", len(wide.Upcoming), wide.Later)
+	}
+}
+
+func TestUpcomingSessions(t *testing.T) {
+	soon := time.Now().Add(2 * time.Hour).Format(time.RFC3339)
+	var query map[string][]string
+	server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		sessions := []videoplatform.Session{{ID: "s-1", Status: videoplatform.SessionScheduled, ScheduledStart: &soon}}
+		json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Session]{Data: sessions, Total: len(sessions)})
+	})
+	defer server.Close()
+	handler := makeUpcomingSessions(videoplatform.New(server.URL))
+
+	t.Run("default days", func(t *testing.T) {
+		result, err := handler(context.Background(), mcp.CallToolRequest{})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result.IsError {
+			t.Fatalf("Unexpected tool error: %v", result.Content)
+		}
+		var got upcomingReport
+		if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &got); err != nil {
+			t.Fatalf("result is not JSON: %v", err)
+		}
+		if got.Days != 7 || len(got.Upcoming) != 1 || got.Upcoming[0].ID != "s-1" {
+			t.Errorf("report = %+v", got)
+		}
+		if query["status"][0] != "scheduled" {
+			t.Errorf("status = %v, want scheduled", query["status"])
+		}
+	})
+
+	t.Run("invalid days", func(t *testing.T) {
+		for _, days := range []float64{0, -3, 400} {
+			req := mcp.CallToolRequest{}
+			req.Params.Arguments = map[string]interface{}{"days": days}
+			result, _ := handler(context.Background(), req)
+			verifyError(t, result, "days must be between 1 and 365")
+		}
+	})
+
+	t.Run("listing fails", func(t *testing.T) {
+		failing := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		})
+		defer failing.Close()
+		result, _ := makeUpcomingSessions(videoplatform.New(failing.URL))(context.Background(), mcp.CallToolRequest{})
+		verifyError(t, result, "Failed to list sessions")
+	})
+}
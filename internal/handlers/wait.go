@@ -0,0 +1,139 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// waitPollInterval is how often wait_for_session_status checks the session
+const waitPollInterval = 2 * time.Second
+
+// Bounds of wait_for_session_status timeout_seconds
+const (
+	defaultWaitTimeout = 30 * time.Second
+	maxWaitTimeout     = 5 * time.Minute
+)
+
+// waitTimeoutError reports a session that didn't reach the target status
+// in time. Last is the status observed by the final poll.
+type waitTimeoutError struct {
+	Timeout time.Duration
+	Last    videoplatform.SessionStatus
+}
+
+func (e *waitTimeoutError) Error() string {
+	last := string(e.Last)
+	if last == "" {
+		last = "unknown"
+	}
+	return fmt.Sprintf("timed out after %s; last status was %s", e.Timeout, last)
+}
+
+// canReach reports whether a session in status from can ever move to to
+func canReach(from, to videoplatform.SessionStatus) bool {
+	seen := map[videoplatform.SessionStatus]bool{from: true}
+	queue := []videoplatform.SessionStatus{from}
+	for len(queue) > 0 {
+		s := queue[0]
+		queue = queue[1:]
+		for _, next := range videoplatform.SessionTransitions[s] {
+			if next == to {
+				return true
+			}
+			if !seen[next] {
+				seen[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+	return false
+}
+
+// waitForStatus polls the session every interval until it has status
+// target. It stops early with an error when the session reaches a status
+// from which target can't be reached, when timeout passes, or when ctx is
+// done.
+func waitForStatus(ctx context.Context, c *videoplatform.Client, sessionID string, target videoplatform.SessionStatus, timeout, interval time.Duration) (*videoplatform.Session, error) {
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var last videoplatform.SessionStatus
+	for {
+		s, err := c.GetSession(waitCtx, sessionID)
+		switch {
+		case ctx.Err() != nil:
+			return nil, ctx.Err()
+		case waitCtx.Err() != nil:
+			return nil, &waitTimeoutError{Timeout: timeout, Last: last}
+		case err != nil:
+			return nil, err
+		}
+
+		last = s.Status
+		if s.Status == target {
+			return s, nil
+		}
+		if s.Status.IsValid() && !canReach(s.Status, target) {
+			return nil, fmt.Errorf("session is %s and can no longer become %s", s.Status, target)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-waitCtx.Done():
+			return nil, &waitTimeoutError{Timeout: timeout, Last: last}
+		case <-ticker.C:
+		}
+	}
+}
+
+func makeWaitForSessionStatus(c *videoplatform.Client, interval time.Duration) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		sessionID, _ := req.Params.Arguments["session_id"].(string)
+		if sessionID == "" {
+			return mcp.NewToolResultError("session_id is required"), nil
+		}
+		target, err := enumArg(req.Params.Arguments, "target_status", videoplatform.SessionStatuses)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if target == "" {
+			return mcp.NewToolResultError("target_status is required"), nil
+		}
+		timeout := defaultWaitTimeout
+		if seconds, ok := req.Params.Arguments["timeout_seconds"].(float64); ok {
+			timeout = time.Duration(seconds * float64(time.Second))
+			if timeout <= 0 || timeout > maxWaitTimeout {
+				return mcp.NewToolResultError(fmt.Sprintf("timeout_seconds must be positive and at most %d", int(maxWaitTimeout/time.Second))), nil
+			}
+		}
+
+		started := time.Now()
+		s, err := waitForStatus(ctx, c, sessionID, target, timeout, interval)
+		if err != nil {
+			var timeoutErr *waitTimeoutError
+			switch {
+			case errors.As(err, &timeoutErr):
+				return mcp.NewToolResultError(fmt.Sprintf("Session %s did not become %s: %v", sessionID, target, err)), nil
+			case apiStatus(err) == http.StatusNotFound:
+				return mcp.NewToolResultError(fmt.Sprintf("Session %s not found", sessionID)), nil
+			case errors.Is(err, context.Canceled):
+				return mcp.NewToolResultError(fmt.Sprintf("Stopped waiting for session %s: %v", sessionID, err)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Failed waiting for session %s: %v", sessionID, err)), nil
+		}
+
+		data, _ := json.MarshalIndent(s, "", "  ")
+		return mcp.NewToolResultText(fmt.Sprintf("Session %s is %s after %s:\n%s", sessionID, target, time.Since(started).Round(time.Second), data)), nil
+	}
+}
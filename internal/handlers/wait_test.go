@@ -0,0 +1,137 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// statusSequence serves session s-1 with statuses in turn, repeating the
+// last one, and counts the polls
+func statusSequence(t *testing.T, polls *atomic.Int32, statuses ...videoplatform.SessionStatus) *videoplatform.Client {
+	server := mockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/sessions/s-1" {
+			w.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(w).Encode(map[string]string{"error": "session not found"})
+			return
+		}
+		n := int(polls.Add(1)) - 1
+		json.NewEncoder(w).Encode(videoplatform.Session{ID: "s-1", Status: statuses[min(n, len(statuses)-1)]})
+	})
+	t.Cleanup(server.Close)
+	return videoplatform.New(server.URL)
+}
+
+func waitRequest(args map[string]interface{}) mcp.CallToolRequest {
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = args
+	return req
+}
+
+func TestWaitForSessionStatus(t *testing.T) {
+	t.Run("reaches target", func(t *testing.T) {
+		var polls atomic.Int32
+		c := statusSequence(t, &polls, videoplatform.SessionScheduled, videoplatform.SessionScheduled, videoplatform.SessionActive)
+		handler := makeWaitForSessionStatus(c, 5*time.Millisecond)
+
+		result, err := handler(context.Background(), waitRequest(map[string]interface{}{"session_id": "s-1", "target_status": "active"}))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result.IsError {
+			t.Fatalf("Unexpected tool error: %v", result.Content)
+		}
+		if polls.Load() != 3 {
+			t.Errorf("polled %d times, want 3", polls.Load())
+		}
+	})
+
+	t.Run("timeout reports last status", func(t *testing.T) {
+		var polls atomic.Int32
+		c := statusSequence(t, &polls, videoplatform.SessionScheduled)
+		handler := makeWaitForSessionStatus(c, 5*time.Millisecond)
+
+		result, _ := handler(context.Background(), waitRequest(map[string]interface{}{"session_id": "s-1", "target_status": "active", "timeout_seconds": 0.05}))
+		verifyError(t, result, "did not become active: timed out after 50ms; last status was scheduled")
+	})
+
+	t.Run("target unreachable", func(t *testing.T) {
+		var polls atomic.Int32
+		c := statusSequence(t, &polls, videoplatform.SessionCancelled)
+		handler := makeWaitForSessionStatus(c, time.Hour)
+
+		result, _ := handler(context.Background(), waitRequest(map[string]interface{}{"session_id": "s-1", "target_status": "active"}))
+		verifyError(t, result, "session is cancelled and can no longer become active")
+	})
+
+	t.Run("client disconnect stops polling", func(t *testing.T) {
+		var polls atomic.Int32
+		c := statusSequence(t, &polls, videoplatform.SessionScheduled)
+		handler := makeWaitForSessionStatus(c, 5*time.Millisecond)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(30*time.Millisecond, cancel)
+		result, _ := handler(ctx, waitRequest(map[string]interface{}{"session_id": "s-1", "target_status": "active"}))
+		verifyError(t, result, "Stopped waiting for session s-1")
+
+		// Let a poll that was already in flight land before counting
+		time.Sleep(10 * time.Millisecond)
+		stopped := polls.Load()
+		time.Sleep(30 * time.Millisecond)
+		if polls.Load() != stopped {
+			t.Errorf("polling continued after the context was cancelled")
+		}
+	})
+
+	t.Run("session not found", func(t *testing.T) {
+		var polls atomic.Int32
+		handler := makeWaitForSessionStatus(statusSequence(t, &polls, videoplatform.SessionActive), time.Millisecond)
+
+		result, _ := handler(context.Background(), waitRequest(map[string]interface{}{"session_id": "missing", "target_status": "active"}))
+		verifyError(t, result, "Session missing not found")
+	})
+
+	t.Run("invalid arguments", func(t *testing.T) {
+		handler := makeWaitForSessionStatus(videoplatform.New("http://unused"), time.Millisecond)
+		tests := []struct {
+			args map[string]interface{}
+			want string
+		}{
+			{map[string]interface{}{"target_status": "active"}, "session_id is required"},
+			{map[string]interface{}{"session_id": "s-1"}, "target_status is required"},
+			{map[string]interface{}{"session_id": "s-1", "target_status": "recording"}, "invalid target_status"},
+			{map[string]interface{}{"session_id": "s-1", "target_status": "active", "timeout_seconds": float64(0)}, "timeout_seconds must be positive and at most 300"},
+			{map[string]interface{}{"session_id": "s-1", "target_status": "active", "timeout_seconds": float64(301)}, "timeout_seconds must be positive and at most 300"},
+		}
+		for _, tt := range tests {
+			result, _ := handler(context.Background(), waitRequest(tt.args))
+			verifyError(t, result, tt.want)
+		}
+	})
+}
+
+func TestCanReach(t *testing.T) {
+	tests := []struct {
+		from, to videoplatform.SessionStatus
+		want     bool
+	}{
+		{videoplatform.SessionScheduled, videoplatform.SessionActive, true},
+		{videoplatform.SessionScheduled, videoplatform.SessionArchived, true},
+		{videoplatform.SessionPaused, videoplatform.SessionCompleted, true},
+		{videoplatform.SessionCancelled, videoplatform.SessionArchived, true},
+		{videoplatform.SessionCancelled, videoplatform.SessionActive, false},
+		{videoplatform.SessionCompleted, videoplatform.SessionActive, false},
+		{videoplatform.SessionArchived, videoplatform.SessionCompleted, false},
+	}
+	for _, tt := range tests {
+		if got := canReach(tt.from, tt.to); got != tt.want {
+			t.Errorf("canReach(%s, %s) = %v, want %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,311 @@
+// Package index keeps an optional on-disk cache of session names so name
+// lookups don't have to re-scan the platform API.
+//
+// The cache is best-effort: the file may be deleted or corrupted at any time
+// and is rebuilt from the platform on the next lookup.
+package index
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+)
+
+// fileName is the name of the index file inside the data directory
+const fileName = "index.json"
+
+// refreshTimeout bounds a background refresh
+const refreshTimeout = 30 * time.Second
+
+// SessionEntry is the cached identity of a session
+type SessionEntry struct {
+	ID        string   `json:"id"`
+	Name      string   `json:"name"`
+	Opponent  string   `json:"opponent,omitempty"`
+	Opponents []string `json:"opponents,omitempty"`
+	Date      string   `json:"date,omitempty"`
+}
+
+// OpponentNames returns every opponent of the session. Entries written
+// before multi-opponent sessions only have Opponent.
+func (e SessionEntry) OpponentNames() []string {
+	if len(e.Opponents) > 0 {
+		return e.Opponents
+	}
+	if e.Opponent != "" {
+		return []string{e.Opponent}
+	}
+	return nil
+}
+
+// EntryFromSession builds the cached entry for a session. Date is the actual
+// start, falling back to the scheduled start and then creation time.
+func EntryFromSession(s videoplatform.Session) SessionEntry {
+	entry := SessionEntry{ID: s.ID, Name: s.Name, Date: s.CreatedAt}
+	if s.Opponent != nil {
+		entry.Opponent = *s.Opponent
+	}
+	entry.Opponents = s.Opponents
+	if s.ScheduledStart != nil {
+		entry.Date = *s.ScheduledStart
+	}
+	if s.ActualStart != nil {
+		entry.Date = *s.ActualStart
+	}
+	return entry
+}
+
+// snapshot is the on-disk format of the index
+type snapshot struct {
+	RefreshedAt time.Time               `json:"refreshed_at"`
+	Sessions    map[string]SessionEntry `json:"sessions"`
+}
+
+// Index is a lazily refreshed cache of session names. Lookups serve cached
+// data and trigger a background refresh once it is older than the staleness
+// threshold; the first lookup with no usable cache refreshes synchronously.
+// Sessions this server creates, changes or deletes are applied with Upsert
+// and Remove so lookups see them before the next refresh.
+type Index struct {
+	c      *videoplatform.Client
+	path   string
+	maxAge time.Duration
+	now    func() time.Time
+
+	mu         sync.Mutex
+	snap       *snapshot
+	loaded     bool
+	refreshing bool
+	wg         sync.WaitGroup
+
+	// inflight counts running refreshes; edits made while one runs are
+	// kept in edits, a nil entry marking a removal, and re-applied to its
+	// result since its listing may predate them
+	inflight int
+	edits    map[string]*SessionEntry
+}
+
+// Open returns an index stored under dir, or nil when dir is empty and the
+// cache is disabled
+func Open(c *videoplatform.Client, dir string, maxAge time.Duration) *Index {
+	if dir == "" {
+		return nil
+	}
+	return &Index{
+		c:      c,
+		path:   filepath.Join(dir, fileName),
+		maxAge: maxAge,
+		now:    time.Now,
+	}
+}
+
+// Session returns the cached entry for a session ID
+func (ix *Index) Session(ctx context.Context, id string) (SessionEntry, bool, error) {
+	snap, err := ix.current(ctx)
+	if err != nil {
+		return SessionEntry{}, false, err
+	}
+	entry, ok := snap.Sessions[id]
+	return entry, ok, nil
+}
+
+// Sessions returns every cached session, most recent first
+func (ix *Index) Sessions(ctx context.Context) ([]SessionEntry, error) {
+	snap, err := ix.current(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]SessionEntry, 0, len(snap.Sessions))
+	for _, entry := range snap.Sessions {
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Date != entries[j].Date {
+			return entries[i].Date > entries[j].Date
+		}
+		return entries[i].ID < entries[j].ID
+	})
+	return entries, nil
+}
+
+// Upsert records a session this server created or changed. It does
+// nothing on a nil index.
+func (ix *Index) Upsert(s videoplatform.Session) {
+	entry := EntryFromSession(s)
+	ix.edit(s.ID, &entry)
+}
+
+// Remove drops a session this server deleted. It does nothing on a nil
+// index.
+func (ix *Index) Remove(id string) {
+	ix.edit(id, nil)
+}
+
+// edit sets or, when entry is nil, removes a session in the cached
+// snapshot and persists it. Readers may hold the current snapshot, so the
+// change is made to a copy.
+func (ix *Index) edit(id string, entry *SessionEntry) {
+	if ix == nil {
+		return
+	}
+
+	ix.mu.Lock()
+	if !ix.loaded {
+		ix.snap = ix.load()
+		ix.loaded = true
+	}
+	if ix.inflight > 0 {
+		if ix.edits == nil {
+			ix.edits = make(map[string]*SessionEntry)
+		}
+		ix.edits[id] = entry
+	}
+	if ix.snap == nil {
+		// Nothing cached yet; the first lookup lists the platform anyway
+		ix.mu.Unlock()
+		return
+	}
+	snap := &snapshot{RefreshedAt: ix.snap.RefreshedAt, Sessions: maps.Clone(ix.snap.Sessions)}
+	applyEdit(snap.Sessions, id, entry)
+	ix.snap = snap
+	ix.mu.Unlock()
+
+	_ = ix.save(snap)
+}
+
+// applyEdit sets or removes one session in a snapshot's sessions
+func applyEdit(sessions map[string]SessionEntry, id string, entry *SessionEntry) {
+	if entry == nil {
+		delete(sessions, id)
+		return
+	}
+	sessions[id] = *entry
+}
+
+// current returns the snapshot to serve, loading it from disk on first use
+func (ix *Index) current(ctx context.Context) (*snapshot, error) {
+	ix.mu.Lock()
+	if !ix.loaded {
+		ix.snap = ix.load()
+		ix.loaded = true
+	}
+	snap := ix.snap
+	ix.mu.Unlock()
+
+	if snap == nil {
+		return ix.refresh(ctx)
+	}
+	if ix.now().Sub(snap.RefreshedAt) > ix.maxAge {
+		ix.refreshInBackground()
+	}
+	return snap, nil
+}
+
+// load reads the index file, returning nil if it is missing or unreadable
+func (ix *Index) load() *snapshot {
+	data, err := os.ReadFile(ix.path)
+	if err != nil {
+		return nil
+	}
+	var snap snapshot
+	if err := json.Unmarshal(data, &snap); err != nil || snap.Sessions == nil {
+		return nil
+	}
+	return &snap
+}
+
+// refresh rebuilds the index from the platform and persists it
+func (ix *Index) refresh(ctx context.Context) (*snapshot, error) {
+	ix.mu.Lock()
+	ix.inflight++
+	ix.mu.Unlock()
+	defer func() {
+		ix.mu.Lock()
+		if ix.inflight--; ix.inflight == 0 {
+			ix.edits = nil
+		}
+		ix.mu.Unlock()
+	}()
+
+	sessions, err := ix.c.ListAllSessions(ctx, videoplatform.ListSessionsParams{})
+	if err != nil {
+		return nil, err
+	}
+
+	snap := &snapshot{
+		RefreshedAt: ix.now(),
+		Sessions:    make(map[string]SessionEntry, len(sessions)),
+	}
+	for _, s := range sessions {
+		snap.Sessions[s.ID] = EntryFromSession(s)
+	}
+
+	ix.mu.Lock()
+	for id, entry := range ix.edits {
+		applyEdit(snap.Sessions, id, entry)
+	}
+	ix.snap = snap
+	ix.mu.Unlock()
+
+	// The cache is best-effort; a failed write only costs a re-scan later
+	_ = ix.save(snap)
+	return snap, nil
+}
+
+func (ix *Index) refreshInBackground() {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+	if ix.refreshing {
+		return
+	}
+	ix.refreshing = true
+	ix.wg.Add(1)
+
+	go func() {
+		defer ix.wg.Done()
+		ctx, cancel := context.WithTimeout(context.Background(), refreshTimeout)
+		defer cancel()
+
+		ix.refresh(ctx)
+
+		ix.mu.Lock()
+		ix.refreshing = false
+		ix.mu.Unlock()
+	}()
+}
+
+// save writes the snapshot atomically so a crash never leaves a torn file
+func (ix *Index) save(snap *snapshot) error {
+	dir := filepath.Dir(ix.path)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(snap)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(dir, fileName+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), ix.path)
+}
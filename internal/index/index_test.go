@@ -0,0 +1,181 @@
+package index
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+)
+
+// fakePlatform serves sessions and counts session listings
+type fakePlatform struct {
+	mu       sync.Mutex
+	sessions []videoplatform.Session
+	scans    atomic.Int32
+}
+
+func (p *fakePlatform) setSessions(sessions ...videoplatform.Session) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.sessions = sessions
+}
+
+func (p *fakePlatform) serve(t *testing.T) *videoplatform.Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/sessions":
+			p.scans.Add(1)
+			p.mu.Lock()
+			data := p.sessions
+			p.mu.Unlock()
+			json.NewEncoder(w).Encode(videoplatform.PaginatedResponse[videoplatform.Session]{Data: data, Total: len(data)})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return videoplatform.New(server.URL)
+}
+
+func TestOpen_Disabled(t *testing.T) {
+	if ix := Open(videoplatform.New("http://localhost"), "", time.Minute); ix != nil {
+		t.Error("Open() with empty dir should return nil")
+	}
+}
+
+func TestIndex_LookupHits(t *testing.T) {
+	opponent := "Eagles"
+	p := &fakePlatform{}
+	p.setSessions(videoplatform.Session{ID: "s-1", Name: "Week 1", Opponent: &opponent, CreatedAt: "2026-09-05T19:00:00Z"})
+	c := p.serve(t)
+	dir := t.TempDir()
+
+	ix := Open(c, dir, time.Hour)
+	entry, ok, err := ix.Session(context.Background(), "s-1")
+	if err != nil || !ok {
+		t.Fatalf("Session() = %v, %v; want hit", ok, err)
+	}
+	if entry.Name != "Week 1" || entry.Opponent != "Eagles" {
+		t.Errorf("Session() = %+v", entry)
+	}
+
+	if got := p.scans.Load(); got != 1 {
+		t.Errorf("platform scanned %d times, want 1", got)
+	}
+
+	// A fresh index over the same directory is served from disk
+	ix2 := Open(c, dir, time.Hour)
+	if _, ok, _ := ix2.Session(context.Background(), "s-1"); !ok {
+		t.Error("Session() from disk should hit")
+	}
+	if got := p.scans.Load(); got != 1 {
+		t.Errorf("platform scanned %d times after reload, want 1", got)
+	}
+}
+
+func TestIndex_StaleRefresh(t *testing.T) {
+	p := &fakePlatform{}
+	p.setSessions(videoplatform.Session{ID: "s-1", Name: "Week 1"})
+	c := p.serve(t)
+
+	now := time.Now()
+	ix := Open(c, t.TempDir(), time.Minute)
+	ix.now = func() time.Time { return now }
+
+	if _, ok, _ := ix.Session(context.Background(), "s-1"); !ok {
+		t.Fatal("Session() initial lookup should hit")
+	}
+
+	p.setSessions(videoplatform.Session{ID: "s-1", Name: "Week 1"}, videoplatform.Session{ID: "s-2", Name: "Week 2"})
+	now = now.Add(2 * time.Minute)
+
+	// The stale lookup is served from cache while a refresh runs
+	if _, ok, _ := ix.Session(context.Background(), "s-2"); ok {
+		t.Error("Session() should serve stale data before the refresh lands")
+	}
+	ix.wg.Wait()
+
+	if _, ok, _ := ix.Session(context.Background(), "s-2"); !ok {
+		t.Error("Session() should see new session after refresh")
+	}
+	if got := p.scans.Load(); got != 2 {
+		t.Errorf("platform scanned %d times, want 2", got)
+	}
+}
+
+func TestIndex_UpsertRemove(t *testing.T) {
+	p := &fakePlatform{}
+	p.setSessions(videoplatform.Session{ID: "s-1", Name: "Week 1"})
+	c := p.serve(t)
+	dir := t.TempDir()
+	ctx := context.Background()
+
+	ix := Open(c, dir, time.Hour)
+	if _, ok, _ := ix.Session(ctx, "s-1"); !ok {
+		t.Fatal("Session() initial lookup should hit")
+	}
+
+	ix.Upsert(videoplatform.Session{ID: "s-2", Name: "Week 2"})
+	ix.Upsert(videoplatform.Session{ID: "s-1", Name: "Week 1 (home)"})
+	ix.Remove("s-2")
+	if entry, _, _ := ix.Session(ctx, "s-1"); entry.Name != "Week 1 (home)" {
+		t.Errorf("Session(s-1) = %+v, want the new name", entry)
+	}
+	if _, ok, _ := ix.Session(ctx, "s-2"); ok {
+		t.Error("Session(s-2) should miss after Remove")
+	}
+
+	// The edits are persisted without another scan
+	ix.Upsert(videoplatform.Session{ID: "s-3", Name: "Week 3"})
+	if _, ok, _ := Open(c, dir, time.Hour).Session(ctx, "s-3"); !ok {
+		t.Error("Session(s-3) from disk should hit")
+	}
+	if got := p.scans.Load(); got != 1 {
+		t.Errorf("platform scanned %d times, want 1", got)
+	}
+
+	// A nil index ignores edits
+	var disabled *Index
+	disabled.Upsert(videoplatform.Session{ID: "s-4"})
+	disabled.Remove("s-4")
+}
+
+func TestIndex_CorruptedFile(t *testing.T) {
+	p := &fakePlatform{}
+	p.setSessions(videoplatform.Session{ID: "s-1", Name: "Week 1"})
+	c := p.serve(t)
+	dir := t.TempDir()
+	path := filepath.Join(dir, fileName)
+
+	if err := os.WriteFile(path, []byte("{not json"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	ix := Open(c, dir, time.Hour)
+	if _, ok, err := ix.Session(context.Background(), "s-1"); err != nil || !ok {
+		t.Fatalf("Session() = %v, %v; want recovery from platform", ok, err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("index file not rewritten: %v", err)
+	}
+	var snap snapshot
+	if err := json.Unmarshal(data, &snap); err != nil {
+		t.Errorf("rewritten index is not valid JSON: %v", err)
+	}
+
+	// Deleting the file while running is harmless
+	os.Remove(path)
+	if _, ok, _ := ix.Session(context.Background(), "s-1"); !ok {
+		t.Error("Session() should still hit after the file is deleted")
+	}
+}
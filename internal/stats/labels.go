@@ -0,0 +1,104 @@
+package stats
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+)
+
+// LabelQuery is the partial tag labels are suggested for. Empty fields
+// match any tag.
+type LabelQuery struct {
+	PlayType  string   `json:"play_type,omitempty"`
+	Formation string   `json:"formation,omitempty"`
+	Labels    []string `json:"labels,omitempty"`
+}
+
+// LabelSuggestion is a label that co-occurs with the query. Frequency is
+// Count divided by the number of tags matching the query.
+type LabelSuggestion struct {
+	Label     string  `json:"label"`
+	Count     int     `json:"count"`
+	Frequency float64 `json:"frequency"`
+}
+
+// LabelSuggestions ranks labels by how often they appear on tags that match
+// a query
+type LabelSuggestions struct {
+	Query       LabelQuery        `json:"query"`
+	Corpus      int               `json:"corpus"`
+	Matched     int               `json:"matched"`
+	Suggestions []LabelSuggestion `json:"suggestions"`
+}
+
+// matches reports whether a tag has the query's play type, formation and
+// every one of its labels. Comparisons ignore case.
+func (q LabelQuery) matches(tag videoplatform.Tag) bool {
+	if q.PlayType != "" && (tag.PlayType == nil || !strings.EqualFold(*tag.PlayType, q.PlayType)) {
+		return false
+	}
+	if q.Formation != "" && (tag.Formation == nil || !strings.EqualFold(*tag.Formation, q.Formation)) {
+		return false
+	}
+	for _, want := range q.Labels {
+		found := false
+		for _, label := range tag.Labels {
+			found = found || strings.EqualFold(label, want)
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// SuggestLabels counts the labels on tags matching q, excluding the labels
+// q already has, and returns the top n by conditional frequency. Labels are
+// counted case-insensitively and reported in the spelling seen first. Ties
+// are broken alphabetically; n <= 0 returns every label.
+func SuggestLabels(tags []videoplatform.Tag, q LabelQuery, n int) LabelSuggestions {
+	have := make(map[string]bool, len(q.Labels))
+	for _, label := range q.Labels {
+		have[strings.ToLower(label)] = true
+	}
+
+	result := LabelSuggestions{Query: q, Corpus: len(tags), Suggestions: []LabelSuggestion{}}
+	counts := make(map[string]*LabelSuggestion)
+	for _, tag := range tags {
+		if !q.matches(tag) {
+			continue
+		}
+		result.Matched++
+
+		seen := make(map[string]bool, len(tag.Labels))
+		for _, label := range tag.Labels {
+			key := strings.ToLower(strings.TrimSpace(label))
+			if key == "" || have[key] || seen[key] {
+				continue
+			}
+			seen[key] = true
+			if s, ok := counts[key]; ok {
+				s.Count++
+			} else {
+				counts[key] = &LabelSuggestion{Label: strings.TrimSpace(label), Count: 1}
+			}
+		}
+	}
+
+	for _, s := range counts {
+		s.Frequency = float64(s.Count) / float64(result.Matched)
+		result.Suggestions = append(result.Suggestions, *s)
+	}
+	sort.Slice(result.Suggestions, func(i, j int) bool {
+		a, b := result.Suggestions[i], result.Suggestions[j]
+		if a.Count != b.Count {
+			return a.Count > b.Count
+		}
+		return strings.ToLower(a.Label) < strings.ToLower(b.Label)
+	})
+	if n > 0 && len(result.Suggestions) > n {
+		result.Suggestions = result.Suggestions[:n]
+	}
+	return result
+}
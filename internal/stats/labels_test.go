@@ -0,0 +1,92 @@
+package stats
+
+import (
+	"testing"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+)
+
+func strPtr(s string) *string { return &s }
+
+func labeled(playType, formation string, labels ...string) videoplatform.Tag {
+	tag := videoplatform.Tag{Labels: labels}
+	if playType != "" {
+		tag.PlayType = strPtr(playType)
+	}
+	if formation != "" {
+		tag.Formation = strPtr(formation)
+	}
+	return tag
+}
+
+// labelCorpus has "boot" on four pass plays, three of them play-action
+var labelCorpus = []videoplatform.Tag{
+	labeled("pass", "shotgun", "boot", "play-action", "rollout"),
+	labeled("pass", "i-form", "Boot", "Play-Action"),
+	labeled("pass", "i-form", "boot", "play-action", "red-zone"),
+	labeled("pass", "shotgun", "boot", "screen"),
+	labeled("pass", "shotgun", "screen", "rpo"),
+	labeled("run", "i-form", "power", "play-action"),
+	labeled("run", "pistol", "zone", "zone"),
+	labeled("", "", "boot"),
+}
+
+func TestSuggestLabels(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   LabelQuery
+		n       int
+		matched int
+		want    []LabelSuggestion
+	}{
+		{
+			name:    "conditional on existing label",
+			query:   LabelQuery{PlayType: "pass", Labels: []string{"boot"}},
+			n:       2,
+			matched: 4,
+			want: []LabelSuggestion{
+				{Label: "play-action", Count: 3, Frequency: 0.75},
+				{Label: "red-zone", Count: 1, Frequency: 0.25},
+			},
+		},
+		{
+			name:    "formation and case-insensitive play type",
+			query:   LabelQuery{PlayType: "PASS", Formation: "i-form"},
+			matched: 2,
+			want: []LabelSuggestion{
+				{Label: "Boot", Count: 2, Frequency: 1},
+				{Label: "Play-Action", Count: 2, Frequency: 1},
+				{Label: "red-zone", Count: 1, Frequency: 0.5},
+			},
+		},
+		{
+			name:    "duplicate labels on a tag count once",
+			query:   LabelQuery{Formation: "pistol"},
+			matched: 1,
+			want:    []LabelSuggestion{{Label: "zone", Count: 1, Frequency: 1}},
+		},
+		{
+			name:    "no matching tags",
+			query:   LabelQuery{PlayType: "punt"},
+			matched: 0,
+			want:    []LabelSuggestion{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SuggestLabels(labelCorpus, tt.query, tt.n)
+			if got.Corpus != len(labelCorpus) || got.Matched != tt.matched {
+				t.Errorf("Corpus, Matched = %d, %d; want %d, %d", got.Corpus, got.Matched, len(labelCorpus), tt.matched)
+			}
+			if len(got.Suggestions) != len(tt.want) {
+				t.Fatalf("Suggestions = %+v, want %+v", got.Suggestions, tt.want)
+			}
+			for i, want := range tt.want {
+				if got.Suggestions[i] != want {
+					t.Errorf("Suggestions[%d] = %+v, want %+v", i, got.Suggestions[i], want)
+				}
+			}
+		})
+	}
+}
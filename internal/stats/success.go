@@ -0,0 +1,129 @@
+// Package stats derives play-level metrics from tags so every analytical
+// tool judges plays the same way.
+package stats
+
+import (
+	"fmt"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+)
+
+// Thresholds are the fraction of the distance to go a play must gain to be
+// successful. LateDown applies to 3rd and 4th down, where anything short of
+// a conversion (1.0) usually fails.
+type Thresholds struct {
+	FirstDown  float64 `json:"first_down"`
+	SecondDown float64 `json:"second_down"`
+	LateDown   float64 `json:"late_down"`
+}
+
+// DefaultThresholds is the standard success rule: 40% of the distance on
+// 1st down, 60% on 2nd, and a conversion on 3rd and 4th
+var DefaultThresholds = Thresholds{FirstDown: 0.4, SecondDown: 0.6, LateDown: 1.0}
+
+// Validate reports thresholds that would make the rule meaningless
+func (t Thresholds) Validate() error {
+	for _, f := range []struct {
+		name  string
+		value float64
+	}{
+		{"first_down", t.FirstDown},
+		{"second_down", t.SecondDown},
+		{"late_down", t.LateDown},
+	} {
+		if f.value <= 0 {
+			return fmt.Errorf("success threshold %s must be greater than 0, got %v", f.name, f.value)
+		}
+	}
+	return nil
+}
+
+// forDown returns the threshold for a down, or false for an invalid down
+func (t Thresholds) forDown(down int) (float64, bool) {
+	switch down {
+	case 1:
+		return t.FirstDown, true
+	case 2:
+		return t.SecondDown, true
+	case 3, 4:
+		return t.LateDown, true
+	default:
+		return 0, false
+	}
+}
+
+// Explanation shows how the success rule was applied to a tag
+type Explanation struct {
+	TagID         string   `json:"tag_id"`
+	Down          *int     `json:"down,omitempty"`
+	Distance      *int     `json:"distance,omitempty"`
+	YardsGained   *int     `json:"yards_gained,omitempty"`
+	Threshold     *float64 `json:"threshold,omitempty"`
+	RequiredYards *float64 `json:"required_yards,omitempty"`
+	Success       *bool    `json:"success"`
+	Reason        string   `json:"reason"`
+}
+
+// Explain applies the rule to a tag and records each step. Tags without a
+// valid down, distance or gain have no success value.
+func (t Thresholds) Explain(tag videoplatform.Tag) Explanation {
+	e := Explanation{
+		TagID:       tag.ID,
+		Down:        tag.Down,
+		Distance:    tag.Distance,
+		YardsGained: tag.YardsGained,
+	}
+
+	if tag.Down == nil || tag.Distance == nil {
+		e.Reason = "tag has no down and distance"
+		return e
+	}
+	threshold, ok := t.forDown(*tag.Down)
+	if !ok {
+		e.Reason = fmt.Sprintf("down %d is not 1-4", *tag.Down)
+		return e
+	}
+	if *tag.Distance <= 0 {
+		e.Reason = fmt.Sprintf("distance %d is not positive", *tag.Distance)
+		return e
+	}
+	if tag.YardsGained == nil {
+		e.Reason = "tag has no yards gained"
+		return e
+	}
+
+	required := threshold * float64(*tag.Distance)
+	success := float64(*tag.YardsGained) >= required
+	e.Threshold = &threshold
+	e.RequiredYards = &required
+	e.Success = &success
+
+	verdict := "successful"
+	if !success {
+		verdict = "unsuccessful"
+	}
+	e.Reason = fmt.Sprintf("down %d needs %.0f%% of %d yards (%.1f); gained %d, %s",
+		*tag.Down, threshold*100, *tag.Distance, required, *tag.YardsGained, verdict)
+	return e
+}
+
+// Success reports whether a tag's play was successful, or nil when the tag
+// lacks the down, distance or gain to judge it
+func (t Thresholds) Success(tag videoplatform.Tag) *bool {
+	return t.Explain(tag).Success
+}
+
+// Play is a tag with its derived metrics
+type Play struct {
+	videoplatform.Tag
+	Success *bool `json:"success"`
+}
+
+// Annotate derives metrics for each tag
+func (t Thresholds) Annotate(tags []videoplatform.Tag) []Play {
+	plays := make([]Play, len(tags))
+	for i, tag := range tags {
+		plays[i] = Play{Tag: tag, Success: t.Success(tag)}
+	}
+	return plays
+}
@@ -0,0 +1,123 @@
+package stats
+
+import (
+	"testing"
+
+	"github.com/Prodro21/video-mcp/pkg/videoplatform"
+)
+
+func intPtr(i int) *int { return &i }
+
+func tag(down, distance, gained *int) videoplatform.Tag {
+	return videoplatform.Tag{ID: "tag-1", Down: down, Distance: distance, YardsGained: gained}
+}
+
+func TestSuccess_DefaultRule(t *testing.T) {
+	yes, no := true, false
+
+	tests := []struct {
+		name string
+		tag  videoplatform.Tag
+		want *bool
+	}{
+		// 1st down: 40% of distance
+		{"1st and 10, gain 4", tag(intPtr(1), intPtr(10), intPtr(4)), &yes},
+		{"1st and 10, gain 3", tag(intPtr(1), intPtr(10), intPtr(3)), &no},
+		{"1st and 5, gain 2", tag(intPtr(1), intPtr(5), intPtr(2)), &yes},
+		{"1st and 10, loss", tag(intPtr(1), intPtr(10), intPtr(-2)), &no},
+		// 2nd down: 60% of distance
+		{"2nd and 10, gain 6", tag(intPtr(2), intPtr(10), intPtr(6)), &yes},
+		{"2nd and 10, gain 5", tag(intPtr(2), intPtr(10), intPtr(5)), &no},
+		{"2nd and 3, gain 2", tag(intPtr(2), intPtr(3), intPtr(2)), &yes},
+		// 3rd and 4th down: conversion
+		{"3rd and 4, gain 4", tag(intPtr(3), intPtr(4), intPtr(4)), &yes},
+		{"3rd and 4, gain 3", tag(intPtr(3), intPtr(4), intPtr(3)), &no},
+		{"4th and 1, gain 1", tag(intPtr(4), intPtr(1), intPtr(1)), &yes},
+		{"4th and 1, gain 0", tag(intPtr(4), intPtr(1), intPtr(0)), &no},
+		{"3rd and 2, big gain", tag(intPtr(3), intPtr(2), intPtr(40)), &yes},
+		// Not enough information
+		{"no down", tag(nil, intPtr(10), intPtr(5)), nil},
+		{"no distance", tag(intPtr(1), nil, intPtr(5)), nil},
+		{"no gain", tag(intPtr(1), intPtr(10), nil), nil},
+		{"nothing", tag(nil, nil, nil), nil},
+		{"down 0", tag(intPtr(0), intPtr(10), intPtr(5)), nil},
+		{"down 5", tag(intPtr(5), intPtr(10), intPtr(5)), nil},
+		{"zero distance", tag(intPtr(1), intPtr(0), intPtr(5)), nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DefaultThresholds.Success(tt.tag)
+			switch {
+			case tt.want == nil && got != nil:
+				t.Errorf("Success() = %v, want nil", *got)
+			case tt.want != nil && got == nil:
+				t.Errorf("Success() = nil, want %v", *tt.want)
+			case tt.want != nil && *got != *tt.want:
+				t.Errorf("Success() = %v, want %v", *got, *tt.want)
+			}
+		})
+	}
+}
+
+func TestSuccess_CustomThresholds(t *testing.T) {
+	strict := Thresholds{FirstDown: 0.5, SecondDown: 0.7, LateDown: 1.0}
+
+	// 4 of 10 on 1st passes the default rule but not a 50% rule
+	play := tag(intPtr(1), intPtr(10), intPtr(4))
+	if got := DefaultThresholds.Success(play); got == nil || !*got {
+		t.Error("default rule should count 1st and 10, gain 4 as success")
+	}
+	if got := strict.Success(play); got == nil || *got {
+		t.Error("50% rule should not count 1st and 10, gain 4 as success")
+	}
+
+	// 6 of 10 on 2nd passes the default rule but not a 70% rule
+	play = tag(intPtr(2), intPtr(10), intPtr(6))
+	if got := strict.Success(play); got == nil || *got {
+		t.Error("70% rule should not count 2nd and 10, gain 6 as success")
+	}
+}
+
+func TestExplain(t *testing.T) {
+	e := DefaultThresholds.Explain(tag(intPtr(2), intPtr(10), intPtr(5)))
+	if e.Success == nil || *e.Success {
+		t.Fatalf("Explain() Success = %v, want false", e.Success)
+	}
+	if *e.Threshold != 0.6 || *e.RequiredYards != 6 {
+		t.Errorf("Explain() threshold = %v required = %v, want 0.6 and 6", *e.Threshold, *e.RequiredYards)
+	}
+	if e.Reason != "down 2 needs 60% of 10 yards (6.0); gained 5, unsuccessful" {
+		t.Errorf("Explain() Reason = %q", e.Reason)
+	}
+
+	e = DefaultThresholds.Explain(tag(nil, nil, intPtr(5)))
+	if e.Success != nil || e.Reason != "tag has no down and distance" {
+		t.Errorf("Explain() = %+v, want nil success with reason", e)
+	}
+}
+
+func TestAnnotate(t *testing.T) {
+	plays := DefaultThresholds.Annotate([]videoplatform.Tag{
+		tag(intPtr(1), intPtr(10), intPtr(5)),
+		tag(nil, nil, nil),
+	})
+	if len(plays) != 2 {
+		t.Fatalf("Annotate() returned %d plays, want 2", len(plays))
+	}
+	if plays[0].Success == nil || !*plays[0].Success {
+		t.Error("Annotate() first play should be successful")
+	}
+	if plays[1].Success != nil {
+		t.Error("Annotate() second play should have nil success")
+	}
+}
+
+func TestThresholds_Validate(t *testing.T) {
+	if err := DefaultThresholds.Validate(); err != nil {
+		t.Errorf("Validate() default thresholds: %v", err)
+	}
+	if err := (Thresholds{FirstDown: 0.4, SecondDown: 0, LateDown: 1}).Validate(); err == nil {
+		t.Error("Validate() should reject a zero threshold")
+	}
+}
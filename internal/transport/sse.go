@@ -0,0 +1,304 @@
+// Package transport serves the MCP server over HTTP with Server-Sent Events.
+//
+// Clients open a stream with GET /sse and receive an endpoint event naming
+// the URL to POST JSON-RPC messages to; responses are delivered on the
+// stream. Streams carry periodic keepalive comments so idle connections
+// through proxies and flaky networks stay open, and a stream whose ping
+// can't be written is treated as stale and dropped.
+//
+// Anyone who can reach the listener can call every tool, so it should stay
+// on a loopback address. Requests carrying an Origin header from a
+// non-local host are refused, which keeps a web page from reaching the
+// server through DNS rebinding.
+package transport
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// Stats describes the connections of an SSE server
+type Stats struct {
+	Clients     int           `json:"clients"`
+	Connections int           `json:"total_connections"`
+	Uptime      time.Duration `json:"-"`
+}
+
+// SSE serves an MCP server over HTTP with Server-Sent Events
+type SSE struct {
+	mcp       *server.MCPServer
+	keepalive time.Duration
+	logger    *log.Logger
+	started   time.Time
+
+	mu    sync.Mutex
+	conns map[string]*conn
+	total int
+	srv   *http.Server
+}
+
+// conn is one open event stream. Writes from the keepalive loop and from
+// message responses are serialized by mu.
+type conn struct {
+	mu        sync.Mutex
+	w         http.ResponseWriter
+	rc        *http.ResponseController
+	remote    string
+	connected time.Time
+	done      chan struct{}
+}
+
+// NewSSE creates an SSE transport for s. Streams are pinged every
+// keepalive; zero disables pings.
+func NewSSE(s *server.MCPServer, keepalive time.Duration, logger *log.Logger) *SSE {
+	return &SSE{
+		mcp:       s,
+		keepalive: keepalive,
+		logger:    logger,
+		started:   time.Now(),
+		conns:     make(map[string]*conn),
+	}
+}
+
+// Stats returns the current and total connection counts and the uptime
+func (t *SSE) Stats() Stats {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return Stats{Clients: len(t.conns), Connections: t.total, Uptime: time.Since(t.started)}
+}
+
+// ServeHTTP routes /sse and /message, refusing browser requests from
+// pages that aren't served by this machine
+func (t *SSE) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if origin := r.Header.Get("Origin"); origin != "" && !localOrigin(origin) {
+		t.logf("Refused request from origin %s (%s)", origin, r.RemoteAddr)
+		http.Error(w, "Forbidden origin", http.StatusForbidden)
+		return
+	}
+	switch r.URL.Path {
+	case "/sse":
+		t.handleStream(w, r)
+	case "/message":
+		t.handleMessage(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// localOrigin reports whether an Origin header names localhost or a
+// loopback address
+func localOrigin(origin string) bool {
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	host := u.Hostname()
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// ListenAndServe serves on addr until Shutdown is called
+func (t *SSE) ListenAndServe(addr string) error {
+	t.mu.Lock()
+	t.srv = &http.Server{Addr: addr, Handler: t}
+	srv := t.srv
+	t.mu.Unlock()
+
+	if err := srv.ListenAndServe(); err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
+
+// Shutdown closes every stream and stops the HTTP server
+func (t *SSE) Shutdown(ctx context.Context) error {
+	t.mu.Lock()
+	srv := t.srv
+	for _, c := range t.conns {
+		c.close()
+	}
+	t.mu.Unlock()
+
+	if srv == nil {
+		return nil
+	}
+	return srv.Shutdown(ctx)
+}
+
+func (t *SSE) logf(format string, args ...interface{}) {
+	if t.logger != nil {
+		t.logger.Printf(format, args...)
+	}
+}
+
+func (t *SSE) handleStream(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if _, ok := w.(http.Flusher); !ok {
+		http.Error(w, "Streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+
+	id := newSessionID()
+	c := &conn{w: w, rc: http.NewResponseController(w), remote: r.RemoteAddr, connected: time.Now(), done: make(chan struct{})}
+	t.register(id, c)
+	reason := "client closed the stream"
+	defer func() { t.unregister(id, c, reason) }()
+
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	if err := c.write(fmt.Sprintf("event: endpoint\ndata: %s://%s/message?sessionId=%s\n\n", scheme, r.Host, id), t.keepalive); err != nil {
+		reason = "endpoint could not be sent: " + err.Error()
+		return
+	}
+
+	var tick <-chan time.Time
+	if t.keepalive > 0 {
+		ticker := time.NewTicker(t.keepalive)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-c.done:
+			reason = "server shutting down"
+			return
+		case <-tick:
+			if err := c.write(": ping\n\n", t.keepalive); err != nil {
+				reason = "stale connection: " + err.Error()
+				return
+			}
+		}
+	}
+}
+
+func (t *SSE) handleMessage(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeJSONRPCError(w, mcp.INVALID_REQUEST, "Method not allowed")
+		return
+	}
+	id := r.URL.Query().Get("sessionId")
+	if id == "" {
+		writeJSONRPCError(w, mcp.INVALID_PARAMS, "Missing sessionId")
+		return
+	}
+	t.mu.Lock()
+	c, ok := t.conns[id]
+	t.mu.Unlock()
+	if !ok {
+		writeJSONRPCError(w, mcp.INVALID_PARAMS, "Invalid session ID")
+		return
+	}
+
+	var message json.RawMessage
+	if err := json.NewDecoder(r.Body).Decode(&message); err != nil {
+		writeJSONRPCError(w, mcp.PARSE_ERROR, "Parse error")
+		return
+	}
+
+	ctx := t.mcp.WithContext(r.Context(), server.NotificationContext{ClientID: id, SessionID: id})
+	response := t.mcp.HandleMessage(ctx, message)
+	if response == nil {
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
+
+	data, _ := json.Marshal(response)
+	if err := c.write(fmt.Sprintf("event: message\ndata: %s\n\n", data), t.keepalive); err != nil {
+		t.logf("Client %s: response not delivered on stream: %v", id, err)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	w.Write(data)
+}
+
+func (t *SSE) register(id string, c *conn) {
+	t.mu.Lock()
+	t.conns[id] = c
+	t.total++
+	clients := len(t.conns)
+	t.mu.Unlock()
+	t.logf("Client %s connected from %s (%d connected)", id, c.remote, clients)
+}
+
+func (t *SSE) unregister(id string, c *conn, reason string) {
+	t.mu.Lock()
+	delete(t.conns, id)
+	clients := len(t.conns)
+	t.mu.Unlock()
+	c.close()
+	t.logf("Client %s disconnected after %s: %s (%d connected)", id, time.Since(c.connected).Round(time.Second), reason, clients)
+}
+
+// write sends data on the stream. A write that doesn't complete within
+// timeout fails, so a peer that stopped reading is detected.
+func (c *conn) write(data string, timeout time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	select {
+	case <-c.done:
+		return fmt.Errorf("stream closed")
+	default:
+	}
+	if timeout > 0 {
+		// Not every ResponseWriter supports deadlines; the write still
+		// fails once the connection is gone
+		_ = c.rc.SetWriteDeadline(time.Now().Add(timeout))
+	}
+	if _, err := fmt.Fprint(c.w, data); err != nil {
+		return err
+	}
+	return c.rc.Flush()
+}
+
+// close ends the stream. Once closed, writes fail instead of touching a
+// ResponseWriter whose handler has returned.
+func (c *conn) close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	select {
+	case <-c.done:
+	default:
+		close(c.done)
+	}
+}
+
+func newSessionID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func writeJSONRPCError(w http.ResponseWriter, code int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	resp := mcp.JSONRPCError{JSONRPC: mcp.JSONRPC_VERSION}
+	resp.Error.Code = code
+	resp.Error.Message = message
+	json.NewEncoder(w).Encode(resp)
+}
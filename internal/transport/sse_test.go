@@ -0,0 +1,181 @@
+package transport
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// openStream connects to /sse and returns a reader over the event stream
+func openStream(t *testing.T, ctx context.Context, url string) *bufio.Reader {
+	t.Helper()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, url+"/sse", nil)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("GET /sse: %v", err)
+	}
+	t.Cleanup(func() { resp.Body.Close() })
+	if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Fatalf("Content-Type = %q", ct)
+	}
+	return bufio.NewReader(resp.Body)
+}
+
+// readEvent returns the next event or comment block from the stream
+func readEvent(t *testing.T, r *bufio.Reader) string {
+	t.Helper()
+	var lines []string
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			t.Fatalf("reading stream: %v", err)
+		}
+		line = strings.TrimRight(line, "\n")
+		if line == "" {
+			return strings.Join(lines, "\n")
+		}
+		lines = append(lines, line)
+	}
+}
+
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestSSE_ConnectionCount(t *testing.T) {
+	sse := NewSSE(server.NewMCPServer("test", "1.0.0"), 0, nil)
+	ts := httptest.NewServer(sse)
+	defer ts.Close()
+
+	ctx1, cancel1 := context.WithCancel(context.Background())
+	defer cancel1()
+	readEvent(t, openStream(t, ctx1, ts.URL))
+	ctx2, cancel2 := context.WithCancel(context.Background())
+	defer cancel2()
+	readEvent(t, openStream(t, ctx2, ts.URL))
+
+	if got := sse.Stats(); got.Clients != 2 || got.Connections != 2 {
+		t.Errorf("Stats() = %+v, want 2 clients and 2 connections", got)
+	}
+
+	cancel1()
+	waitFor(t, "first client to disconnect", func() bool { return sse.Stats().Clients == 1 })
+	cancel2()
+	waitFor(t, "second client to disconnect", func() bool { return sse.Stats().Clients == 0 })
+
+	if got := sse.Stats().Connections; got != 2 {
+		t.Errorf("Connections = %d after disconnects, want 2", got)
+	}
+}
+
+func TestSSE_Keepalive(t *testing.T) {
+	sse := NewSSE(server.NewMCPServer("test", "1.0.0"), 10*time.Millisecond, nil)
+	ts := httptest.NewServer(sse)
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := openStream(t, ctx, ts.URL)
+
+	endpoint := readEvent(t, stream)
+	if !strings.HasPrefix(endpoint, "event: endpoint\ndata: "+ts.URL+"/message?sessionId=") {
+		t.Fatalf("first event = %q, want endpoint", endpoint)
+	}
+	for i := 0; i < 2; i++ {
+		if got := readEvent(t, stream); got != ": ping" {
+			t.Errorf("event %d = %q, want keepalive ping", i, got)
+		}
+	}
+}
+
+func TestSSE_RefusesForeignOrigin(t *testing.T) {
+	sse := NewSSE(server.NewMCPServer("test", "1.0.0"), 0, nil)
+	ts := httptest.NewServer(sse)
+	defer ts.Close()
+
+	tests := []struct {
+		origin string
+		want   int
+	}{
+		{"http://evil.example", http.StatusForbidden},
+		{"http://127.0.0.1.evil.example:8090", http.StatusForbidden},
+		{"null", http.StatusForbidden},
+		{"http://localhost:3000", http.StatusBadRequest},
+		{"http://127.0.0.1:8090", http.StatusBadRequest},
+		{"http://[::1]:8090", http.StatusBadRequest},
+		{"", http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.origin, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodPost, ts.URL+"/message", strings.NewReader(`{}`))
+			if tt.origin != "" {
+				req.Header.Set("Origin", tt.origin)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("POST /message: %v", err)
+			}
+			resp.Body.Close()
+			// Allowed origins get through to the handler, which rejects the
+			// missing session
+			if resp.StatusCode != tt.want {
+				t.Errorf("status = %d, want %d", resp.StatusCode, tt.want)
+			}
+		})
+	}
+}
+
+func TestSSE_Message(t *testing.T) {
+	sse := NewSSE(server.NewMCPServer("test", "1.0.0"), 0, nil)
+	ts := httptest.NewServer(sse)
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := openStream(t, ctx, ts.URL)
+	endpoint := strings.TrimPrefix(readEvent(t, stream), "event: endpoint\ndata: ")
+
+	resp, err := http.Post(endpoint, "application/json", strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"ping"}`))
+	if err != nil {
+		t.Fatalf("POST /message: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusAccepted {
+		t.Errorf("POST /message status = %d, want 202", resp.StatusCode)
+	}
+
+	event := readEvent(t, stream)
+	data, ok := strings.CutPrefix(event, "event: message\ndata: ")
+	if !ok {
+		t.Fatalf("event = %q, want message", event)
+	}
+	var msg struct {
+		ID int `json:"id"`
+	}
+	if err := json.Unmarshal([]byte(data), &msg); err != nil || msg.ID != 1 {
+		t.Errorf("message = %s (%v), want response to id 1", data, err)
+	}
+
+	resp, err = http.Post(ts.URL+"/message?sessionId=unknown", "application/json", strings.NewReader(`{}`))
+	if err != nil {
+		t.Fatalf("POST /message: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("unknown session status = %d, want 400", resp.StatusCode)
+	}
+}
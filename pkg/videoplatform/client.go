@@ -0,0 +1,1248 @@
+package videoplatform
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// defaultTimeout bounds each request unless overridden with WithTimeout or
+// WithHTTPClient
+const defaultTimeout = 30 * time.Second
+
+// Client wraps the video-platform REST API. It is safe for concurrent use.
+type Client struct {
+	baseURL    string
+	token      string
+	httpClient *http.Client
+	transport  *loggingTransport
+}
+
+// New creates a new video platform client for the API at baseURL
+func New(baseURL string, opts ...Option) *Client {
+	o := options{timeout: defaultTimeout}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	// Copy the caller's http.Client so wrapping its transport doesn't
+	// change their client
+	httpClient := &http.Client{Timeout: o.timeout}
+	if o.httpClient != nil {
+		copied := *o.httpClient
+		httpClient = &copied
+	}
+	next := httpClient.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	transport := newLoggingTransport(next)
+	transport.logger = o.logger
+	httpClient.Transport = transport
+
+	return &Client{
+		baseURL:    baseURL,
+		token:      o.token,
+		httpClient: httpClient,
+		transport:  transport,
+	}
+}
+
+// RecentRequests returns summaries of the most recent platform requests,
+// oldest first
+func (c *Client) RecentRequests() []RequestSummary {
+	return c.transport.recent()
+}
+
+// Health checks that the platform API is reachable and healthy
+func (c *Client) Health(ctx context.Context) error {
+	return c.get(ctx, "/health", nil, nil)
+}
+
+// Session represents a recording session
+type Session struct {
+	ID                   string        `json:"id"`
+	Name                 string        `json:"name"`
+	SessionType          SessionType   `json:"session_type"`
+	Status               SessionStatus `json:"status"`
+	ScheduledStart       *string       `json:"scheduled_start,omitempty"`
+	ActualStart          *string       `json:"actual_start,omitempty"`
+	ActualEnd            *string       `json:"actual_end,omitempty"`
+	Opponent             *string       `json:"opponent,omitempty"`
+	Opponents            []string      `json:"opponents,omitempty"`
+	Location             *string       `json:"location,omitempty"`
+	Notes                *string       `json:"notes,omitempty"`
+	ClipCount            int           `json:"clip_count"`
+	TagCount             int           `json:"tag_count"`
+	TotalDurationSeconds int           `json:"total_duration_seconds"`
+	RetentionDays        *int          `json:"retention_days,omitempty"`
+	CreatedAt            string        `json:"created_at"`
+	UpdatedAt            string        `json:"updated_at"`
+}
+
+// Clip represents a video clip
+type Clip struct {
+	ID              string     `json:"id"`
+	SessionID       string     `json:"session_id"`
+	ChannelID       string     `json:"channel_id"`
+	Title           *string    `json:"title,omitempty"`
+	Description     *string    `json:"description,omitempty"`
+	StartTime       string     `json:"start_time"`
+	EndTime         string     `json:"end_time"`
+	DurationSeconds float64    `json:"duration_seconds"`
+	Status          ClipStatus `json:"status"`
+	IsFavorite      bool       `json:"is_favorite"`
+	FavoriteNote    *string    `json:"favorite_note,omitempty"`
+	Rating          *int       `json:"rating,omitempty"`
+	ViewCount       int        `json:"view_count"`
+	TagCount        *int       `json:"tag_count,omitempty"`
+	CreatedAt       string     `json:"created_at"`
+}
+
+// Channel represents a video input channel
+type Channel struct {
+	ID           string            `json:"id"`
+	Name         string            `json:"name"`
+	Description  *string           `json:"description,omitempty"`
+	Group        *string           `json:"group,omitempty"`
+	InputType    *ChannelInputType `json:"input_type,omitempty"`
+	InputURL     *string           `json:"input_url,omitempty"`
+	Resolution   *string           `json:"resolution,omitempty"`
+	Framerate    *int              `json:"framerate,omitempty"`
+	Status       ChannelStatus     `json:"status"`
+	LastSeenAt   *string           `json:"last_seen_at,omitempty"`
+	ErrorMessage *string           `json:"error_message,omitempty"`
+	CreatedAt    string            `json:"created_at"`
+}
+
+// Tag represents a clip annotation
+type Tag struct {
+	ID            string   `json:"id"`
+	ClipID        string   `json:"clip_id"`
+	SessionID     string   `json:"session_id"`
+	Quarter       *int     `json:"quarter,omitempty"`
+	Down          *int     `json:"down,omitempty"`
+	Distance      *int     `json:"distance,omitempty"`
+	PlayType      *string  `json:"play_type,omitempty"`
+	Formation     *string  `json:"formation,omitempty"`
+	Result        *string  `json:"result,omitempty"`
+	YardsGained   *int     `json:"yards_gained,omitempty"`
+	Labels        []string `json:"labels,omitempty"`
+	Notes         *string  `json:"notes,omitempty"`
+	OffsetSeconds *float64 `json:"offset_seconds,omitempty"`
+	IsImportant   bool     `json:"is_important"`
+	IsReviewed    bool     `json:"is_reviewed"`
+	CreatedAt     string   `json:"created_at"`
+}
+
+// PaginatedResponse wraps paginated API responses
+type PaginatedResponse[T any] struct {
+	Data   []T `json:"data"`
+	Total  int `json:"total"`
+	Limit  int `json:"limit"`
+	Offset int `json:"offset"`
+}
+
+// ListSessionsParams for filtering sessions
+type ListSessionsParams struct {
+	Status       SessionStatus
+	SessionType  SessionType
+	Search       string // matches session name and opponent
+	Opponent     string // sessions against this opponent
+	DateFrom     string // RFC 3339; sessions starting at or after it
+	DateTo       string // RFC 3339; sessions starting before it
+	Sort         string // field to order by, e.g. "created_at"; prefix "-" for descending
+	UpdatedSince string // RFC 3339; only sessions created or updated after it
+	Limit        int
+	Offset       int
+}
+
+// ListSessions returns all sessions
+func (c *Client) ListSessions(ctx context.Context, params ListSessionsParams) (*PaginatedResponse[Session], error) {
+	query := url.Values{}
+	if params.Status != "" {
+		query.Set("status", string(params.Status))
+	}
+	if params.SessionType != "" {
+		query.Set("session_type", string(params.SessionType))
+	}
+	if params.Search != "" {
+		query.Set("search", params.Search)
+	}
+	if params.Opponent != "" {
+		query.Set("opponent", params.Opponent)
+	}
+	if params.DateFrom != "" {
+		query.Set("date_from", params.DateFrom)
+	}
+	if params.DateTo != "" {
+		query.Set("date_to", params.DateTo)
+	}
+	if params.Sort != "" {
+		query.Set("sort", params.Sort)
+	}
+	if params.UpdatedSince != "" {
+		query.Set("updated_since", params.UpdatedSince)
+	}
+	if params.Limit > 0 {
+		query.Set("limit", fmt.Sprintf("%d", params.Limit))
+	}
+	if params.Offset > 0 {
+		query.Set("offset", fmt.Sprintf("%d", params.Offset))
+	}
+
+	var resp PaginatedResponse[Session]
+	if err := c.get(ctx, "/api/v1/sessions", query, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetSession returns a single session
+func (c *Client) GetSession(ctx context.Context, id string) (*Session, error) {
+	var session Session
+	if err := c.get(ctx, "/api/v1/sessions/"+id, nil, &session); err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// SessionLock describes an edit lock held on a session
+type SessionLock struct {
+	SessionID string `json:"session_id"`
+	Locked    bool   `json:"locked"`
+	LockedBy  string `json:"locked_by,omitempty"`
+	Note      string `json:"note,omitempty"`
+	LockedAt  string `json:"locked_at,omitempty"`
+}
+
+// LockSessionRequest for locking a session
+type LockSessionRequest struct {
+	LockedBy string `json:"locked_by,omitempty"`
+	Note     string `json:"note,omitempty"`
+}
+
+// GetSessionLock returns the lock state of a session
+func (c *Client) GetSessionLock(ctx context.Context, id string) (*SessionLock, error) {
+	var lock SessionLock
+	if err := c.get(ctx, "/api/v1/sessions/"+id+"/lock", nil, &lock); err != nil {
+		return nil, err
+	}
+	return &lock, nil
+}
+
+// LockSession locks a session against mutating changes
+func (c *Client) LockSession(ctx context.Context, id string, req LockSessionRequest) (*SessionLock, error) {
+	var lock SessionLock
+	if err := c.post(ctx, "/api/v1/sessions/"+id+"/lock", req, &lock); err != nil {
+		return nil, err
+	}
+	return &lock, nil
+}
+
+// UnlockSession releases a session lock
+func (c *Client) UnlockSession(ctx context.Context, id string) error {
+	return c.delete(ctx, "/api/v1/sessions/"+id+"/lock")
+}
+
+// CreateSessionRequest for creating a session
+type CreateSessionRequest struct {
+	Name           string      `json:"name"`
+	SessionType    SessionType `json:"session_type"`
+	ScheduledStart *string     `json:"scheduled_start,omitempty"`
+	Opponent       *string     `json:"opponent,omitempty"`
+	Opponents      []string    `json:"opponents,omitempty"`
+	Location       *string     `json:"location,omitempty"`
+	Notes          *string     `json:"notes,omitempty"`
+}
+
+// CreateSession creates a new session
+func (c *Client) CreateSession(ctx context.Context, req CreateSessionRequest) (*Session, error) {
+	var session Session
+	if err := c.post(ctx, "/api/v1/sessions", req, &session); err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// UpdateSessionRequest edits session metadata. Nil fields are left
+// unchanged; an empty Opponents list, or an empty Opponent, clears the
+// session's opponents.
+type UpdateSessionRequest struct {
+	Name           *string      `json:"name,omitempty"`
+	SessionType    *SessionType `json:"session_type,omitempty"`
+	ScheduledStart *string      `json:"scheduled_start,omitempty"`
+	Opponent       *string      `json:"opponent,omitempty"`
+	Opponents      *[]string    `json:"opponents,omitempty"`
+	Location       *string      `json:"location,omitempty"`
+	Notes          *string      `json:"notes,omitempty"`
+}
+
+// IsEmpty reports whether the request would change nothing
+func (r UpdateSessionRequest) IsEmpty() bool {
+	return r.Name == nil && r.SessionType == nil && r.ScheduledStart == nil &&
+		r.Opponent == nil && r.Opponents == nil && r.Location == nil && r.Notes == nil
+}
+
+// UpdateSession applies a partial update to a session
+func (c *Client) UpdateSession(ctx context.Context, id string, req UpdateSessionRequest) (*Session, error) {
+	var session Session
+	if err := c.patch(ctx, "/api/v1/sessions/"+id, req, &session); err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// StartSession starts a session
+func (c *Client) StartSession(ctx context.Context, id string) (*Session, error) {
+	var session Session
+	if err := c.post(ctx, "/api/v1/sessions/"+id+"/start", nil, &session); err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// PauseSession pauses a session
+func (c *Client) PauseSession(ctx context.Context, id string) (*Session, error) {
+	var session Session
+	if err := c.post(ctx, "/api/v1/sessions/"+id+"/pause", nil, &session); err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// ResumeSession resumes recording on a paused session
+func (c *Client) ResumeSession(ctx context.Context, id string) (*Session, error) {
+	var session Session
+	if err := c.post(ctx, "/api/v1/sessions/"+id+"/resume", nil, &session); err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// CompleteSessionRequest optionally records the final score of a game
+type CompleteSessionRequest struct {
+	OurScore   *int    `json:"our_score,omitempty"`
+	TheirScore *int    `json:"their_score,omitempty"`
+	Result     *string `json:"result,omitempty"`
+	Notes      *string `json:"notes,omitempty"`
+}
+
+// CompleteSession completes a session
+func (c *Client) CompleteSession(ctx context.Context, id string) (*Session, error) {
+	var session Session
+	if err := c.post(ctx, "/api/v1/sessions/"+id+"/complete", nil, &session); err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// CompleteSessionWithResult completes a session, recording its final score
+func (c *Client) CompleteSessionWithResult(ctx context.Context, id string, req CompleteSessionRequest) (*Session, error) {
+	var session Session
+	if err := c.post(ctx, "/api/v1/sessions/"+id+"/complete", req, &session); err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// ArchiveSession moves a finished session out of the main session list
+func (c *Client) ArchiveSession(ctx context.Context, id string) (*Session, error) {
+	var session Session
+	if err := c.post(ctx, "/api/v1/sessions/"+id+"/archive", nil, &session); err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// CancelSessionRequest optionally records why a session was cancelled
+type CancelSessionRequest struct {
+	Reason *string `json:"reason,omitempty"`
+}
+
+// CancelSession cancels a session that has not started
+func (c *Client) CancelSession(ctx context.Context, id string, req CancelSessionRequest) (*Session, error) {
+	var session Session
+	if err := c.post(ctx, "/api/v1/sessions/"+id+"/cancel", req, &session); err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// DeleteSession moves a session to the trash
+func (c *Client) DeleteSession(ctx context.Context, id string) error {
+	return c.delete(ctx, "/api/v1/sessions/"+id)
+}
+
+// ListClipsParams for filtering clips
+type ListClipsParams struct {
+	SessionID    string
+	ChannelID    string
+	Status       ClipStatus
+	Favorite     *bool
+	Search       string
+	Sort         string  // field to order by, e.g. "duration"; prefix "-" for descending
+	UpdatedSince string  // RFC 3339; only clips created or updated after it
+	MinDuration  float64 // seconds; 0 for no lower bound
+	MaxDuration  float64 // seconds; 0 for no upper bound
+	MinRating    int     // only clips rated at least this; 0 for no filter
+	Limit        int
+	Offset       int
+}
+
+// ListClips returns clips with filters
+func (c *Client) ListClips(ctx context.Context, params ListClipsParams) (*PaginatedResponse[Clip], error) {
+	query := url.Values{}
+	if params.SessionID != "" {
+		query.Set("session_id", params.SessionID)
+	}
+	if params.ChannelID != "" {
+		query.Set("channel_id", params.ChannelID)
+	}
+	if params.Status != "" {
+		query.Set("status", string(params.Status))
+	}
+	if params.Favorite != nil {
+		query.Set("favorite", fmt.Sprintf("%v", *params.Favorite))
+	}
+	if params.Search != "" {
+		query.Set("search", params.Search)
+	}
+	if params.Sort != "" {
+		query.Set("sort", params.Sort)
+	}
+	if params.UpdatedSince != "" {
+		query.Set("updated_since", params.UpdatedSince)
+	}
+	if params.MinDuration > 0 {
+		query.Set("min_duration", fmt.Sprintf("%g", params.MinDuration))
+	}
+	if params.MaxDuration > 0 {
+		query.Set("max_duration", fmt.Sprintf("%g", params.MaxDuration))
+	}
+	if params.MinRating > 0 {
+		query.Set("min_rating", fmt.Sprintf("%d", params.MinRating))
+	}
+	if params.Limit > 0 {
+		query.Set("limit", fmt.Sprintf("%d", params.Limit))
+	}
+	if params.Offset > 0 {
+		query.Set("offset", fmt.Sprintf("%d", params.Offset))
+	}
+
+	var resp PaginatedResponse[Clip]
+	if err := c.get(ctx, "/api/v1/clips", query, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetClip returns a single clip
+func (c *Client) GetClip(ctx context.Context, id string) (*Clip, error) {
+	var clip Clip
+	if err := c.get(ctx, "/api/v1/clips/"+id, nil, &clip); err != nil {
+		return nil, err
+	}
+	return &clip, nil
+}
+
+// DownloadClip streams a clip's media file to w without holding it in
+// memory and returns the number of bytes written. A media file can take far
+// longer to transfer than an API call, so the download is bounded by ctx
+// rather than the client's request timeout.
+func (c *Client) DownloadClip(ctx context.Context, id string, w io.Writer) (int64, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", c.baseURL+"/api/v1/clips/"+id+"/download", nil)
+	if err != nil {
+		return 0, err
+	}
+
+	hc := *c.httpClient
+	hc.Timeout = 0
+	resp, err := c.sendVia(&hc, req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		return 0, &APIError{StatusCode: resp.StatusCode, Body: string(body)}
+	}
+
+	n, err := io.Copy(w, resp.Body)
+	if err != nil {
+		return n, fmt.Errorf("download failed after %d bytes: %w", n, err)
+	}
+	return n, nil
+}
+
+// maxErrorBodySize bounds how much of a failed download's body is kept in
+// the error
+const maxErrorBodySize = 4 << 10
+
+// ClipURL is a playback or download link to a clip's media. ExpiresAt is
+// set when the link is signed and stops working at that time.
+type ClipURL struct {
+	URL       string  `json:"url"`
+	ExpiresAt *string `json:"expires_at,omitempty"`
+}
+
+// GetClipURL returns a stream or download link for a clip
+func (c *Client) GetClipURL(ctx context.Context, id string, urlType ClipURLType) (*ClipURL, error) {
+	query := url.Values{}
+	query.Set("type", string(urlType))
+	var clipURL ClipURL
+	if err := c.get(ctx, "/api/v1/clips/"+id+"/url", query, &clipURL); err != nil {
+		return nil, err
+	}
+	return &clipURL, nil
+}
+
+// CreateClipRequest for cutting a clip out of a session's recording.
+// StartTime and EndTime are RFC 3339 timestamps.
+type CreateClipRequest struct {
+	SessionID string  `json:"session_id"`
+	ChannelID string  `json:"channel_id"`
+	StartTime string  `json:"start_time"`
+	EndTime   string  `json:"end_time"`
+	Title     *string `json:"title,omitempty"`
+}
+
+// CreateClip creates a clip from a time range of a session's recording
+func (c *Client) CreateClip(ctx context.Context, req CreateClipRequest) (*Clip, error) {
+	var clip Clip
+	if err := c.post(ctx, "/api/v1/clips", req, &clip); err != nil {
+		return nil, err
+	}
+	return &clip, nil
+}
+
+// TrimClipRequest moves a clip's boundaries, either to absolute RFC 3339
+// timestamps or by trimming seconds off either end. Nil fields are left
+// unchanged.
+type TrimClipRequest struct {
+	StartTime        *string  `json:"start_time,omitempty"`
+	EndTime          *string  `json:"end_time,omitempty"`
+	TrimStartSeconds *float64 `json:"trim_start_seconds,omitempty"`
+	TrimEndSeconds   *float64 `json:"trim_end_seconds,omitempty"`
+}
+
+// TrimClip adjusts a clip's start and end
+func (c *Client) TrimClip(ctx context.Context, id string, req TrimClipRequest) (*Clip, error) {
+	var clip Clip
+	if err := c.post(ctx, "/api/v1/clips/"+id+"/trim", req, &clip); err != nil {
+		return nil, err
+	}
+	return &clip, nil
+}
+
+// MergeClipsRequest joins clips, in the order given, into one new clip
+type MergeClipsRequest struct {
+	ClipIDs []string `json:"clip_ids"`
+	Title   *string  `json:"title,omitempty"`
+}
+
+// MergeClips creates a clip spanning the given clips
+func (c *Client) MergeClips(ctx context.Context, req MergeClipsRequest) (*Clip, error) {
+	var clip Clip
+	if err := c.post(ctx, "/api/v1/clips/merge", req, &clip); err != nil {
+		return nil, err
+	}
+	return &clip, nil
+}
+
+// FavoriteClip toggles favorite status
+func (c *Client) FavoriteClip(ctx context.Context, id string) (*Clip, error) {
+	var clip Clip
+	if err := c.post(ctx, "/api/v1/clips/"+id+"/favorite", nil, &clip); err != nil {
+		return nil, err
+	}
+	return &clip, nil
+}
+
+// RecordView counts a view of a clip towards its view_count
+func (c *Client) RecordView(ctx context.Context, id string) error {
+	return c.post(ctx, "/api/v1/clips/"+id+"/view", nil, nil)
+}
+
+// RateClip grades a clip from 1 to 5
+func (c *Client) RateClip(ctx context.Context, id string, rating int) (*Clip, error) {
+	body := struct {
+		Rating int `json:"rating"`
+	}{rating}
+	var clip Clip
+	if err := c.post(ctx, "/api/v1/clips/"+id+"/rating", body, &clip); err != nil {
+		return nil, err
+	}
+	return &clip, nil
+}
+
+// FavoriteNoteRequest sets why a clip is a favorite. A nil Note clears it.
+type FavoriteNoteRequest struct {
+	Note *string `json:"favorite_note"`
+}
+
+// SetFavoriteNote sets or clears a clip's favorite note
+func (c *Client) SetFavoriteNote(ctx context.Context, id string, req FavoriteNoteRequest) (*Clip, error) {
+	var clip Clip
+	if err := c.patch(ctx, "/api/v1/clips/"+id, req, &clip); err != nil {
+		return nil, err
+	}
+	return &clip, nil
+}
+
+// UpdateClipRequest for updating a clip; nil fields are left unchanged
+type UpdateClipRequest struct {
+	Title       *string `json:"title,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// IsEmpty reports whether the request would change nothing
+func (r UpdateClipRequest) IsEmpty() bool {
+	return r.Title == nil && r.Description == nil
+}
+
+// UpdateClip applies a partial update to a clip
+func (c *Client) UpdateClip(ctx context.Context, id string, req UpdateClipRequest) (*Clip, error) {
+	var clip Clip
+	if err := c.patch(ctx, "/api/v1/clips/"+id, req, &clip); err != nil {
+		return nil, err
+	}
+	return &clip, nil
+}
+
+// ListChannelsParams filters ListChannelsFiltered; the zero value lists
+// every channel
+type ListChannelsParams struct {
+	Status    ChannelStatus
+	InputType ChannelInputType
+	Search    string // matches channel name and description
+	Group     string // channels with this group label, e.g. a field
+	Limit     int
+	Offset    int
+}
+
+// ListChannels returns all channels
+func (c *Client) ListChannels(ctx context.Context) (*PaginatedResponse[Channel], error) {
+	return c.ListChannelsFiltered(ctx, ListChannelsParams{})
+}
+
+// ListChannelsFiltered returns channels matching params
+func (c *Client) ListChannelsFiltered(ctx context.Context, params ListChannelsParams) (*PaginatedResponse[Channel], error) {
+	query := url.Values{}
+	if params.Status != "" {
+		query.Set("status", string(params.Status))
+	}
+	if params.InputType != "" {
+		query.Set("input_type", string(params.InputType))
+	}
+	if params.Search != "" {
+		query.Set("search", params.Search)
+	}
+	if params.Group != "" {
+		query.Set("group", params.Group)
+	}
+	if params.Limit > 0 {
+		query.Set("limit", fmt.Sprintf("%d", params.Limit))
+	}
+	if params.Offset > 0 {
+		query.Set("offset", fmt.Sprintf("%d", params.Offset))
+	}
+
+	var resp PaginatedResponse[Channel]
+	if err := c.get(ctx, "/api/v1/channels", query, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// CreateChannelRequest registers a camera input. InputURL is the stream
+// address for network inputs or the device or file path otherwise.
+type CreateChannelRequest struct {
+	Name        string           `json:"name"`
+	Description *string          `json:"description,omitempty"`
+	Group       *string          `json:"group,omitempty"`
+	InputType   ChannelInputType `json:"input_type"`
+	InputURL    *string          `json:"input_url,omitempty"`
+	Resolution  *string          `json:"resolution,omitempty"`
+	Framerate   *int             `json:"framerate,omitempty"`
+}
+
+// CreateChannel registers a new channel
+func (c *Client) CreateChannel(ctx context.Context, req CreateChannelRequest) (*Channel, error) {
+	var channel Channel
+	if err := c.post(ctx, "/api/v1/channels", req, &channel); err != nil {
+		return nil, err
+	}
+	return &channel, nil
+}
+
+// UpdateChannelRequest edits a channel. Nil fields are left unchanged.
+type UpdateChannelRequest struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Group       *string `json:"group,omitempty"`
+	InputURL    *string `json:"input_url,omitempty"`
+	Resolution  *string `json:"resolution,omitempty"`
+	Framerate   *int    `json:"framerate,omitempty"`
+}
+
+// IsEmpty reports whether the request would change nothing
+func (r UpdateChannelRequest) IsEmpty() bool {
+	return r.Name == nil && r.Description == nil && r.Group == nil && r.InputURL == nil && r.Resolution == nil && r.Framerate == nil
+}
+
+// UpdateChannel applies a partial update to a channel
+func (c *Client) UpdateChannel(ctx context.Context, id string, req UpdateChannelRequest) (*Channel, error) {
+	var channel Channel
+	if err := c.patch(ctx, "/api/v1/channels/"+id, req, &channel); err != nil {
+		return nil, err
+	}
+	return &channel, nil
+}
+
+// GetChannel returns a single channel
+func (c *Client) GetChannel(ctx context.Context, id string) (*Channel, error) {
+	var channel Channel
+	if err := c.get(ctx, "/api/v1/channels/"+id, nil, &channel); err != nil {
+		return nil, err
+	}
+	return &channel, nil
+}
+
+// RestartChannel restarts a channel's input, e.g. to recover from an error
+func (c *Client) RestartChannel(ctx context.Context, id string) (*Channel, error) {
+	var channel Channel
+	if err := c.post(ctx, "/api/v1/channels/"+id+"/restart", nil, &channel); err != nil {
+		return nil, err
+	}
+	return &channel, nil
+}
+
+// ClearChannelError acknowledges a channel's error, resetting its error
+// state and error_message
+func (c *Client) ClearChannelError(ctx context.Context, id string) error {
+	return c.post(ctx, "/api/v1/channels/"+id+"/clear-error", nil, nil)
+}
+
+// ChannelStats is a channel's recent throughput. A field is nil when the
+// platform has no reading for it.
+type ChannelStats struct {
+	BitrateKbps   *float64 `json:"bitrate_kbps,omitempty"`
+	FPSActual     *float64 `json:"fps_actual,omitempty"`
+	DroppedFrames *int     `json:"dropped_frames,omitempty"`
+	UptimeSeconds *float64 `json:"uptime_seconds,omitempty"`
+	LastError     *string  `json:"last_error,omitempty"`
+}
+
+// GetChannelStats returns a channel's throughput and dropped-frame counts
+func (c *Client) GetChannelStats(ctx context.Context, id string) (*ChannelStats, error) {
+	var stats ChannelStats
+	if err := c.get(ctx, "/api/v1/channels/"+id+"/stats", nil, &stats); err != nil {
+		return nil, err
+	}
+	return &stats, nil
+}
+
+// maxSnapshotSize bounds how much of a snapshot is read
+const maxSnapshotSize = 32 << 20
+
+// ChannelSnapshot is a still frame of a channel's input as the platform
+// sent it
+type ChannelSnapshot struct {
+	ContentType string
+	Data        []byte
+}
+
+// GetChannelSnapshot fetches the current frame of a channel. The content
+// type is returned as sent; the caller checks it is an image.
+func (c *Client) GetChannelSnapshot(ctx context.Context, id string) (*ChannelSnapshot, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", c.baseURL+"/api/v1/channels/"+id+"/snapshot", nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.send(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		return nil, &APIError{StatusCode: resp.StatusCode, Body: string(body)}
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxSnapshotSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot: %w", err)
+	}
+	if len(data) > maxSnapshotSize {
+		return nil, fmt.Errorf("snapshot is larger than %d MiB", maxSnapshotSize>>20)
+	}
+	return &ChannelSnapshot{ContentType: resp.Header.Get("Content-Type"), Data: data}, nil
+}
+
+// DeleteChannel deletes a channel
+func (c *Client) DeleteChannel(ctx context.Context, id string) error {
+	return c.delete(ctx, "/api/v1/channels/"+id)
+}
+
+// ChannelTestResult is what a signal probe of a channel found. The
+// detected fields are set when video arrived; Error says why it didn't.
+type ChannelTestResult struct {
+	Reachable          bool     `json:"reachable"`
+	LatencyMS          *int     `json:"latency_ms,omitempty"`
+	DetectedResolution *string  `json:"detected_resolution,omitempty"`
+	DetectedFramerate  *float64 `json:"detected_framerate,omitempty"`
+	Error              *string  `json:"error,omitempty"`
+}
+
+// TestChannel probes a channel's input for a video signal
+func (c *Client) TestChannel(ctx context.Context, id string) (*ChannelTestResult, error) {
+	var result ChannelTestResult
+	if err := c.post(ctx, "/api/v1/channels/"+id+"/test", nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ActivateChannel activates a channel
+func (c *Client) ActivateChannel(ctx context.Context, id string) (*Channel, error) {
+	var channel Channel
+	if err := c.post(ctx, "/api/v1/channels/"+id+"/activate", nil, &channel); err != nil {
+		return nil, err
+	}
+	return &channel, nil
+}
+
+// DeactivateChannel deactivates a channel
+func (c *Client) DeactivateChannel(ctx context.Context, id string) (*Channel, error) {
+	var channel Channel
+	if err := c.post(ctx, "/api/v1/channels/"+id+"/deactivate", nil, &channel); err != nil {
+		return nil, err
+	}
+	return &channel, nil
+}
+
+// ListTagsParams for filtering tags
+type ListTagsParams struct {
+	SessionID    string
+	ClipID       string
+	PlayType     string
+	Formation    string
+	Result       string
+	Down         int    // 1-4; 0 means any
+	Quarter      int    // 1-4, then 5 for OT, 6 for OT2 and so on; 0 means any
+	Label        string // tags carrying this label
+	Search       string // matches tag notes and labels
+	IsImportant  *bool
+	IsReviewed   *bool
+	UpdatedSince string // RFC 3339; only tags created or updated after it
+	Limit        int
+	Offset       int
+}
+
+// ListTags returns tags with filters
+func (c *Client) ListTags(ctx context.Context, params ListTagsParams) (*PaginatedResponse[Tag], error) {
+	query := url.Values{}
+	if params.SessionID != "" {
+		query.Set("session_id", params.SessionID)
+	}
+	if params.ClipID != "" {
+		query.Set("clip_id", params.ClipID)
+	}
+	if params.PlayType != "" {
+		query.Set("play_type", params.PlayType)
+	}
+	if params.Formation != "" {
+		query.Set("formation", params.Formation)
+	}
+	if params.Result != "" {
+		query.Set("result", params.Result)
+	}
+	if params.Down > 0 {
+		query.Set("down", fmt.Sprintf("%d", params.Down))
+	}
+	if params.Quarter > 0 {
+		query.Set("quarter", fmt.Sprintf("%d", params.Quarter))
+	}
+	if params.Label != "" {
+		query.Set("label", params.Label)
+	}
+	if params.Search != "" {
+		query.Set("search", params.Search)
+	}
+	if params.IsImportant != nil {
+		query.Set("is_important", fmt.Sprintf("%v", *params.IsImportant))
+	}
+	if params.IsReviewed != nil {
+		query.Set("is_reviewed", fmt.Sprintf("%v", *params.IsReviewed))
+	}
+	if params.UpdatedSince != "" {
+		query.Set("updated_since", params.UpdatedSince)
+	}
+	if params.Limit > 0 {
+		query.Set("limit", fmt.Sprintf("%d", params.Limit))
+	}
+	if params.Offset > 0 {
+		query.Set("offset", fmt.Sprintf("%d", params.Offset))
+	}
+
+	var resp PaginatedResponse[Tag]
+	if err := c.get(ctx, "/api/v1/tags", query, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetTag returns a single tag
+func (c *Client) GetTag(ctx context.Context, id string) (*Tag, error) {
+	var tag Tag
+	if err := c.get(ctx, "/api/v1/tags/"+id, nil, &tag); err != nil {
+		return nil, err
+	}
+	return &tag, nil
+}
+
+// CreateTagRequest for creating a tag
+type CreateTagRequest struct {
+	ClipID      string   `json:"clip_id"`
+	SessionID   string   `json:"session_id"`
+	Quarter     *int     `json:"quarter,omitempty"`
+	Down        *int     `json:"down,omitempty"`
+	Distance    *int     `json:"distance,omitempty"`
+	PlayType    *string  `json:"play_type,omitempty"`
+	Formation   *string  `json:"formation,omitempty"`
+	Result      *string  `json:"result,omitempty"`
+	YardsGained *int     `json:"yards_gained,omitempty"`
+	Labels      []string `json:"labels,omitempty"`
+	Notes       *string  `json:"notes,omitempty"`
+}
+
+// CreateTag creates a new tag
+func (c *Client) CreateTag(ctx context.Context, req CreateTagRequest) (*Tag, error) {
+	var tag Tag
+	if err := c.post(ctx, "/api/v1/tags", req, &tag); err != nil {
+		return nil, err
+	}
+	return &tag, nil
+}
+
+// UpdateTagRequest for updating a tag; nil fields are left unchanged.
+// Labels, when set, replaces every label; an empty slice clears them.
+type UpdateTagRequest struct {
+	ClipID        *string   `json:"clip_id,omitempty"`
+	OffsetSeconds *float64  `json:"offset_seconds,omitempty"`
+	Quarter       *int      `json:"quarter,omitempty"`
+	Down          *int      `json:"down,omitempty"`
+	Distance      *int      `json:"distance,omitempty"`
+	PlayType      *string   `json:"play_type,omitempty"`
+	Formation     *string   `json:"formation,omitempty"`
+	Result        *string   `json:"result,omitempty"`
+	YardsGained   *int      `json:"yards_gained,omitempty"`
+	Labels        *[]string `json:"labels,omitempty"`
+	Notes         *string   `json:"notes,omitempty"`
+}
+
+// IsEmpty reports whether the request would change nothing
+func (r UpdateTagRequest) IsEmpty() bool {
+	return r.ClipID == nil && r.OffsetSeconds == nil && r.Quarter == nil && r.Down == nil && r.Distance == nil &&
+		r.PlayType == nil && r.Formation == nil && r.Result == nil && r.YardsGained == nil && r.Labels == nil && r.Notes == nil
+}
+
+// UpdateTag applies a partial update to a tag
+func (c *Client) UpdateTag(ctx context.Context, id string, req UpdateTagRequest) (*Tag, error) {
+	var tag Tag
+	if err := c.patch(ctx, "/api/v1/tags/"+id, req, &tag); err != nil {
+		return nil, err
+	}
+	return &tag, nil
+}
+
+// SetTagReviewed marks a tag reviewed, or not reviewed
+func (c *Client) SetTagReviewed(ctx context.Context, id string, reviewed bool) (*Tag, error) {
+	body := struct {
+		Reviewed bool `json:"reviewed"`
+	}{reviewed}
+	var tag Tag
+	if err := c.post(ctx, "/api/v1/tags/"+id+"/reviewed", body, &tag); err != nil {
+		return nil, err
+	}
+	return &tag, nil
+}
+
+// SetTagImportant flags a tag important, or clears the flag
+func (c *Client) SetTagImportant(ctx context.Context, id string, important bool) (*Tag, error) {
+	body := struct {
+		Important bool `json:"important"`
+	}{important}
+	var tag Tag
+	if err := c.post(ctx, "/api/v1/tags/"+id+"/important", body, &tag); err != nil {
+		return nil, err
+	}
+	return &tag, nil
+}
+
+// DeleteTag deletes a tag
+func (c *Client) DeleteTag(ctx context.Context, id string) error {
+	return c.delete(ctx, "/api/v1/tags/"+id)
+}
+
+// RetentionForever is the retention value that keeps clips indefinitely
+const RetentionForever = 0
+
+// RetentionPolicy sets how many days clips are kept, by session type.
+// Session types without an entry use DefaultDays. A value of
+// RetentionForever keeps clips indefinitely.
+type RetentionPolicy struct {
+	DefaultDays  int            `json:"default_days"`
+	SessionTypes map[string]int `json:"session_types,omitempty"`
+}
+
+// SessionRetentionRequest overrides retention for one session. A nil Days
+// clears the override.
+type SessionRetentionRequest struct {
+	Days *int `json:"days"`
+}
+
+// GetRetentionPolicy returns the platform-wide retention policy
+func (c *Client) GetRetentionPolicy(ctx context.Context) (*RetentionPolicy, error) {
+	var policy RetentionPolicy
+	if err := c.get(ctx, "/api/v1/retention", nil, &policy); err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+// SetRetentionPolicy replaces the platform-wide retention policy
+func (c *Client) SetRetentionPolicy(ctx context.Context, policy RetentionPolicy) (*RetentionPolicy, error) {
+	var updated RetentionPolicy
+	if err := c.put(ctx, "/api/v1/retention", policy, &updated); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// SetSessionRetention overrides the retention of a single session
+func (c *Client) SetSessionRetention(ctx context.Context, id string, req SessionRetentionRequest) (*Session, error) {
+	var session Session
+	if err := c.put(ctx, "/api/v1/sessions/"+id+"/retention", req, &session); err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// Playlist is a named, ordered group of clips, e.g. a teaching reel of
+// busted coverages
+type Playlist struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Description *string  `json:"description,omitempty"`
+	ClipIDs     []string `json:"clip_ids"`
+	CreatedAt   string   `json:"created_at,omitempty"`
+}
+
+// CreatePlaylistRequest for creating a new playlist
+type CreatePlaylistRequest struct {
+	Name        string  `json:"name"`
+	Description *string `json:"description,omitempty"`
+}
+
+// ListPlaylistsParams for filtering playlists
+type ListPlaylistsParams struct {
+	Search string
+	Limit  int
+	Offset int
+}
+
+// ListPlaylists returns playlists, optionally filtered by name
+func (c *Client) ListPlaylists(ctx context.Context, params ListPlaylistsParams) (*PaginatedResponse[Playlist], error) {
+	query := url.Values{}
+	if params.Search != "" {
+		query.Set("search", params.Search)
+	}
+	if params.Limit > 0 {
+		query.Set("limit", fmt.Sprintf("%d", params.Limit))
+	}
+	if params.Offset > 0 {
+		query.Set("offset", fmt.Sprintf("%d", params.Offset))
+	}
+
+	var resp PaginatedResponse[Playlist]
+	if err := c.get(ctx, "/api/v1/playlists", query, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetPlaylist returns a single playlist
+func (c *Client) GetPlaylist(ctx context.Context, id string) (*Playlist, error) {
+	var playlist Playlist
+	if err := c.get(ctx, "/api/v1/playlists/"+id, nil, &playlist); err != nil {
+		return nil, err
+	}
+	return &playlist, nil
+}
+
+// CreatePlaylist creates an empty playlist
+func (c *Client) CreatePlaylist(ctx context.Context, req CreatePlaylistRequest) (*Playlist, error) {
+	var playlist Playlist
+	if err := c.post(ctx, "/api/v1/playlists", req, &playlist); err != nil {
+		return nil, err
+	}
+	return &playlist, nil
+}
+
+// AddClipsToPlaylist appends clips to the end of a playlist, in order
+func (c *Client) AddClipsToPlaylist(ctx context.Context, id string, clipIDs []string) (*Playlist, error) {
+	body := struct {
+		ClipIDs []string `json:"clip_ids"`
+	}{clipIDs}
+	var playlist Playlist
+	if err := c.post(ctx, "/api/v1/playlists/"+id+"/clips", body, &playlist); err != nil {
+		return nil, err
+	}
+	return &playlist, nil
+}
+
+// APIError is returned when the platform responds with an error status
+type APIError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("API error %d: %s", e.StatusCode, e.Body)
+}
+
+// IsNotSupported reports whether err means the platform doesn't provide the
+// requested endpoint or method
+func IsNotSupported(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	switch apiErr.StatusCode {
+	case http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusNotImplemented:
+		return true
+	}
+	return false
+}
+
+// HTTP helpers
+
+func (c *Client) get(ctx context.Context, path string, query url.Values, result interface{}) error {
+	u := c.baseURL + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return err
+	}
+
+	return c.doRequest(req, result)
+}
+
+func (c *Client) post(ctx context.Context, path string, body interface{}, result interface{}) error {
+	var bodyReader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		bodyReader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", c.baseURL+path, bodyReader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	return c.doRequest(req, result)
+}
+
+func (c *Client) patch(ctx context.Context, path string, body interface{}, result interface{}) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PATCH", c.baseURL+path, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	return c.doRequest(req, result)
+}
+
+func (c *Client) put(ctx context.Context, path string, body interface{}, result interface{}) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", c.baseURL+path, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	return c.doRequest(req, result)
+}
+
+func (c *Client) delete(ctx context.Context, path string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", c.baseURL+path, nil)
+	if err != nil {
+		return err
+	}
+
+	return c.doRequest(req, nil)
+}
+
+// send executes a request with the client's credentials
+func (c *Client) send(req *http.Request) (*http.Response, error) {
+	return c.sendVia(c.httpClient, req)
+}
+
+// sendVia executes a request with the client's credentials through hc
+func (c *Client) sendVia(hc *http.Client, req *http.Request) (*http.Response, error) {
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	resp, err := hc.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	return resp, nil
+}
+
+func (c *Client) doRequest(req *http.Request, result interface{}) error {
+	resp, err := c.send(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		body, _ := io.ReadAll(resp.Body)
+		return &APIError{StatusCode: resp.StatusCode, Body: string(body)}
+	}
+
+	if result != nil {
+		if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+			return fmt.Errorf("failed to decode response: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,1568 @@
+package videoplatform
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNew(t *testing.T) {
+	c := New("http://localhost:8080")
+	if c == nil {
+		t.Fatal("New() returned nil client")
+	}
+	if c.baseURL != "http://localhost:8080" {
+		t.Errorf("New() baseURL = %v, want %v", c.baseURL, "http://localhost:8080")
+	}
+	if c.httpClient == nil {
+		t.Error("New() httpClient is nil")
+	}
+}
+
+func TestClient_ListSessions(t *testing.T) {
+	// Create mock server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if r.URL.Path != "/api/v1/sessions" {
+			t.Errorf("Expected path /api/v1/sessions, got %s", r.URL.Path)
+		}
+		if r.URL.Query().Has("opponent") {
+			t.Errorf("Expected no opponent filter, got %s", r.URL.RawQuery)
+		}
+
+		resp := PaginatedResponse[Session]{
+			Data: []Session{
+				{ID: "session-1", Name: "Game 1", SessionType: "game", Status: "scheduled"},
+				{ID: "session-2", Name: "Practice 1", SessionType: "practice", Status: "active"},
+			},
+			Total:  2,
+			Limit:  20,
+			Offset: 0,
+		}
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	c := New(server.URL)
+	result, err := c.ListSessions(context.Background(), ListSessionsParams{})
+	if err != nil {
+		t.Fatalf("ListSessions() unexpected error: %v", err)
+	}
+	if result == nil {
+		t.Fatal("ListSessions() returned nil")
+	}
+	if len(result.Data) != 2 {
+		t.Errorf("ListSessions() returned %d sessions, want 2", len(result.Data))
+	}
+	if result.Total != 2 {
+		t.Errorf("ListSessions() total = %d, want 2", result.Total)
+	}
+}
+
+func TestClient_ListSessions_WithParams(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Verify query parameters
+		if r.URL.Query().Get("status") != "active" {
+			t.Errorf("Expected status=active, got %s", r.URL.Query().Get("status"))
+		}
+		if r.URL.Query().Get("session_type") != "game" {
+			t.Errorf("Expected session_type=game, got %s", r.URL.Query().Get("session_type"))
+		}
+		if r.URL.Query().Get("limit") != "10" {
+			t.Errorf("Expected limit=10, got %s", r.URL.Query().Get("limit"))
+		}
+		if r.URL.Query().Get("search") != "Jefferson" {
+			t.Errorf("Expected search=Jefferson, got %s", r.URL.Query().Get("search"))
+		}
+		if r.URL.Query().Get("sort") != "-created_at" {
+			t.Errorf("Expected sort=-created_at, got %s", r.URL.Query().Get("sort"))
+		}
+		if r.URL.Query().Get("opponent") != "Eagles" {
+			t.Errorf("Expected opponent=Eagles, got %s", r.URL.Query().Get("opponent"))
+		}
+		if r.URL.Query().Get("date_from") != "2026-10-01T00:00:00Z" || r.URL.Query().Get("date_to") != "2026-10-08T00:00:00Z" {
+			t.Errorf("Expected date range 2026-10-01 to 2026-10-08, got %s", r.URL.RawQuery)
+		}
+		if r.URL.Query().Get("updated_since") != "2026-10-17T19:00:00Z" {
+			t.Errorf("Expected updated_since=2026-10-17T19:00:00Z, got %s", r.URL.Query().Get("updated_since"))
+		}
+
+		resp := PaginatedResponse[Session]{
+			Data:   []Session{},
+			Total:  0,
+			Limit:  10,
+			Offset: 0,
+		}
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	c := New(server.URL)
+	_, err := c.ListSessions(context.Background(), ListSessionsParams{
+		Status:       "active",
+		SessionType:  "game",
+		Search:       "Jefferson",
+		Opponent:     "Eagles",
+		DateFrom:     "2026-10-01T00:00:00Z",
+		DateTo:       "2026-10-08T00:00:00Z",
+		Sort:         "-created_at",
+		UpdatedSince: "2026-10-17T19:00:00Z",
+		Limit:        10,
+	})
+	if err != nil {
+		t.Fatalf("ListSessions() unexpected error: %v", err)
+	}
+}
+
+func TestClient_GetSession(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/sessions/session-123" {
+			t.Errorf("Expected path /api/v1/sessions/session-123, got %s", r.URL.Path)
+		}
+
+		session := Session{
+			ID:          "session-123",
+			Name:        "Test Game",
+			SessionType: "game",
+			Status:      "active",
+			ClipCount:   5,
+			TagCount:    10,
+		}
+		json.NewEncoder(w).Encode(session)
+	}))
+	defer server.Close()
+
+	c := New(server.URL)
+	session, err := c.GetSession(context.Background(), "session-123")
+	if err != nil {
+		t.Fatalf("GetSession() unexpected error: %v", err)
+	}
+	if session.ID != "session-123" {
+		t.Errorf("GetSession() ID = %v, want session-123", session.ID)
+	}
+	if session.ClipCount != 5 {
+		t.Errorf("GetSession() ClipCount = %v, want 5", session.ClipCount)
+	}
+}
+
+func TestSession_OpponentsJSON(t *testing.T) {
+	t.Run("legacy opponent fills opponents", func(t *testing.T) {
+		var s Session
+		if err := json.Unmarshal([]byte(`{"id":"s-1","opponent":"Eagles"}`), &s); err != nil {
+			t.Fatal(err)
+		}
+		if len(s.Opponents) != 1 || s.Opponents[0] != "Eagles" {
+			t.Errorf("Opponents = %v, want [Eagles]", s.Opponents)
+		}
+	})
+
+	t.Run("opponents fill legacy opponent", func(t *testing.T) {
+		var s Session
+		if err := json.Unmarshal([]byte(`{"id":"s-1","opponents":["Hornets","Wildcats"]}`), &s); err != nil {
+			t.Fatal(err)
+		}
+		if s.Opponent == nil || *s.Opponent != "Hornets" {
+			t.Errorf("Opponent = %v, want Hornets", s.Opponent)
+		}
+	})
+
+	t.Run("encoding keeps both fields", func(t *testing.T) {
+		for _, v := range []interface{}{
+			Session{ID: "s-1", Opponents: []string{"Hornets", "Wildcats"}},
+			CreateSessionRequest{Name: "Jamboree", Opponents: []string{"Hornets", "Wildcats"}},
+			UpdateSessionRequest{Opponents: &[]string{"Hornets", "Wildcats"}},
+		} {
+			data, err := json.Marshal(v)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got struct {
+				Opponent  string   `json:"opponent"`
+				Opponents []string `json:"opponents"`
+			}
+			json.Unmarshal(data, &got)
+			if got.Opponent != "Hornets" || len(got.Opponents) != 2 {
+				t.Errorf("%T encoded as %s", v, data)
+			}
+		}
+	})
+
+	t.Run("clearing sends both fields empty", func(t *testing.T) {
+		none := ""
+		for _, req := range []UpdateSessionRequest{{Opponents: &[]string{}}, {Opponent: &none}} {
+			data, err := json.Marshal(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(data) != `{"opponent":"","opponents":[]}` {
+				t.Errorf("clearing request encoded as %s", data)
+			}
+			if req.IsEmpty() {
+				t.Errorf("IsEmpty() = true for a clearing request %s", data)
+			}
+		}
+	})
+
+	t.Run("no opponent", func(t *testing.T) {
+		data, _ := json.Marshal(Session{ID: "s-1"})
+		if strings.Contains(string(data), "opponent") {
+			t.Errorf("Expected no opponent fields, got %s", data)
+		}
+	})
+}
+
+func TestClient_CreateSession(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("Expected POST request, got %s", r.Method)
+		}
+		if r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("Expected Content-Type application/json, got %s", r.Header.Get("Content-Type"))
+		}
+
+		var req CreateSessionRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("Failed to decode request: %v", err)
+		}
+
+		if req.Name != "New Game" {
+			t.Errorf("Expected name 'New Game', got %s", req.Name)
+		}
+		if req.SessionType != "game" {
+			t.Errorf("Expected session_type 'game', got %s", req.SessionType)
+		}
+
+		session := Session{
+			ID:          "new-session-id",
+			Name:        req.Name,
+			SessionType: req.SessionType,
+			Status:      "scheduled",
+		}
+		json.NewEncoder(w).Encode(session)
+	}))
+	defer server.Close()
+
+	c := New(server.URL)
+	session, err := c.CreateSession(context.Background(), CreateSessionRequest{
+		Name:        "New Game",
+		SessionType: "game",
+	})
+	if err != nil {
+		t.Fatalf("CreateSession() unexpected error: %v", err)
+	}
+	if session.ID != "new-session-id" {
+		t.Errorf("CreateSession() ID = %v, want new-session-id", session.ID)
+	}
+}
+
+func TestSessionNotes(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		var s Session
+		if err := json.Unmarshal([]byte(`{"id":"s-1","notes":"second-string offense, backup QB"}`), &s); err != nil {
+			t.Fatal(err)
+		}
+		if s.Notes == nil || *s.Notes != "second-string offense, backup QB" {
+			t.Fatalf("Notes = %v", s.Notes)
+		}
+		data, _ := json.Marshal(s)
+		if !strings.Contains(string(data), `"notes":"second-string offense, backup QB"`) {
+			t.Errorf("Session encoded as %s", data)
+		}
+	})
+
+	t.Run("absent notes are omitted", func(t *testing.T) {
+		for _, v := range []interface{}{
+			Session{ID: "s-1"},
+			CreateSessionRequest{Name: "New Game", SessionType: "game"},
+			UpdateSessionRequest{Name: new(string)},
+		} {
+			data, _ := json.Marshal(v)
+			if strings.Contains(string(data), "notes") {
+				t.Errorf("%T encoded as %s, want no notes", v, data)
+			}
+		}
+	})
+
+	t.Run("empty notes clear", func(t *testing.T) {
+		empty := ""
+		req := UpdateSessionRequest{Notes: &empty}
+		if req.IsEmpty() {
+			t.Error("IsEmpty() = true for a notes update")
+		}
+		data, _ := json.Marshal(req)
+		if string(data) != `{"notes":""}` {
+			t.Errorf("UpdateSessionRequest encoded as %s, want {\"notes\":\"\"}", data)
+		}
+	})
+}
+
+func TestClient_SessionStateTransitions(t *testing.T) {
+	t.Run("StartSession", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/api/v1/sessions/session-1/start" {
+				t.Errorf("Expected path /api/v1/sessions/session-1/start, got %s", r.URL.Path)
+			}
+			session := Session{ID: "session-1", Status: "active"}
+			json.NewEncoder(w).Encode(session)
+		}))
+		defer server.Close()
+
+		c := New(server.URL)
+		session, err := c.StartSession(context.Background(), "session-1")
+		if err != nil {
+			t.Fatalf("StartSession() unexpected error: %v", err)
+		}
+		if session.Status != "active" {
+			t.Errorf("StartSession() status = %v, want active", session.Status)
+		}
+	})
+
+	t.Run("PauseSession", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/api/v1/sessions/session-1/pause" {
+				t.Errorf("Expected path /api/v1/sessions/session-1/pause, got %s", r.URL.Path)
+			}
+			session := Session{ID: "session-1", Status: "paused"}
+			json.NewEncoder(w).Encode(session)
+		}))
+		defer server.Close()
+
+		c := New(server.URL)
+		session, err := c.PauseSession(context.Background(), "session-1")
+		if err != nil {
+			t.Fatalf("PauseSession() unexpected error: %v", err)
+		}
+		if session.Status != "paused" {
+			t.Errorf("PauseSession() status = %v, want paused", session.Status)
+		}
+	})
+
+	t.Run("CompleteSession", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/api/v1/sessions/session-1/complete" {
+				t.Errorf("Expected path /api/v1/sessions/session-1/complete, got %s", r.URL.Path)
+			}
+			if body, _ := io.ReadAll(r.Body); len(body) != 0 {
+				t.Errorf("Expected no body, got %s", body)
+			}
+			session := Session{ID: "session-1", Status: "completed"}
+			json.NewEncoder(w).Encode(session)
+		}))
+		defer server.Close()
+
+		c := New(server.URL)
+		session, err := c.CompleteSession(context.Background(), "session-1")
+		if err != nil {
+			t.Fatalf("CompleteSession() unexpected error: %v", err)
+		}
+		if session.Status != "completed" {
+			t.Errorf("CompleteSession() status = %v, want completed", session.Status)
+		}
+	})
+
+	t.Run("CompleteSession with score", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			if want := `{"our_score":21,"their_score":14,"result":"win"}`; string(body) != want {
+				t.Errorf("body = %s, want %s", body, want)
+			}
+			json.NewEncoder(w).Encode(Session{ID: "session-1", Status: "completed"})
+		}))
+		defer server.Close()
+
+		ours, theirs, result := 21, 14, "win"
+		c := New(server.URL)
+		_, err := c.CompleteSessionWithResult(context.Background(), "session-1", CompleteSessionRequest{OurScore: &ours, TheirScore: &theirs, Result: &result})
+		if err != nil {
+			t.Fatalf("CompleteSessionWithResult() unexpected error: %v", err)
+		}
+	})
+}
+
+func TestClient_ListClips(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := PaginatedResponse[Clip]{
+			Data: []Clip{
+				{ID: "clip-1", SessionID: "session-1", Status: "ready"},
+				{ID: "clip-2", SessionID: "session-1", Status: "ready"},
+			},
+			Total: 2,
+		}
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	c := New(server.URL)
+	result, err := c.ListClips(context.Background(), ListClipsParams{
+		SessionID: "session-1",
+	})
+	if err != nil {
+		t.Fatalf("ListClips() unexpected error: %v", err)
+	}
+	if len(result.Data) != 2 {
+		t.Errorf("ListClips() returned %d clips, want 2", len(result.Data))
+	}
+}
+
+func TestClient_ListClipsDuration(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("min_duration") != "3" || q.Get("max_duration") != "600.5" {
+			t.Errorf("Expected min_duration=3&max_duration=600.5, got %s", r.URL.RawQuery)
+		}
+		json.NewEncoder(w).Encode(PaginatedResponse[Clip]{})
+	}))
+	defer server.Close()
+
+	c := New(server.URL)
+	if _, err := c.ListClips(context.Background(), ListClipsParams{MinDuration: 3, MaxDuration: 600.5}); err != nil {
+		t.Fatalf("ListClips() unexpected error: %v", err)
+	}
+}
+
+func TestClient_Playlists(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "POST /api/v1/playlists":
+			var req CreatePlaylistRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			json.NewEncoder(w).Encode(Playlist{ID: "pl-1", Name: req.Name, ClipIDs: []string{}})
+		case "POST /api/v1/playlists/pl-1/clips":
+			var body map[string][]string
+			json.NewDecoder(r.Body).Decode(&body)
+			json.NewEncoder(w).Encode(Playlist{ID: "pl-1", ClipIDs: body["clip_ids"]})
+		case "GET /api/v1/playlists":
+			if r.URL.Query().Get("search") != "coverage" {
+				t.Errorf("Expected search=coverage, got %s", r.URL.RawQuery)
+			}
+			json.NewEncoder(w).Encode(PaginatedResponse[Playlist]{Data: []Playlist{{ID: "pl-1"}}, Total: 1})
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c := New(server.URL)
+	ctx := context.Background()
+	created, err := c.CreatePlaylist(ctx, CreatePlaylistRequest{Name: "Busted coverages"})
+	if err != nil || created.Name != "Busted coverages" {
+		t.Fatalf("CreatePlaylist() = %+v, %v", created, err)
+	}
+	updated, err := c.AddClipsToPlaylist(ctx, "pl-1", []string{"clip-1", "clip-2"})
+	if err != nil || !reflect.DeepEqual(updated.ClipIDs, []string{"clip-1", "clip-2"}) {
+		t.Fatalf("AddClipsToPlaylist() = %+v, %v", updated, err)
+	}
+	list, err := c.ListPlaylists(ctx, ListPlaylistsParams{Search: "coverage"})
+	if err != nil || len(list.Data) != 1 {
+		t.Fatalf("ListPlaylists() = %+v, %v", list, err)
+	}
+}
+
+func TestClient_RateClip(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v1/clips/clip-1/rating" {
+			t.Errorf("Expected POST /api/v1/clips/clip-1/rating, got %s %s", r.Method, r.URL.Path)
+		}
+		var body map[string]int
+		json.NewDecoder(r.Body).Decode(&body)
+		rating := body["rating"]
+		json.NewEncoder(w).Encode(Clip{ID: "clip-1", Rating: &rating})
+	}))
+	defer server.Close()
+
+	c := New(server.URL)
+	clip, err := c.RateClip(context.Background(), "clip-1", 4)
+	if err != nil {
+		t.Fatalf("RateClip() unexpected error: %v", err)
+	}
+	if clip.Rating == nil || *clip.Rating != 4 {
+		t.Errorf("Rating = %v, want 4", clip.Rating)
+	}
+}
+
+func TestClient_RecordView(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v1/clips/clip-1/view" {
+			t.Errorf("Expected POST /api/v1/clips/clip-1/view, got %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c := New(server.URL)
+	if err := c.RecordView(context.Background(), "clip-1"); err != nil {
+		t.Fatalf("RecordView() unexpected error: %v", err)
+	}
+}
+
+func TestClient_DownloadClip(t *testing.T) {
+	media := bytes.Repeat([]byte("frame"), 1<<16)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/clips/clip-1/download":
+			if r.Header.Get("Authorization") != "Bearer secret" {
+				t.Errorf("Expected bearer token, got %q", r.Header.Get("Authorization"))
+			}
+			w.Write(media)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	// The request timeout covers API calls, not the media transfer
+	c := New(server.URL, WithToken("secret"), WithTimeout(time.Nanosecond))
+
+	t.Run("streams the file", func(t *testing.T) {
+		var buf bytes.Buffer
+		n, err := c.DownloadClip(context.Background(), "clip-1", &buf)
+		if err != nil {
+			t.Fatalf("DownloadClip() unexpected error: %v", err)
+		}
+		if n != int64(len(media)) || !bytes.Equal(buf.Bytes(), media) {
+			t.Errorf("DownloadClip() wrote %d bytes, want %d", n, len(media))
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		var buf bytes.Buffer
+		_, err := c.DownloadClip(context.Background(), "missing", &buf)
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound {
+			t.Errorf("DownloadClip() error = %v, want a 404 APIError", err)
+		}
+		if buf.Len() != 0 {
+			t.Errorf("DownloadClip() wrote %d bytes of an error response", buf.Len())
+		}
+	})
+}
+
+func TestClient_GetClipURL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/clips/clip-1/url" {
+			t.Errorf("Expected path /api/v1/clips/clip-1/url, got %s", r.URL.Path)
+		}
+		w.Write([]byte(`{"url": "https://cdn.example/clip-1?` + r.URL.Query().Get("type") + `", "expires_at": "2026-10-17T20:00:00Z"}`))
+	}))
+	defer server.Close()
+
+	c := New(server.URL)
+	for _, urlType := range ClipURLTypes {
+		link, err := c.GetClipURL(context.Background(), "clip-1", urlType)
+		if err != nil {
+			t.Fatalf("GetClipURL(%s) unexpected error: %v", urlType, err)
+		}
+		if link.URL != "https://cdn.example/clip-1?"+string(urlType) || link.ExpiresAt == nil || *link.ExpiresAt != "2026-10-17T20:00:00Z" {
+			t.Errorf("GetClipURL(%s) = %+v", urlType, link)
+		}
+	}
+}
+
+func TestClient_CreateClip(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/api/v1/clips" {
+			t.Errorf("Expected POST /api/v1/clips, got %s %s", r.Method, r.URL.Path)
+		}
+
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		want := map[string]interface{}{
+			"session_id": "s-1",
+			"channel_id": "ch-1",
+			"start_time": "2026-10-17T19:00:00Z",
+			"end_time":   "2026-10-17T19:00:12Z",
+		}
+		if !reflect.DeepEqual(body, want) {
+			t.Errorf("body = %v, want %v", body, want)
+		}
+
+		json.NewEncoder(w).Encode(Clip{ID: "clip-9", SessionID: "s-1"})
+	}))
+	defer server.Close()
+
+	c := New(server.URL)
+	clip, err := c.CreateClip(context.Background(), CreateClipRequest{
+		SessionID: "s-1",
+		ChannelID: "ch-1",
+		StartTime: "2026-10-17T19:00:00Z",
+		EndTime:   "2026-10-17T19:00:12Z",
+	})
+	if err != nil {
+		t.Fatalf("CreateClip() unexpected error: %v", err)
+	}
+	if clip.ID != "clip-9" {
+		t.Errorf("CreateClip() ID = %v, want clip-9", clip.ID)
+	}
+}
+
+func TestClient_TrimClip(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/api/v1/clips/clip-1/trim" {
+			t.Errorf("Expected POST /api/v1/clips/clip-1/trim, got %s %s", r.Method, r.URL.Path)
+		}
+
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if want := map[string]interface{}{"trim_start_seconds": 10.0}; !reflect.DeepEqual(body, want) {
+			t.Errorf("body = %v, want %v", body, want)
+		}
+
+		json.NewEncoder(w).Encode(Clip{ID: "clip-1", DurationSeconds: 28})
+	}))
+	defer server.Close()
+
+	c := New(server.URL)
+	seconds := 10.0
+	clip, err := c.TrimClip(context.Background(), "clip-1", TrimClipRequest{TrimStartSeconds: &seconds})
+	if err != nil {
+		t.Fatalf("TrimClip() unexpected error: %v", err)
+	}
+	if clip.DurationSeconds != 28 {
+		t.Errorf("TrimClip() DurationSeconds = %v, want 28", clip.DurationSeconds)
+	}
+}
+
+func TestClient_MergeClips(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/api/v1/clips/merge" {
+			t.Errorf("Expected POST /api/v1/clips/merge, got %s %s", r.Method, r.URL.Path)
+		}
+
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if want := map[string]interface{}{"clip_ids": []interface{}{"clip-b", "clip-a"}}; !reflect.DeepEqual(body, want) {
+			t.Errorf("body = %v, want %v", body, want)
+		}
+
+		json.NewEncoder(w).Encode(Clip{ID: "clip-m"})
+	}))
+	defer server.Close()
+
+	c := New(server.URL)
+	clip, err := c.MergeClips(context.Background(), MergeClipsRequest{ClipIDs: []string{"clip-b", "clip-a"}})
+	if err != nil {
+		t.Fatalf("MergeClips() unexpected error: %v", err)
+	}
+	if clip.ID != "clip-m" {
+		t.Errorf("MergeClips() ID = %v, want clip-m", clip.ID)
+	}
+}
+
+func TestClient_FavoriteClip(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/clips/clip-1/favorite" {
+			t.Errorf("Expected path /api/v1/clips/clip-1/favorite, got %s", r.URL.Path)
+		}
+		clip := Clip{ID: "clip-1", IsFavorite: true}
+		json.NewEncoder(w).Encode(clip)
+	}))
+	defer server.Close()
+
+	c := New(server.URL)
+	clip, err := c.FavoriteClip(context.Background(), "clip-1")
+	if err != nil {
+		t.Fatalf("FavoriteClip() unexpected error: %v", err)
+	}
+	if !clip.IsFavorite {
+		t.Error("FavoriteClip() IsFavorite should be true")
+	}
+}
+
+func TestClient_UpdateClip(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PATCH" {
+			t.Errorf("Expected PATCH request, got %s", r.Method)
+		}
+		if r.URL.Path != "/api/v1/clips/clip-1" {
+			t.Errorf("Expected path /api/v1/clips/clip-1, got %s", r.URL.Path)
+		}
+
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if _, ok := body["description"]; ok {
+			t.Error("Expected description to be omitted when nil")
+		}
+		if body["title"] != "Johnson 45yd TD" {
+			t.Errorf("Expected title 'Johnson 45yd TD', got %v", body["title"])
+		}
+
+		title := "Johnson 45yd TD"
+		json.NewEncoder(w).Encode(Clip{ID: "clip-1", Title: &title})
+	}))
+	defer server.Close()
+
+	c := New(server.URL)
+	title := "Johnson 45yd TD"
+	clip, err := c.UpdateClip(context.Background(), "clip-1", UpdateClipRequest{Title: &title})
+	if err != nil {
+		t.Fatalf("UpdateClip() unexpected error: %v", err)
+	}
+	if clip.Title == nil || *clip.Title != title {
+		t.Errorf("UpdateClip() Title = %v, want %s", clip.Title, title)
+	}
+	if !(UpdateClipRequest{}).IsEmpty() || (UpdateClipRequest{Title: &title}).IsEmpty() {
+		t.Error("UpdateClipRequest.IsEmpty() is wrong")
+	}
+}
+
+func TestClient_ListChannels(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.RawQuery != "" {
+			t.Errorf("Expected no query for zero params, got %s", r.URL.RawQuery)
+		}
+		resp := PaginatedResponse[Channel]{
+			Data: []Channel{
+				{ID: "camera-1", Name: "Main Camera", Status: "active"},
+				{ID: "camera-2", Name: "Secondary Camera", Status: "inactive"},
+			},
+			Total: 2,
+		}
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	c := New(server.URL)
+	result, err := c.ListChannels(context.Background())
+	if err != nil {
+		t.Fatalf("ListChannels() unexpected error: %v", err)
+	}
+	if len(result.Data) != 2 {
+		t.Errorf("ListChannels() returned %d channels, want 2", len(result.Data))
+	}
+}
+
+func TestClient_ListChannelsFiltered(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/channels" {
+			t.Errorf("Expected path /api/v1/channels, got %s", r.URL.Path)
+		}
+		want := "input_type=rtsp&limit=10&offset=20&search=end+zone&status=error"
+		if r.URL.RawQuery != want {
+			t.Errorf("Expected query %s, got %s", want, r.URL.RawQuery)
+		}
+		json.NewEncoder(w).Encode(PaginatedResponse[Channel]{})
+	}))
+	defer server.Close()
+
+	c := New(server.URL)
+	_, err := c.ListChannelsFiltered(context.Background(), ListChannelsParams{
+		Status:    ChannelError,
+		InputType: ChannelInputRTSP,
+		Search:    "end zone",
+		Limit:     10,
+		Offset:    20,
+	})
+	if err != nil {
+		t.Fatalf("ListChannelsFiltered() unexpected error: %v", err)
+	}
+}
+
+func TestClient_GetChannel(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/channels/camera-1" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		msg := "no signal"
+		json.NewEncoder(w).Encode(Channel{ID: "camera-1", Name: "Main Camera", Status: "error", ErrorMessage: &msg})
+	}))
+	defer server.Close()
+
+	c := New(server.URL)
+	channel, err := c.GetChannel(context.Background(), "camera-1")
+	if err != nil {
+		t.Fatalf("GetChannel() unexpected error: %v", err)
+	}
+	if channel.ErrorMessage == nil || *channel.ErrorMessage != "no signal" {
+		t.Errorf("ErrorMessage = %v, want no signal", channel.ErrorMessage)
+	}
+	if _, err := c.GetChannel(context.Background(), "camera-9"); err == nil {
+		t.Error("GetChannel() expected an error for a missing channel")
+	}
+}
+
+func TestClient_CreateChannel(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v1/channels" {
+			t.Errorf("Expected POST /api/v1/channels, got %s %s", r.Method, r.URL.Path)
+		}
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["input_type"] != "rtsp" || body["input_url"] != "rtsp://10.0.0.12/stream1" {
+			t.Errorf("Unexpected body: %v", body)
+		}
+		if _, ok := body["framerate"]; ok {
+			t.Error("Expected unset framerate to be omitted")
+		}
+		json.NewEncoder(w).Encode(Channel{ID: "camera-3", Name: "End Zone", Status: ChannelInactive})
+	}))
+	defer server.Close()
+
+	c := New(server.URL)
+	inputURL := "rtsp://10.0.0.12/stream1"
+	channel, err := c.CreateChannel(context.Background(), CreateChannelRequest{Name: "End Zone", InputType: ChannelInputRTSP, InputURL: &inputURL})
+	if err != nil {
+		t.Fatalf("CreateChannel() unexpected error: %v", err)
+	}
+	if channel.ID != "camera-3" {
+		t.Errorf("ID = %s, want camera-3", channel.ID)
+	}
+}
+
+func TestChannel_GroupRoundTrip(t *testing.T) {
+	var ch Channel
+	if err := json.Unmarshal([]byte(`{"id": "camera-1", "name": "Camera 1", "group": "North Field", "status": "active"}`), &ch); err != nil {
+		t.Fatalf("Unmarshal() unexpected error: %v", err)
+	}
+	if ch.Group == nil || *ch.Group != "North Field" {
+		t.Errorf("Group = %v, want North Field", ch.Group)
+	}
+
+	data, _ := json.Marshal(Channel{ID: "camera-2", Name: "Camera 1"})
+	if strings.Contains(string(data), `"group"`) {
+		t.Errorf("Expected an unset group to be omitted, got %s", data)
+	}
+
+	// An empty group in an update removes the label, so it must be sent
+	empty := ""
+	data, _ = json.Marshal(UpdateChannelRequest{Group: &empty})
+	if string(data) != `{"group":""}` {
+		t.Errorf("Marshal(UpdateChannelRequest) = %s, want {\"group\":\"\"}", data)
+	}
+}
+
+func TestClient_ListChannelsFiltered_Group(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.RawQuery != "group=North+Field" {
+			t.Errorf("Expected query group=North+Field, got %s", r.URL.RawQuery)
+		}
+		json.NewEncoder(w).Encode(PaginatedResponse[Channel]{})
+	}))
+	defer server.Close()
+
+	c := New(server.URL)
+	if _, err := c.ListChannelsFiltered(context.Background(), ListChannelsParams{Group: "North Field"}); err != nil {
+		t.Fatalf("ListChannelsFiltered() unexpected error: %v", err)
+	}
+}
+
+func TestClient_UpdateChannel(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || r.URL.Path != "/api/v1/channels/camera-1" {
+			t.Errorf("Expected PATCH /api/v1/channels/camera-1, got %s %s", r.Method, r.URL.Path)
+		}
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if len(body) != 1 || body["input_url"] != "rtsp://192.168.1.40/stream1" {
+			t.Errorf("Expected only input_url in the body, got %v", body)
+		}
+		json.NewEncoder(w).Encode(Channel{ID: "camera-1"})
+	}))
+	defer server.Close()
+
+	c := New(server.URL)
+	inputURL := "rtsp://192.168.1.40/stream1"
+	if _, err := c.UpdateChannel(context.Background(), "camera-1", UpdateChannelRequest{InputURL: &inputURL}); err != nil {
+		t.Fatalf("UpdateChannel() unexpected error: %v", err)
+	}
+	if !(UpdateChannelRequest{}).IsEmpty() || (UpdateChannelRequest{InputURL: &inputURL}).IsEmpty() {
+		t.Error("UpdateChannelRequest.IsEmpty() is wrong")
+	}
+}
+
+func TestClient_DeleteChannel(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/api/v1/channels/camera-1" {
+			t.Errorf("Expected DELETE /api/v1/channels/camera-1, got %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c := New(server.URL)
+	if err := c.DeleteChannel(context.Background(), "camera-1"); err != nil {
+		t.Fatalf("DeleteChannel() unexpected error: %v", err)
+	}
+}
+
+func TestClient_TestChannel(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v1/channels/camera-2/test" {
+			t.Errorf("Expected POST /api/v1/channels/camera-2/test, got %s %s", r.Method, r.URL.Path)
+		}
+		w.Write([]byte(`{"reachable": true, "latency_ms": 42, "detected_resolution": "1920x1080", "detected_framerate": 59.94}`))
+	}))
+	defer server.Close()
+
+	c := New(server.URL)
+	result, err := c.TestChannel(context.Background(), "camera-2")
+	if err != nil {
+		t.Fatalf("TestChannel() unexpected error: %v", err)
+	}
+	if !result.Reachable || result.LatencyMS == nil || *result.LatencyMS != 42 || result.DetectedFramerate == nil || *result.DetectedFramerate != 59.94 {
+		t.Errorf("TestChannel() = %+v", result)
+	}
+}
+
+func TestClient_RestartChannel(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v1/channels/camera-1/restart" {
+			t.Errorf("Expected POST /api/v1/channels/camera-1/restart, got %s %s", r.Method, r.URL.Path)
+		}
+		json.NewEncoder(w).Encode(Channel{ID: "camera-1", Status: ChannelInactive})
+	}))
+	defer server.Close()
+
+	c := New(server.URL)
+	if _, err := c.RestartChannel(context.Background(), "camera-1"); err != nil {
+		t.Fatalf("RestartChannel() unexpected error: %v", err)
+	}
+}
+
+func TestClient_ClearChannelError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v1/channels/camera-1/clear-error" {
+			t.Errorf("Expected POST /api/v1/channels/camera-1/clear-error, got %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c := New(server.URL)
+	if err := c.ClearChannelError(context.Background(), "camera-1"); err != nil {
+		t.Fatalf("ClearChannelError() unexpected error: %v", err)
+	}
+}
+
+func TestClient_GetChannelStats(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/channels/camera-1/stats" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"bitrate_kbps": 4500, "fps_actual": 59.94, "dropped_frames": 12}`))
+	}))
+	defer server.Close()
+
+	c := New(server.URL)
+	stats, err := c.GetChannelStats(context.Background(), "camera-1")
+	if err != nil {
+		t.Fatalf("GetChannelStats() unexpected error: %v", err)
+	}
+	if stats.BitrateKbps == nil || *stats.BitrateKbps != 4500 || stats.DroppedFrames == nil || *stats.DroppedFrames != 12 {
+		t.Errorf("GetChannelStats() = %+v", stats)
+	}
+	if stats.UptimeSeconds != nil || stats.LastError != nil {
+		t.Errorf("GetChannelStats() should leave missing fields nil, got %+v", stats)
+	}
+}
+
+func TestClient_GetChannelSnapshot(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/channels/camera-1/snapshot" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "image/png")
+		w.Write([]byte("\x89PNG frame"))
+	}))
+	defer server.Close()
+
+	c := New(server.URL)
+	snap, err := c.GetChannelSnapshot(context.Background(), "camera-1")
+	if err != nil {
+		t.Fatalf("GetChannelSnapshot() unexpected error: %v", err)
+	}
+	if snap.ContentType != "image/png" || string(snap.Data) != "\x89PNG frame" {
+		t.Errorf("GetChannelSnapshot() = %q, %q", snap.ContentType, snap.Data)
+	}
+	if _, err := c.GetChannelSnapshot(context.Background(), "camera-9"); err == nil {
+		t.Error("GetChannelSnapshot() expected an error for a missing channel")
+	}
+}
+
+func TestClient_ActivateDeactivateChannel(t *testing.T) {
+	t.Run("ActivateChannel", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/api/v1/channels/camera-1/activate" {
+				t.Errorf("Expected path /api/v1/channels/camera-1/activate, got %s", r.URL.Path)
+			}
+			channel := Channel{ID: "camera-1", Status: "active"}
+			json.NewEncoder(w).Encode(channel)
+		}))
+		defer server.Close()
+
+		c := New(server.URL)
+		channel, err := c.ActivateChannel(context.Background(), "camera-1")
+		if err != nil {
+			t.Fatalf("ActivateChannel() unexpected error: %v", err)
+		}
+		if channel.Status != "active" {
+			t.Errorf("ActivateChannel() status = %v, want active", channel.Status)
+		}
+	})
+
+	t.Run("DeactivateChannel", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/api/v1/channels/camera-1/deactivate" {
+				t.Errorf("Expected path /api/v1/channels/camera-1/deactivate, got %s", r.URL.Path)
+			}
+			channel := Channel{ID: "camera-1", Status: "inactive"}
+			json.NewEncoder(w).Encode(channel)
+		}))
+		defer server.Close()
+
+		c := New(server.URL)
+		channel, err := c.DeactivateChannel(context.Background(), "camera-1")
+		if err != nil {
+			t.Fatalf("DeactivateChannel() unexpected error: %v", err)
+		}
+		if channel.Status != "inactive" {
+			t.Errorf("DeactivateChannel() status = %v, want inactive", channel.Status)
+		}
+	})
+}
+
+func TestClient_ErrorHandling(t *testing.T) {
+	t.Run("404 error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error": "not found"}`))
+		}))
+		defer server.Close()
+
+		c := New(server.URL)
+		_, err := c.GetSession(context.Background(), "non-existent")
+		if err == nil {
+			t.Error("GetSession() expected error for 404")
+		}
+	})
+
+	t.Run("500 error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"error": "internal server error"}`))
+		}))
+		defer server.Close()
+
+		c := New(server.URL)
+		_, err := c.ListSessions(context.Background(), ListSessionsParams{})
+		if err == nil {
+			t.Error("ListSessions() expected error for 500")
+		}
+	})
+}
+
+func TestClient_ListTags(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := PaginatedResponse[Tag]{
+			Data: []Tag{
+				{ID: "tag-1", ClipID: "clip-1", SessionID: "session-1", IsImportant: true},
+				{ID: "tag-2", ClipID: "clip-2", SessionID: "session-1", IsReviewed: true},
+			},
+			Total: 2,
+		}
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	c := New(server.URL)
+	result, err := c.ListTags(context.Background(), ListTagsParams{
+		SessionID: "session-1",
+	})
+	if err != nil {
+		t.Fatalf("ListTags() unexpected error: %v", err)
+	}
+	if len(result.Data) != 2 {
+		t.Errorf("ListTags() returned %d tags, want 2", len(result.Data))
+	}
+}
+
+func TestClient_ListTags_Paging(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		want := "limit=50&offset=100&session_id=session-1"
+		if r.URL.RawQuery != want {
+			t.Errorf("Expected query %s, got %s", want, r.URL.RawQuery)
+		}
+		json.NewEncoder(w).Encode(PaginatedResponse[Tag]{})
+	}))
+	defer server.Close()
+
+	c := New(server.URL)
+	if _, err := c.ListTags(context.Background(), ListTagsParams{SessionID: "session-1", Limit: 50, Offset: 100}); err != nil {
+		t.Fatalf("ListTags() unexpected error: %v", err)
+	}
+}
+
+func TestClient_ListTags_PlayFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		want := "down=3&formation=Trips&label=redzone&play_type=Run&quarter=5&result=first_down&search=blitz"
+		if r.URL.RawQuery != want {
+			t.Errorf("Expected query %s, got %s", want, r.URL.RawQuery)
+		}
+		json.NewEncoder(w).Encode(PaginatedResponse[Tag]{})
+	}))
+	defer server.Close()
+
+	c := New(server.URL)
+	_, err := c.ListTags(context.Background(), ListTagsParams{
+		PlayType:  "Run",
+		Formation: "Trips",
+		Result:    "first_down",
+		Down:      3,
+		Quarter:   5,
+		Label:     "redzone",
+		Search:    "blitz",
+	})
+	if err != nil {
+		t.Fatalf("ListTags() unexpected error: %v", err)
+	}
+}
+
+func TestClient_CreateTag(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("Expected POST request, got %s", r.Method)
+		}
+
+		var req CreateTagRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("Failed to decode request: %v", err)
+		}
+
+		if req.ClipID != "clip-1" {
+			t.Errorf("Expected clip_id 'clip-1', got %s", req.ClipID)
+		}
+
+		tag := Tag{
+			ID:        "new-tag-id",
+			ClipID:    req.ClipID,
+			SessionID: req.SessionID,
+		}
+		json.NewEncoder(w).Encode(tag)
+	}))
+	defer server.Close()
+
+	c := New(server.URL)
+	playType := "run"
+	tag, err := c.CreateTag(context.Background(), CreateTagRequest{
+		ClipID:    "clip-1",
+		SessionID: "session-1",
+		PlayType:  &playType,
+	})
+	if err != nil {
+		t.Fatalf("CreateTag() unexpected error: %v", err)
+	}
+	if tag.ID != "new-tag-id" {
+		t.Errorf("CreateTag() ID = %v, want new-tag-id", tag.ID)
+	}
+}
+
+func TestClient_Health(t *testing.T) {
+	t.Run("healthy", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/health" {
+				t.Errorf("Expected path /health, got %s", r.URL.Path)
+			}
+			w.Write([]byte(`{"status":"ok"}`))
+		}))
+		defer server.Close()
+
+		c := New(server.URL)
+		if err := c.Health(context.Background()); err != nil {
+			t.Errorf("Health() unexpected error: %v", err)
+		}
+	})
+
+	t.Run("unhealthy", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		c := New(server.URL)
+		if err := c.Health(context.Background()); err == nil {
+			t.Error("Health() expected error for 503")
+		}
+	})
+}
+
+func TestClient_SessionLock(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/sessions/session-1/lock" {
+			t.Errorf("Expected path /api/v1/sessions/session-1/lock, got %s", r.URL.Path)
+		}
+		switch r.Method {
+		case "GET":
+			json.NewEncoder(w).Encode(SessionLock{SessionID: "session-1", Locked: false})
+		case "POST":
+			var req LockSessionRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			json.NewEncoder(w).Encode(SessionLock{SessionID: "session-1", Locked: true, LockedBy: req.LockedBy, Note: req.Note})
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	}))
+	defer server.Close()
+
+	c := New(server.URL)
+	lock, err := c.GetSessionLock(context.Background(), "session-1")
+	if err != nil {
+		t.Fatalf("GetSessionLock() unexpected error: %v", err)
+	}
+	if lock.Locked {
+		t.Error("GetSessionLock() Locked should be false")
+	}
+
+	lock, err = c.LockSession(context.Background(), "session-1", LockSessionRequest{LockedBy: "booth", Note: "live"})
+	if err != nil {
+		t.Fatalf("LockSession() unexpected error: %v", err)
+	}
+	if !lock.Locked || lock.LockedBy != "booth" || lock.Note != "live" {
+		t.Errorf("LockSession() = %+v", lock)
+	}
+
+	if err := c.UnlockSession(context.Background(), "session-1"); err != nil {
+		t.Fatalf("UnlockSession() unexpected error: %v", err)
+	}
+}
+
+func TestAPIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		w.Write([]byte(`{"error": "method not allowed"}`))
+	}))
+	defer server.Close()
+
+	c := New(server.URL)
+	_, err := c.GetSessionLock(context.Background(), "session-1")
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected *APIError, got %T", err)
+	}
+	if apiErr.StatusCode != http.StatusMethodNotAllowed {
+		t.Errorf("APIError.StatusCode = %d, want 405", apiErr.StatusCode)
+	}
+	if !IsNotSupported(err) {
+		t.Error("IsNotSupported() should be true for 405")
+	}
+	if IsNotSupported(&APIError{StatusCode: http.StatusInternalServerError}) {
+		t.Error("IsNotSupported() should be false for 500")
+	}
+}
+
+func TestClient_UpdateTag(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PATCH" {
+			t.Errorf("Expected PATCH request, got %s", r.Method)
+		}
+		if r.URL.Path != "/api/v1/tags/tag-1" {
+			t.Errorf("Expected path /api/v1/tags/tag-1, got %s", r.URL.Path)
+		}
+
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if _, ok := body["offset_seconds"]; ok {
+			t.Error("Expected offset_seconds to be omitted when nil")
+		}
+		if body["clip_id"] != "clip-2" {
+			t.Errorf("Expected clip_id clip-2, got %v", body["clip_id"])
+		}
+
+		json.NewEncoder(w).Encode(Tag{ID: "tag-1", ClipID: "clip-2"})
+	}))
+	defer server.Close()
+
+	c := New(server.URL)
+	clipID := "clip-2"
+	tag, err := c.UpdateTag(context.Background(), "tag-1", UpdateTagRequest{ClipID: &clipID})
+	if err != nil {
+		t.Fatalf("UpdateTag() unexpected error: %v", err)
+	}
+	if tag.ClipID != "clip-2" {
+		t.Errorf("UpdateTag() ClipID = %v, want clip-2", tag.ClipID)
+	}
+}
+
+func TestClient_UpdateTag_PlayFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		want := map[string]interface{}{"yards_gained": float64(12), "labels": []interface{}{}}
+		if !reflect.DeepEqual(body, want) {
+			t.Errorf("Expected body %v, got %v", want, body)
+		}
+		json.NewEncoder(w).Encode(Tag{ID: "tag-1"})
+	}))
+	defer server.Close()
+
+	c := New(server.URL)
+	yards, labels := 12, []string{}
+	if _, err := c.UpdateTag(context.Background(), "tag-1", UpdateTagRequest{YardsGained: &yards, Labels: &labels}); err != nil {
+		t.Fatalf("UpdateTag() unexpected error: %v", err)
+	}
+	if !(UpdateTagRequest{}).IsEmpty() || (UpdateTagRequest{Labels: &labels}).IsEmpty() {
+		t.Error("UpdateTagRequest.IsEmpty() is wrong")
+	}
+}
+
+func TestClient_DeleteTag(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/api/v1/tags/tag-1" {
+			t.Errorf("Expected DELETE /api/v1/tags/tag-1, got %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c := New(server.URL)
+	if err := c.DeleteTag(context.Background(), "tag-1"); err != nil {
+		t.Fatalf("DeleteTag() unexpected error: %v", err)
+	}
+}
+
+func TestClient_SetTagReviewed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v1/tags/tag-1/reviewed" {
+			t.Errorf("Expected POST /api/v1/tags/tag-1/reviewed, got %s %s", r.Method, r.URL.Path)
+		}
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["reviewed"] != false {
+			t.Errorf("Expected reviewed false in body, got %v", body)
+		}
+		json.NewEncoder(w).Encode(Tag{ID: "tag-1", IsReviewed: false})
+	}))
+	defer server.Close()
+
+	c := New(server.URL)
+	tag, err := c.SetTagReviewed(context.Background(), "tag-1", false)
+	if err != nil {
+		t.Fatalf("SetTagReviewed() unexpected error: %v", err)
+	}
+	if tag.ID != "tag-1" || tag.IsReviewed {
+		t.Errorf("SetTagReviewed() = %+v", tag)
+	}
+}
+
+func TestClient_SetTagImportant(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v1/tags/tag-1/important" {
+			t.Errorf("Expected POST /api/v1/tags/tag-1/important, got %s %s", r.Method, r.URL.Path)
+		}
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["important"] != true {
+			t.Errorf("Expected important true in body, got %v", body)
+		}
+		json.NewEncoder(w).Encode(Tag{ID: "tag-1", IsImportant: true})
+	}))
+	defer server.Close()
+
+	c := New(server.URL)
+	tag, err := c.SetTagImportant(context.Background(), "tag-1", true)
+	if err != nil {
+		t.Fatalf("SetTagImportant() unexpected error: %v", err)
+	}
+	if !tag.IsImportant {
+		t.Errorf("SetTagImportant() = %+v", tag)
+	}
+}
+
+func TestClient_RecentRequests(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/sessions/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode(Session{ID: "session-1"})
+	}))
+	defer server.Close()
+
+	c := New(server.URL)
+	for i := 0; i < requestHistorySize+5; i++ {
+		c.GetSession(context.Background(), "session-1")
+	}
+	c.GetSession(context.Background(), "missing")
+
+	history := c.RecentRequests()
+	if len(history) != requestHistorySize {
+		t.Fatalf("RecentRequests() returned %d entries, want %d", len(history), requestHistorySize)
+	}
+
+	last := history[len(history)-1]
+	if last.Method != "GET" || last.Path != "/api/v1/sessions/missing" || last.Status != http.StatusNotFound {
+		t.Errorf("RecentRequests() newest entry = %+v", last)
+	}
+	if history[0].Path != "/api/v1/sessions/session-1" {
+		t.Errorf("RecentRequests() oldest entry = %+v", history[0])
+	}
+}
+
+func TestNew_Options(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(Session{ID: "session-1"})
+	}))
+	defer server.Close()
+
+	var logged bytes.Buffer
+	hc := &http.Client{Timeout: 5 * time.Second}
+	c := New(server.URL, WithHTTPClient(hc), WithLogger(log.New(&logged, "", 0)))
+
+	if _, err := c.GetSession(context.Background(), "session-1"); err != nil {
+		t.Fatalf("GetSession() unexpected error: %v", err)
+	}
+	if !strings.Contains(logged.String(), "GET /api/v1/sessions/session-1 200") {
+		t.Errorf("WithLogger() logged %q", logged.String())
+	}
+	if hc.Transport != nil {
+		t.Error("WithHTTPClient() must not modify the caller's client")
+	}
+	if c.httpClient.Timeout != 5*time.Second {
+		t.Errorf("WithHTTPClient() timeout = %v, want 5s", c.httpClient.Timeout)
+	}
+
+	if got := New(server.URL, WithTimeout(time.Second)).httpClient.Timeout; got != time.Second {
+		t.Errorf("WithTimeout() timeout = %v, want 1s", got)
+	}
+}
+
+func TestClient_Retention(t *testing.T) {
+	var bodies = map[string]map[string]interface{}{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" {
+			json.NewEncoder(w).Encode(RetentionPolicy{DefaultDays: RetentionForever, SessionTypes: map[string]int{"practice": 30}})
+			return
+		}
+
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		bodies[r.URL.Path] = body
+
+		if r.URL.Path == "/api/v1/retention" {
+			json.NewEncoder(w).Encode(RetentionPolicy{})
+			return
+		}
+		json.NewEncoder(w).Encode(Session{ID: "session-1"})
+	}))
+	defer server.Close()
+
+	c := New(server.URL)
+	ctx := context.Background()
+
+	policy, err := c.GetRetentionPolicy(ctx)
+	if err != nil {
+		t.Fatalf("GetRetentionPolicy() unexpected error: %v", err)
+	}
+	if policy.SessionTypes["practice"] != 30 {
+		t.Errorf("GetRetentionPolicy() practice = %d, want 30", policy.SessionTypes["practice"])
+	}
+
+	if _, err := c.SetRetentionPolicy(ctx, RetentionPolicy{DefaultDays: 90, SessionTypes: map[string]int{"game": RetentionForever}}); err != nil {
+		t.Fatalf("SetRetentionPolicy() unexpected error: %v", err)
+	}
+	body := bodies["/api/v1/retention"]
+	if body["default_days"] != float64(90) {
+		t.Errorf("SetRetentionPolicy() default_days = %v, want 90", body["default_days"])
+	}
+	if types, _ := body["session_types"].(map[string]interface{}); types["game"] != float64(0) {
+		t.Errorf("SetRetentionPolicy() session_types = %v", body["session_types"])
+	}
+
+	days := 7
+	if _, err := c.SetSessionRetention(ctx, "session-1", SessionRetentionRequest{Days: &days}); err != nil {
+		t.Fatalf("SetSessionRetention() unexpected error: %v", err)
+	}
+	if got := bodies["/api/v1/sessions/session-1/retention"]["days"]; got != float64(7) {
+		t.Errorf("SetSessionRetention() days = %v, want 7", got)
+	}
+
+	if _, err := c.SetSessionRetention(ctx, "session-1", SessionRetentionRequest{}); err != nil {
+		t.Fatalf("SetSessionRetention() unexpected error: %v", err)
+	}
+	cleared, ok := bodies["/api/v1/sessions/session-1/retention"]["days"]
+	if !ok || cleared != nil {
+		t.Errorf("SetSessionRetention() clear should send days: null, got %v", cleared)
+	}
+}
+
+func TestClient_CancelAndDeleteSession(t *testing.T) {
+	var calls []string
+	var body CancelSessionRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		if r.Method == "DELETE" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		json.NewEncoder(w).Encode(Session{ID: "session-1", Status: "cancelled"})
+	}))
+	defer server.Close()
+
+	c := New(server.URL)
+	reason := "rained out"
+	session, err := c.CancelSession(context.Background(), "session-1", CancelSessionRequest{Reason: &reason})
+	if err != nil {
+		t.Fatalf("CancelSession() unexpected error: %v", err)
+	}
+	if session.Status != "cancelled" {
+		t.Errorf("CancelSession() Status = %v, want cancelled", session.Status)
+	}
+	if body.Reason == nil || *body.Reason != reason {
+		t.Errorf("CancelSession() should send the reason, got %+v", body)
+	}
+	if err := c.DeleteSession(context.Background(), "session-2"); err != nil {
+		t.Fatalf("DeleteSession() unexpected error: %v", err)
+	}
+
+	want := []string{"POST /api/v1/sessions/session-1/cancel", "DELETE /api/v1/sessions/session-2"}
+	if len(calls) != 2 || calls[0] != want[0] || calls[1] != want[1] {
+		t.Errorf("Expected calls %v, got %v", want, calls)
+	}
+}
+
+func TestClient_Token(t *testing.T) {
+	var auth []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth = append(auth, r.Header.Get("Authorization"))
+		json.NewEncoder(w).Encode(Session{ID: "session-1"})
+	}))
+	defer server.Close()
+
+	c := New(server.URL, WithToken("secret"))
+	if _, err := c.GetSession(context.Background(), "session-1"); err != nil {
+		t.Fatalf("GetSession() unexpected error: %v", err)
+	}
+	resp, err := c.Do(context.Background(), "GET", "/api/v1/sessions/session-1", nil, nil)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		t.Fatalf("Do() = %+v, %v", resp, err)
+	}
+	if _, err := New(server.URL).GetSession(context.Background(), "session-1"); err != nil {
+		t.Fatalf("GetSession() unexpected error: %v", err)
+	}
+
+	want := []string{"Bearer secret", "Bearer secret", ""}
+	if strings.Join(auth, "|") != strings.Join(want, "|") {
+		t.Errorf("Authorization headers = %q, want %q", auth, want)
+	}
+
+	if _, err := c.Do(context.Background(), "GET", "api/v1/sessions", nil, nil); err == nil {
+		t.Error("Do() should reject a path without a leading /")
+	}
+}
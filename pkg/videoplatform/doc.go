@@ -0,0 +1,11 @@
+// Package videoplatform is a Go client for the video platform REST API.
+//
+// Create a client with New and call its methods with a context:
+//
+//	c := videoplatform.New("http://localhost:8080", videoplatform.WithTimeout(10*time.Second))
+//	sessions, err := c.ListSessions(ctx, videoplatform.ListSessionsParams{Status: videoplatform.SessionActive})
+//
+// Requests that reach the platform but fail return an *APIError carrying the
+// HTTP status and response body; IsNotSupported reports whether the platform
+// lacks an endpoint.
+package videoplatform
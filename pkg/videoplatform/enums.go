@@ -0,0 +1,160 @@
+package videoplatform
+
+// The platform reports statuses and types as plain strings. The typed
+// constants below name the values this client knows about; values added by
+// newer platforms still decode, and IsValid reports whether a value is one
+// of the known ones.
+
+// SessionStatus is the lifecycle status of a session
+type SessionStatus string
+
+const (
+	SessionScheduled SessionStatus = "scheduled"
+	SessionActive    SessionStatus = "active"
+	SessionPaused    SessionStatus = "paused"
+	SessionCompleted SessionStatus = "completed"
+	SessionArchived  SessionStatus = "archived"
+	SessionCancelled SessionStatus = "cancelled"
+)
+
+// SessionStatuses lists the known session statuses in lifecycle order
+var SessionStatuses = []SessionStatus{
+	SessionScheduled, SessionActive, SessionPaused, SessionCompleted, SessionArchived, SessionCancelled,
+}
+
+// IsValid reports whether s is a known session status
+func (s SessionStatus) IsValid() bool {
+	_, ok := SessionTransitions[s]
+	return ok
+}
+
+// SessionTransitions lists the statuses a session may move to from each
+// status. Every known status has an entry; terminal statuses map to none.
+var SessionTransitions = map[SessionStatus][]SessionStatus{
+	SessionScheduled: {SessionActive, SessionCancelled},
+	SessionActive:    {SessionPaused, SessionCompleted},
+	SessionPaused:    {SessionActive, SessionCompleted},
+	SessionCompleted: {SessionArchived},
+	SessionArchived:  {},
+	SessionCancelled: {SessionArchived},
+}
+
+// CanTransitionTo reports whether a session may move from s to next
+func (s SessionStatus) CanTransitionTo(next SessionStatus) bool {
+	for _, to := range SessionTransitions[s] {
+		if to == next {
+			return true
+		}
+	}
+	return false
+}
+
+// IsLive reports whether a session in status s is recording or paused
+// mid-recording
+func (s SessionStatus) IsLive() bool {
+	return s == SessionActive || s == SessionPaused
+}
+
+// SessionType is the kind of event a session records
+type SessionType string
+
+const (
+	SessionTypeGame      SessionType = "game"
+	SessionTypePractice  SessionType = "practice"
+	SessionTypeScrimmage SessionType = "scrimmage"
+	SessionTypeTraining  SessionType = "training"
+	SessionTypeOther     SessionType = "other"
+)
+
+// SessionTypes lists the known session types
+var SessionTypes = []SessionType{
+	SessionTypeGame, SessionTypePractice, SessionTypeScrimmage, SessionTypeTraining, SessionTypeOther,
+}
+
+// IsValid reports whether t is a known session type
+func (t SessionType) IsValid() bool {
+	return contains(SessionTypes, t)
+}
+
+// ClipStatus is the processing status of a clip
+type ClipStatus string
+
+const (
+	ClipPending    ClipStatus = "pending"
+	ClipProcessing ClipStatus = "processing"
+	ClipReady      ClipStatus = "ready"
+	ClipFailed     ClipStatus = "failed"
+)
+
+// ClipStatuses lists the known clip statuses in processing order
+var ClipStatuses = []ClipStatus{ClipPending, ClipProcessing, ClipReady, ClipFailed}
+
+// IsValid reports whether s is a known clip status
+func (s ClipStatus) IsValid() bool {
+	return contains(ClipStatuses, s)
+}
+
+// ClipURLType is the kind of link GetClipURL returns
+type ClipURLType string
+
+const (
+	ClipURLStream   ClipURLType = "stream"
+	ClipURLDownload ClipURLType = "download"
+)
+
+// ClipURLTypes lists the known clip URL types
+var ClipURLTypes = []ClipURLType{ClipURLStream, ClipURLDownload}
+
+// IsValid reports whether t is a known clip URL type
+func (t ClipURLType) IsValid() bool {
+	return contains(ClipURLTypes, t)
+}
+
+// ChannelStatus is the status of a video input channel
+type ChannelStatus string
+
+const (
+	ChannelActive   ChannelStatus = "active"
+	ChannelInactive ChannelStatus = "inactive"
+	ChannelError    ChannelStatus = "error"
+)
+
+// ChannelStatuses lists the known channel statuses
+var ChannelStatuses = []ChannelStatus{ChannelActive, ChannelInactive, ChannelError}
+
+// IsValid reports whether s is a known channel status
+func (s ChannelStatus) IsValid() bool {
+	return contains(ChannelStatuses, s)
+}
+
+// ChannelInputType is the kind of source a channel records from
+type ChannelInputType string
+
+const (
+	ChannelInputRTSP ChannelInputType = "rtsp"
+	ChannelInputUSB  ChannelInputType = "usb"
+	ChannelInputNDI  ChannelInputType = "ndi"
+	ChannelInputFile ChannelInputType = "file"
+)
+
+// ChannelInputTypes lists the known channel input types
+var ChannelInputTypes = []ChannelInputType{ChannelInputRTSP, ChannelInputUSB, ChannelInputNDI, ChannelInputFile}
+
+// IsValid reports whether t is a known channel input type
+func (t ChannelInputType) IsValid() bool {
+	return contains(ChannelInputTypes, t)
+}
+
+// IsNetwork reports whether channels of type t are reached at a URL
+func (t ChannelInputType) IsNetwork() bool {
+	return t == ChannelInputRTSP || t == ChannelInputNDI
+}
+
+func contains[T comparable](values []T, v T) bool {
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,107 @@
+package videoplatform
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestSessionTransitions_Complete(t *testing.T) {
+	if len(SessionTransitions) != len(SessionStatuses) {
+		t.Errorf("SessionTransitions has %d entries, want one per status (%d)", len(SessionTransitions), len(SessionStatuses))
+	}
+	for _, status := range SessionStatuses {
+		targets, ok := SessionTransitions[status]
+		if !ok {
+			t.Errorf("SessionTransitions missing %q", status)
+		}
+		for _, to := range targets {
+			if !to.IsValid() {
+				t.Errorf("%s -> %q: target is not a known status", status, to)
+			}
+			if to == status {
+				t.Errorf("%s transitions to itself", status)
+			}
+		}
+	}
+
+	// Every status other than scheduled must be reachable
+	reachable := map[SessionStatus]bool{SessionScheduled: true}
+	for _, targets := range SessionTransitions {
+		for _, to := range targets {
+			reachable[to] = true
+		}
+	}
+	for _, status := range SessionStatuses {
+		if !reachable[status] {
+			t.Errorf("%s is unreachable", status)
+		}
+	}
+
+	if !SessionPaused.CanTransitionTo(SessionActive) {
+		t.Error("paused -> active should be allowed")
+	}
+	if SessionArchived.CanTransitionTo(SessionActive) {
+		t.Error("archived -> active should not be allowed")
+	}
+}
+
+func TestEnums_IsValid(t *testing.T) {
+	if !SessionTypeScrimmage.IsValid() || SessionType("tournament").IsValid() {
+		t.Error("SessionType.IsValid() is wrong")
+	}
+	if !ClipProcessing.IsValid() || ClipStatus("queued").IsValid() {
+		t.Error("ClipStatus.IsValid() is wrong")
+	}
+	if !ChannelInactive.IsValid() || ChannelStatus("degraded").IsValid() {
+		t.Error("ChannelStatus.IsValid() is wrong")
+	}
+	if !ClipURLDownload.IsValid() || ClipURLType("embed").IsValid() {
+		t.Error("ClipURLType.IsValid() is wrong")
+	}
+	if !ChannelInputNDI.IsValid() || ChannelInputType("hdmi").IsValid() {
+		t.Error("ChannelInputType.IsValid() is wrong")
+	}
+	if SessionStatus("").IsValid() {
+		t.Error("empty SessionStatus should not be valid")
+	}
+}
+
+func TestEnums_JSON(t *testing.T) {
+	t.Run("unknown statuses decode", func(t *testing.T) {
+		var s Session
+		if err := json.Unmarshal([]byte(`{"id":"s-1","status":"transcoding","session_type":"tournament"}`), &s); err != nil {
+			t.Fatalf("Unmarshal() error = %v", err)
+		}
+		if s.Status != "transcoding" || s.Status.IsValid() {
+			t.Errorf("Status = %q (valid %v), want unknown transcoding", s.Status, s.Status.IsValid())
+		}
+		if s.SessionType != "tournament" {
+			t.Errorf("SessionType = %q, want tournament", s.SessionType)
+		}
+
+		var ch Channel
+		if err := json.Unmarshal([]byte(`{"id":"ch-1","status":"degraded"}`), &ch); err != nil {
+			t.Fatalf("Unmarshal() error = %v", err)
+		}
+		if ch.Status != "degraded" {
+			t.Errorf("Channel.Status = %q, want degraded", ch.Status)
+		}
+	})
+
+	t.Run("encodes plain strings", func(t *testing.T) {
+		data, err := json.Marshal(Clip{ID: "clip-1", Status: ClipReady})
+		if err != nil {
+			t.Fatalf("Marshal() error = %v", err)
+		}
+		if !strings.Contains(string(data), `"status":"ready"`) {
+			t.Errorf("Marshal() = %s, want plain status string", data)
+		}
+
+		sessionType := SessionTypeGame
+		data, _ = json.Marshal(UpdateSessionRequest{SessionType: &sessionType})
+		if string(data) != `{"session_type":"game"}` {
+			t.Errorf("Marshal() = %s", data)
+		}
+	})
+}
@@ -0,0 +1,255 @@
+package videoplatform
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sort"
+
+	"github.com/Prodro21/video-mcp/internal/fanout"
+)
+
+// pageSize is the page size used when fetching every page of a listing
+const pageSize = 100
+
+// maxConcurrentFetches bounds fan-out requests to the platform
+const maxConcurrentFetches = 4
+
+// ListAllTags fetches every page of tags matching params. Limit sets the
+// page size and Offset is ignored.
+func (c *Client) ListAllTags(ctx context.Context, params ListTagsParams) ([]Tag, error) {
+	if params.Limit <= 0 {
+		params.Limit = pageSize
+	}
+	params.Offset = 0
+
+	var all []Tag
+	for {
+		resp, err := c.ListTags(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, resp.Data...)
+		if len(resp.Data) == 0 || len(all) >= resp.Total {
+			return all, nil
+		}
+		params.Offset += len(resp.Data)
+	}
+}
+
+// ListAllSessions fetches every page of sessions matching params. Limit
+// sets the page size and Offset is ignored.
+func (c *Client) ListAllSessions(ctx context.Context, params ListSessionsParams) ([]Session, error) {
+	if params.Limit <= 0 {
+		params.Limit = pageSize
+	}
+	params.Offset = 0
+
+	var all []Session
+	for {
+		resp, err := c.ListSessions(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, resp.Data...)
+		if len(resp.Data) == 0 || len(all) >= resp.Total {
+			return all, nil
+		}
+		params.Offset += len(resp.Data)
+	}
+}
+
+// ListAllChannels fetches every page of channels matching params. Limit
+// sets the page size and Offset is ignored.
+func (c *Client) ListAllChannels(ctx context.Context, params ListChannelsParams) ([]Channel, error) {
+	if params.Limit <= 0 {
+		params.Limit = pageSize
+	}
+	params.Offset = 0
+
+	var all []Channel
+	for {
+		resp, err := c.ListChannelsFiltered(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, resp.Data...)
+		if len(resp.Data) == 0 || len(all) >= resp.Total {
+			return all, nil
+		}
+		params.Offset += len(resp.Data)
+	}
+}
+
+// ListAllClips fetches every page of clips matching params. Limit sets the
+// page size and Offset is ignored.
+func (c *Client) ListAllClips(ctx context.Context, params ListClipsParams) ([]Clip, error) {
+	if params.Limit <= 0 {
+		params.Limit = pageSize
+	}
+	params.Offset = 0
+
+	var all []Clip
+	for {
+		resp, err := c.ListClips(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, resp.Data...)
+		if len(resp.Data) == 0 || len(all) >= resp.Total {
+			return all, nil
+		}
+		params.Offset += len(resp.Data)
+	}
+}
+
+// SessionFetchError reports a session whose data could not be fetched
+type SessionFetchError struct {
+	SessionID string `json:"session_id"`
+	Error     string `json:"error"`
+}
+
+// MultiSessionTags holds tags merged across several sessions
+type MultiSessionTags struct {
+	Tags   []Tag               `json:"tags"`
+	Failed []SessionFetchError `json:"failed,omitempty"`
+}
+
+// ListTagsForSessions fetches all tags for each session concurrently and
+// merges them ordered by session (in the order given) then created_at.
+// Sessions that fail are reported in Failed without dropping the others;
+// an error is returned only if ctx is cancelled.
+func (c *Client) ListTagsForSessions(ctx context.Context, sessionIDs []string, params ListTagsParams) (*MultiSessionTags, error) {
+	order := make(map[string]int, len(sessionIDs))
+	var ids []string
+	for _, id := range sessionIDs {
+		if _, dup := order[id]; !dup {
+			order[id] = len(ids)
+			ids = append(ids, id)
+		}
+	}
+
+	tags := make([][]Tag, len(ids))
+	errs := fanout.Each(ctx, len(ids), maxConcurrentFetches, func(i int) (err error) {
+		p := params
+		p.SessionID = ids[i]
+		tags[i], err = c.ListAllTags(ctx, p)
+		return err
+	})
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	result := &MultiSessionTags{}
+	for i, id := range ids {
+		if errs[i] != nil {
+			result.Failed = append(result.Failed, SessionFetchError{SessionID: id, Error: errs[i].Error()})
+			continue
+		}
+		result.Tags = append(result.Tags, tags[i]...)
+	}
+
+	sort.SliceStable(result.Tags, func(a, b int) bool {
+		ta, tb := result.Tags[a], result.Tags[b]
+		if ta.SessionID != tb.SessionID {
+			return order[ta.SessionID] < order[tb.SessionID]
+		}
+		if ta.CreatedAt != tb.CreatedAt {
+			return ta.CreatedAt < tb.CreatedAt
+		}
+		return ta.ID < tb.ID
+	})
+	return result, nil
+}
+
+// ClipResult is the outcome of fetching one clip with GetClipsInOrder:
+// the clip, or the error fetching it
+type ClipResult struct {
+	ID   string
+	Clip *Clip
+	Err  error
+}
+
+// GetClipsInOrder fetches several clips concurrently, at most
+// maxConcurrentFetches at a time, and returns one result per ID in input
+// order. A repeated ID is fetched once. Cancelling ctx aborts the requests
+// in flight and fails those not yet started with the context's error.
+func (c *Client) GetClipsInOrder(ctx context.Context, ids []string) []ClipResult {
+	index := make(map[string]int, len(ids))
+	var distinct []string
+	for _, id := range ids {
+		if _, seen := index[id]; !seen {
+			index[id] = len(distinct)
+			distinct = append(distinct, id)
+		}
+	}
+
+	clips := make([]*Clip, len(distinct))
+	errs := fanout.Each(ctx, len(distinct), maxConcurrentFetches, func(i int) (err error) {
+		clips[i], err = c.GetClip(ctx, distinct[i])
+		return err
+	})
+
+	results := make([]ClipResult, len(ids))
+	for i, id := range ids {
+		j := index[id]
+		results[i] = ClipResult{ID: id, Clip: clips[j], Err: errs[j]}
+	}
+	return results
+}
+
+// GetClips fetches several clips concurrently, once per distinct ID. Clips
+// that no longer exist are left out of the result; any other failure is
+// returned as an error.
+func (c *Client) GetClips(ctx context.Context, ids []string) (map[string]Clip, error) {
+	result := make(map[string]Clip, len(ids))
+	for _, r := range c.GetClipsInOrder(ctx, ids) {
+		var apiErr *APIError
+		switch {
+		case r.Err == nil:
+			result[r.ID] = *r.Clip
+		case errors.As(r.Err, &apiErr) && apiErr.StatusCode == http.StatusNotFound:
+		default:
+			return nil, r.Err
+		}
+	}
+	return result, nil
+}
+
+// ChannelUsage summarizes the clips a channel has produced. Error is set
+// when the channel's count could not be fetched.
+type ChannelUsage struct {
+	ChannelID        string `json:"channel_id"`
+	ClipCount        int    `json:"clip_count"`
+	MostRecentClipAt string `json:"most_recent_clip_at,omitempty"`
+	Error            string `json:"error,omitempty"`
+}
+
+// ListChannelUsage counts each channel's clips concurrently with a
+// count-only query, returning one entry per channel in the order given.
+// Channels that fail carry an Error without dropping the others; an error
+// is returned only if ctx is cancelled.
+func (c *Client) ListChannelUsage(ctx context.Context, channelIDs []string) ([]ChannelUsage, error) {
+	usage := make([]ChannelUsage, len(channelIDs))
+
+	fanout.Each(ctx, len(channelIDs), maxConcurrentFetches, func(i int) error {
+		usage[i].ChannelID = channelIDs[i]
+		// Newest first, so the one clip returned is the most recent
+		resp, err := c.ListClips(ctx, ListClipsParams{ChannelID: channelIDs[i], Limit: 1, Sort: "-created_at"})
+		if err != nil {
+			usage[i].Error = err.Error()
+			return nil
+		}
+		usage[i].ClipCount = resp.Total
+		if len(resp.Data) > 0 {
+			usage[i].MostRecentClipAt = resp.Data[0].CreatedAt
+		}
+		return nil
+	})
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return usage, nil
+}
@@ -0,0 +1,345 @@
+package videoplatform
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestClient_ListTags_Offset(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("offset") != "50" {
+			t.Errorf("Expected offset=50, got %q", r.URL.Query().Get("offset"))
+		}
+		json.NewEncoder(w).Encode(PaginatedResponse[Tag]{})
+	}))
+	defer server.Close()
+
+	c := New(server.URL)
+	if _, err := c.ListTags(context.Background(), ListTagsParams{Limit: 50, Offset: 50}); err != nil {
+		t.Fatalf("ListTags() unexpected error: %v", err)
+	}
+}
+
+func TestClient_ListAllTags(t *testing.T) {
+	const total = 7
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+		var data []Tag
+		for i := offset; i < total && i < offset+limit; i++ {
+			data = append(data, Tag{ID: "tag-" + strconv.Itoa(i)})
+		}
+		json.NewEncoder(w).Encode(PaginatedResponse[Tag]{Data: data, Total: total, Limit: limit, Offset: offset})
+	}))
+	defer server.Close()
+
+	c := New(server.URL)
+	tags, err := c.ListAllTags(context.Background(), ListTagsParams{Limit: 3})
+	if err != nil {
+		t.Fatalf("ListAllTags() unexpected error: %v", err)
+	}
+	if len(tags) != total {
+		t.Errorf("ListAllTags() returned %d tags, want %d", len(tags), total)
+	}
+	if tags[6].ID != "tag-6" {
+		t.Errorf("ListAllTags() last tag = %s, want tag-6", tags[6].ID)
+	}
+}
+
+func TestClient_ListAllChannels(t *testing.T) {
+	const total = 5
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("status") != "active" {
+			t.Errorf("Expected the status filter on every page, got %s", r.URL.RawQuery)
+		}
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+		var data []Channel
+		for i := offset; i < total && i < offset+limit; i++ {
+			data = append(data, Channel{ID: "cam-" + strconv.Itoa(i)})
+		}
+		json.NewEncoder(w).Encode(PaginatedResponse[Channel]{Data: data, Total: total, Limit: limit, Offset: offset})
+	}))
+	defer server.Close()
+
+	channels, err := New(server.URL).ListAllChannels(context.Background(), ListChannelsParams{Status: ChannelActive, Limit: 2})
+	if err != nil {
+		t.Fatalf("ListAllChannels() unexpected error: %v", err)
+	}
+	if len(channels) != total || channels[4].ID != "cam-4" {
+		t.Errorf("ListAllChannels() = %v, want cam-0 to cam-4", channels)
+	}
+}
+
+func TestClient_ListTagsForSessions(t *testing.T) {
+	t.Run("merges in session then created_at order", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			session := r.URL.Query().Get("session_id")
+			var data []Tag
+			switch session {
+			case "game-1":
+				data = []Tag{
+					{ID: "g1-b", SessionID: session, CreatedAt: "2026-09-05T19:10:00Z"},
+					{ID: "g1-a", SessionID: session, CreatedAt: "2026-09-05T19:05:00Z"},
+				}
+			case "game-2":
+				data = []Tag{{ID: "g2-a", SessionID: session, CreatedAt: "2026-09-12T19:00:00Z"}}
+			}
+			json.NewEncoder(w).Encode(PaginatedResponse[Tag]{Data: data, Total: len(data)})
+		}))
+		defer server.Close()
+
+		c := New(server.URL)
+		result, err := c.ListTagsForSessions(context.Background(), []string{"game-2", "game-1", "game-2"}, ListTagsParams{})
+		if err != nil {
+			t.Fatalf("ListTagsForSessions() unexpected error: %v", err)
+		}
+
+		var got []string
+		for _, tag := range result.Tags {
+			got = append(got, tag.ID)
+		}
+		want := []string{"g2-a", "g1-a", "g1-b"}
+		if len(got) != len(want) {
+			t.Fatalf("ListTagsForSessions() tags = %v, want %v", got, want)
+		}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Errorf("ListTagsForSessions() tags = %v, want %v", got, want)
+				break
+			}
+		}
+	})
+
+	t.Run("failing session does not drop the others", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			session := r.URL.Query().Get("session_id")
+			if session == "bad" {
+				w.WriteHeader(http.StatusInternalServerError)
+				w.Write([]byte(`{"error": "boom"}`))
+				return
+			}
+			data := []Tag{{ID: session + "-tag", SessionID: session}}
+			json.NewEncoder(w).Encode(PaginatedResponse[Tag]{Data: data, Total: 1})
+		}))
+		defer server.Close()
+
+		c := New(server.URL)
+		result, err := c.ListTagsForSessions(context.Background(), []string{"good-1", "bad", "good-2"}, ListTagsParams{})
+		if err != nil {
+			t.Fatalf("ListTagsForSessions() unexpected error: %v", err)
+		}
+		if len(result.Tags) != 2 {
+			t.Errorf("ListTagsForSessions() returned %d tags, want 2", len(result.Tags))
+		}
+		if len(result.Failed) != 1 || result.Failed[0].SessionID != "bad" {
+			t.Errorf("ListTagsForSessions() failed = %+v, want bad session", result.Failed)
+		}
+	})
+
+	t.Run("cancellation stops the fan-out", func(t *testing.T) {
+		release := make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-release:
+			case <-r.Context().Done():
+			}
+		}))
+		defer server.Close()
+		defer close(release)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(50*time.Millisecond, cancel)
+
+		sessions := make([]string, 10)
+		for i := range sessions {
+			sessions[i] = "session-" + strconv.Itoa(i)
+		}
+
+		c := New(server.URL)
+		start := time.Now()
+		_, err := c.ListTagsForSessions(ctx, sessions, ListTagsParams{})
+		if err == nil {
+			t.Fatal("ListTagsForSessions() expected error after cancellation")
+		}
+		if time.Since(start) > 2*time.Second {
+			t.Errorf("ListTagsForSessions() took %v to return after cancellation", time.Since(start))
+		}
+	})
+}
+
+func TestClient_GetClips(t *testing.T) {
+	var mu sync.Mutex
+	requests := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimPrefix(r.URL.Path, "/api/v1/clips/")
+		mu.Lock()
+		requests[id]++
+		mu.Unlock()
+
+		switch id {
+		case "gone":
+			w.WriteHeader(http.StatusNotFound)
+		case "broken":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			json.NewEncoder(w).Encode(Clip{ID: id})
+		}
+	}))
+	defer server.Close()
+
+	c := New(server.URL)
+	clips, err := c.GetClips(context.Background(), []string{"clip-1", "gone", "clip-1", "clip-2"})
+	if err != nil {
+		t.Fatalf("GetClips() unexpected error: %v", err)
+	}
+	if len(clips) != 2 || clips["clip-1"].ID != "clip-1" || clips["clip-2"].ID != "clip-2" {
+		t.Errorf("GetClips() = %v, want clip-1 and clip-2", clips)
+	}
+	if requests["clip-1"] != 1 {
+		t.Errorf("GetClips() fetched clip-1 %d times, want 1", requests["clip-1"])
+	}
+
+	if _, err := c.GetClips(context.Background(), []string{"clip-1", "broken"}); err == nil {
+		t.Error("GetClips() expected error for a server failure")
+	}
+}
+
+func TestClient_GetClipsInOrder(t *testing.T) {
+	var inFlight, peak atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for p := peak.Load(); n > p && !peak.CompareAndSwap(p, n); p = peak.Load() {
+		}
+		time.Sleep(5 * time.Millisecond)
+
+		id := strings.TrimPrefix(r.URL.Path, "/api/v1/clips/")
+		if id == "gone" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode(Clip{ID: id})
+	}))
+	defer server.Close()
+
+	var ids []string
+	for i := 0; i < 12; i++ {
+		ids = append(ids, "clip-"+strconv.Itoa(i))
+	}
+	ids = append(ids, "gone", "clip-3")
+
+	results := New(server.URL).GetClipsInOrder(context.Background(), ids)
+	if len(results) != len(ids) {
+		t.Fatalf("GetClipsInOrder() returned %d results, want %d", len(results), len(ids))
+	}
+	for i, r := range results {
+		switch {
+		case r.ID != ids[i]:
+			t.Errorf("result %d is %s, want %s", i, r.ID, ids[i])
+		case r.ID == "gone":
+			if r.Err == nil {
+				t.Error("Expected an error for the missing clip")
+			}
+		case r.Err != nil || r.Clip.ID != r.ID:
+			t.Errorf("result %d = %+v", i, r)
+		}
+	}
+	if p := peak.Load(); p > maxConcurrentFetches {
+		t.Errorf("%d requests in flight at once, want at most %d", p, maxConcurrentFetches)
+	}
+}
+
+func TestClient_GetClipsInOrder_Cancel(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+
+	start := time.Now()
+	results := New(server.URL).GetClipsInOrder(ctx, []string{"a", "b", "c", "d", "e", "f", "g", "h"})
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("GetClipsInOrder() took %v after cancellation", elapsed)
+	}
+	for _, r := range results {
+		if r.Err == nil {
+			t.Errorf("result %s succeeded after cancellation", r.ID)
+		}
+	}
+}
+
+func TestClient_ListChannelUsage(t *testing.T) {
+	var inFlight, peak atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+
+		if r.URL.Query().Get("limit") != "1" {
+			t.Errorf("Expected count-only query, got %s", r.URL.RawQuery)
+		}
+		if r.URL.Query().Get("sort") != "-created_at" {
+			t.Errorf("Expected newest clip first, got %s", r.URL.RawQuery)
+		}
+		switch id := r.URL.Query().Get("channel_id"); id {
+		case "broken":
+			w.WriteHeader(http.StatusInternalServerError)
+		case "empty":
+			json.NewEncoder(w).Encode(PaginatedResponse[Clip]{Total: 0})
+		default:
+			json.NewEncoder(w).Encode(PaginatedResponse[Clip]{
+				Data:  []Clip{{ID: id + "-latest", CreatedAt: "2026-10-01T18:00:00Z"}},
+				Total: 12,
+			})
+		}
+	}))
+	defer server.Close()
+
+	ids := []string{"cam-1", "empty", "broken", "cam-2", "cam-3", "cam-4", "cam-5"}
+	usage, err := New(server.URL).ListChannelUsage(context.Background(), ids)
+	if err != nil {
+		t.Fatalf("ListChannelUsage() unexpected error: %v", err)
+	}
+	if len(usage) != len(ids) {
+		t.Fatalf("ListChannelUsage() returned %d entries, want %d", len(usage), len(ids))
+	}
+	if u := usage[0]; u.ChannelID != "cam-1" || u.ClipCount != 12 || u.MostRecentClipAt != "2026-10-01T18:00:00Z" {
+		t.Errorf("usage[cam-1] = %+v", u)
+	}
+	if u := usage[1]; u.ClipCount != 0 || u.MostRecentClipAt != "" || u.Error != "" {
+		t.Errorf("usage[empty] = %+v, want zero clips", u)
+	}
+	if u := usage[2]; u.Error == "" {
+		t.Errorf("usage[broken] = %+v, want error", u)
+	}
+	if u := usage[6]; u.ClipCount != 12 {
+		t.Errorf("usage[cam-5] = %+v, want count despite the failure", u)
+	}
+	if got := peak.Load(); got > maxConcurrentFetches {
+		t.Errorf("peak concurrency = %d, want at most %d", got, maxConcurrentFetches)
+	}
+}
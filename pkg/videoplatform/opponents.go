@@ -0,0 +1,70 @@
+package videoplatform
+
+import "encoding/json"
+
+// Sessions may have several opponents (e.g. a jamboree). Platforms that
+// predate Opponents only know the single opponent field, so both are kept in
+// sync when encoding and decoding: Opponent is always the first entry of
+// Opponents.
+
+// syncOpponents fills whichever of opponent and opponents is missing from
+// the other
+func syncOpponents(opponent **string, opponents *[]string) {
+	switch {
+	case len(*opponents) > 0:
+		first := (*opponents)[0]
+		*opponent = &first
+	case *opponent != nil && **opponent != "":
+		*opponents = []string{**opponent}
+	}
+}
+
+type sessionJSON Session
+
+// UnmarshalJSON decodes a session, filling Opponents from the legacy
+// opponent field when the platform doesn't send opponents
+func (s *Session) UnmarshalJSON(data []byte) error {
+	var v sessionJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*s = Session(v)
+	syncOpponents(&s.Opponent, &s.Opponents)
+	return nil
+}
+
+// MarshalJSON encodes a session with opponent set to the first of Opponents
+func (s Session) MarshalJSON() ([]byte, error) {
+	syncOpponents(&s.Opponent, &s.Opponents)
+	return json.Marshal(sessionJSON(s))
+}
+
+type createSessionJSON CreateSessionRequest
+
+// MarshalJSON encodes the request with opponent set to the first of
+// Opponents
+func (r CreateSessionRequest) MarshalJSON() ([]byte, error) {
+	syncOpponents(&r.Opponent, &r.Opponents)
+	return json.Marshal(createSessionJSON(r))
+}
+
+type updateSessionJSON UpdateSessionRequest
+
+// MarshalJSON encodes the request with opponent set to the first of
+// Opponents. Clearing either field clears both, so a platform reading
+// either one sees the session's opponents removed.
+func (r UpdateSessionRequest) MarshalJSON() ([]byte, error) {
+	switch {
+	case r.Opponents != nil && len(*r.Opponents) > 0:
+		first := (*r.Opponents)[0]
+		r.Opponent = &first
+	case r.Opponents != nil:
+		none := ""
+		r.Opponent = &none
+	case r.Opponent != nil && *r.Opponent != "":
+		r.Opponents = &[]string{*r.Opponent}
+	case r.Opponent != nil:
+		r.Opponents = &[]string{}
+	}
+	return json.Marshal(updateSessionJSON(r))
+}
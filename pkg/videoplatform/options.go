@@ -0,0 +1,47 @@
+package videoplatform
+
+import (
+	"log"
+	"net/http"
+	"time"
+)
+
+// Option configures a Client
+type Option func(*options)
+
+type options struct {
+	httpClient *http.Client
+	timeout    time.Duration
+	logger     *log.Logger
+	token      string
+}
+
+// WithHTTPClient sends requests through hc instead of a default client. The
+// client is copied, so hc itself is not modified. Its Timeout is used as is.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(o *options) {
+		o.httpClient = hc
+	}
+}
+
+// WithTimeout sets the per-request timeout (default 30s). It has no effect
+// when combined with WithHTTPClient.
+func WithTimeout(d time.Duration) Option {
+	return func(o *options) {
+		o.timeout = d
+	}
+}
+
+// WithLogger logs every platform request to l
+func WithLogger(l *log.Logger) Option {
+	return func(o *options) {
+		o.logger = l
+	}
+}
+
+// WithToken authenticates every request with a bearer token
+func WithToken(token string) Option {
+	return func(o *options) {
+		o.token = token
+	}
+}
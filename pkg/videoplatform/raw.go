@@ -0,0 +1,65 @@
+package videoplatform
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// maxRawResponseSize bounds how much of a raw response body is read
+const maxRawResponseSize = 4 << 20
+
+// RawResponse is the result of a request made with Do
+type RawResponse struct {
+	StatusCode int    `json:"status"`
+	Body       []byte `json:"-"`
+}
+
+// Do sends a request to an arbitrary platform path, for endpoints this
+// package doesn't wrap yet. path must be relative to the base URL and start
+// with "/". Unlike the typed methods, error statuses are not converted to an
+// *APIError; the caller inspects StatusCode.
+func (c *Client) Do(ctx context.Context, method, path string, query url.Values, body interface{}) (*RawResponse, error) {
+	if !strings.HasPrefix(path, "/") {
+		return nil, fmt.Errorf("path %q must start with /", path)
+	}
+
+	u := c.baseURL + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+
+	var bodyReader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		bodyReader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u, bodyReader)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.send(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxRawResponseSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	return &RawResponse{StatusCode: resp.StatusCode, Body: data}, nil
+}
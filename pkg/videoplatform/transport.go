@@ -0,0 +1,90 @@
+package videoplatform
+
+import (
+	"log"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// requestHistorySize bounds how many request summaries are retained
+const requestHistorySize = 50
+
+// RequestSummary describes one completed request to the platform
+type RequestSummary struct {
+	Time      string `json:"time"`
+	Method    string `json:"method"`
+	Path      string `json:"path"`
+	Status    int    `json:"status,omitempty"`
+	LatencyMS int64  `json:"latency_ms"`
+	Error     string `json:"error,omitempty"`
+}
+
+// loggingTransport records a bounded history of requests and, when a
+// logger is set, logs each one
+type loggingTransport struct {
+	next http.RoundTripper
+
+	mu      sync.Mutex
+	logger  *log.Logger
+	history []RequestSummary
+	pos     int
+}
+
+func newLoggingTransport(next http.RoundTripper) *loggingTransport {
+	return &loggingTransport{
+		next:    next,
+		history: make([]RequestSummary, 0, requestHistorySize),
+	}
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+
+	summary := RequestSummary{
+		Time:      start.UTC().Format(time.RFC3339),
+		Method:    req.Method,
+		Path:      req.URL.Path,
+		LatencyMS: time.Since(start).Milliseconds(),
+	}
+	if err != nil {
+		summary.Error = err.Error()
+	} else {
+		summary.Status = resp.StatusCode
+	}
+	t.record(summary)
+
+	return resp, err
+}
+
+func (t *loggingTransport) record(s RequestSummary) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if len(t.history) < requestHistorySize {
+		t.history = append(t.history, s)
+	} else {
+		t.history[t.pos] = s
+		t.pos = (t.pos + 1) % requestHistorySize
+	}
+
+	if t.logger != nil {
+		if s.Error != "" {
+			t.logger.Printf("%s %s failed after %dms: %s", s.Method, s.Path, s.LatencyMS, s.Error)
+		} else {
+			t.logger.Printf("%s %s %d (%dms)", s.Method, s.Path, s.Status, s.LatencyMS)
+		}
+	}
+}
+
+// recent returns the retained history, oldest first
+func (t *loggingTransport) recent() []RequestSummary {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	out := make([]RequestSummary, 0, len(t.history))
+	out = append(out, t.history[t.pos:]...)
+	out = append(out, t.history[:t.pos]...)
+	return out
+}